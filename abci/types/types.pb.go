@@ -1997,14 +1997,15 @@ func (m *ResponseCheckTx) GetCodespace() string {
 }
 
 type ResponseDeliverTx struct {
-	Code      uint32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Data      []byte  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Log       string  `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
-	Info      string  `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
-	GasWanted int64   `protobuf:"varint,5,opt,name=gas_wanted,proto3" json:"gas_wanted,omitempty"`
-	GasUsed   int64   `protobuf:"varint,6,opt,name=gas_used,proto3" json:"gas_used,omitempty"`
-	Events    []Event `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
-	Codespace string  `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code                  uint32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Data                  []byte  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Log                   string  `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
+	Info                  string  `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+	GasWanted             int64   `protobuf:"varint,5,opt,name=gas_wanted,proto3" json:"gas_wanted,omitempty"`
+	GasUsed               int64   `protobuf:"varint,6,opt,name=gas_used,proto3" json:"gas_used,omitempty"`
+	Events                []Event `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
+	Codespace             string  `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	IntermediateStateRoot []byte  `protobuf:"bytes,9,opt,name=intermediate_state_root,json=intermediateStateRoot,proto3" json:"intermediate_state_root,omitempty"`
 }
 
 func (m *ResponseDeliverTx) Reset()         { *m = ResponseDeliverTx{} }
@@ -2096,6 +2097,13 @@ func (m *ResponseDeliverTx) GetCodespace() string {
 	return ""
 }
 
+func (m *ResponseDeliverTx) GetIntermediateStateRoot() []byte {
+	if m != nil {
+		return m.IntermediateStateRoot
+	}
+	return nil
+}
+
 type ResponseEndBlock struct {
 	ValidatorUpdates      []ValidatorUpdate `protobuf:"bytes,1,rep,name=validator_updates,json=validatorUpdates,proto3" json:"validator_updates"`
 	ConsensusParamUpdates *ConsensusParams  `protobuf:"bytes,2,opt,name=consensus_param_updates,json=consensusParamUpdates,proto3" json:"consensus_param_updates,omitempty"`
@@ -2402,8 +2410,9 @@ func (m *ResponseApplySnapshotChunk) GetRejectSenders() []string {
 }
 
 type ResponsePreprocessTxs struct {
-	Txs      [][]byte         `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
-	Messages *types1.Messages `protobuf:"bytes,2,opt,name=messages,proto3" json:"messages,omitempty"`
+	Txs                    [][]byte                       `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	Messages               *types1.Messages               `protobuf:"bytes,2,opt,name=messages,proto3" json:"messages,omitempty"`
+	IntermediateStateRoots *types1.IntermediateStateRoots `protobuf:"bytes,3,opt,name=intermediate_state_roots,json=intermediateStateRoots,proto3" json:"intermediate_state_roots,omitempty"`
 }
 
 func (m *ResponsePreprocessTxs) Reset()         { *m = ResponsePreprocessTxs{} }
@@ -2453,13 +2462,21 @@ func (m *ResponsePreprocessTxs) GetMessages() *types1.Messages {
 	return nil
 }
 
+func (m *ResponsePreprocessTxs) GetIntermediateStateRoots() *types1.IntermediateStateRoots {
+	if m != nil {
+		return m.IntermediateStateRoots
+	}
+	return nil
+}
+
 // ConsensusParams contains all consensus-relevant parameters
 // that can be adjusted by the abci app
 type ConsensusParams struct {
-	Block     *BlockParams            `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
-	Evidence  *types1.EvidenceParams  `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence,omitempty"`
-	Validator *types1.ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
-	Version   *types1.VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Block            *BlockParams            `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Evidence         *types1.EvidenceParams  `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	Validator        *types1.ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	Version          *types1.VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	DataAvailability *DataAvailabilityParams `protobuf:"bytes,5,opt,name=data_availability,json=dataAvailability,proto3" json:"data_availability,omitempty"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
@@ -2523,6 +2540,13 @@ func (m *ConsensusParams) GetVersion() *types1.VersionParams {
 	return nil
 }
 
+func (m *ConsensusParams) GetDataAvailability() *DataAvailabilityParams {
+	if m != nil {
+		return m.DataAvailability
+	}
+	return nil
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Note: must be greater than 0
@@ -2578,6 +2602,62 @@ func (m *BlockParams) GetMaxGas() int64 {
 	return 0
 }
 
+// DataAvailabilityParams contains limits on the size of the original data
+// square. The erasure codec is fixed at genesis and thus not exposed.
+type DataAvailabilityParams struct {
+	// Note: must be a power of 2 supported by the erasure codec
+	MaxSquareSize uint32 `protobuf:"varint,1,opt,name=max_square_size,json=maxSquareSize,proto3" json:"max_square_size,omitempty"`
+	// Note: must be a power of 2 not greater than max_square_size
+	MinSquareSize uint32 `protobuf:"varint,2,opt,name=min_square_size,json=minSquareSize,proto3" json:"min_square_size,omitempty"`
+}
+
+func (m *DataAvailabilityParams) Reset()         { *m = DataAvailabilityParams{} }
+func (m *DataAvailabilityParams) String() string { return proto.CompactTextString(m) }
+func (*DataAvailabilityParams) ProtoMessage()    {}
+func (*DataAvailabilityParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252557cfdd89a31a, []int{35}
+}
+func (m *DataAvailabilityParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataAvailabilityParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataAvailabilityParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataAvailabilityParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataAvailabilityParams.Merge(m, src)
+}
+func (m *DataAvailabilityParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataAvailabilityParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataAvailabilityParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataAvailabilityParams proto.InternalMessageInfo
+
+func (m *DataAvailabilityParams) GetMaxSquareSize() uint32 {
+	if m != nil {
+		return m.MaxSquareSize
+	}
+	return 0
+}
+
+func (m *DataAvailabilityParams) GetMinSquareSize() uint32 {
+	if m != nil {
+		return m.MinSquareSize
+	}
+	return 0
+}
+
 type LastCommitInfo struct {
 	Round int32      `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
 	Votes []VoteInfo `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
@@ -2587,7 +2667,7 @@ func (m *LastCommitInfo) Reset()         { *m = LastCommitInfo{} }
 func (m *LastCommitInfo) String() string { return proto.CompactTextString(m) }
 func (*LastCommitInfo) ProtoMessage()    {}
 func (*LastCommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{35}
+	return fileDescriptor_252557cfdd89a31a, []int{36}
 }
 func (m *LastCommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2642,7 +2722,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{36}
+	return fileDescriptor_252557cfdd89a31a, []int{37}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2696,7 +2776,7 @@ func (m *EventAttribute) Reset()         { *m = EventAttribute{} }
 func (m *EventAttribute) String() string { return proto.CompactTextString(m) }
 func (*EventAttribute) ProtoMessage()    {}
 func (*EventAttribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{37}
+	return fileDescriptor_252557cfdd89a31a, []int{38}
 }
 func (m *EventAttribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +2840,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{38}
+	return fileDescriptor_252557cfdd89a31a, []int{39}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2828,7 +2908,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{39}
+	return fileDescriptor_252557cfdd89a31a, []int{40}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2881,7 +2961,7 @@ func (m *ValidatorUpdate) Reset()         { *m = ValidatorUpdate{} }
 func (m *ValidatorUpdate) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdate) ProtoMessage()    {}
 func (*ValidatorUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{40}
+	return fileDescriptor_252557cfdd89a31a, []int{41}
 }
 func (m *ValidatorUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2934,7 +3014,7 @@ func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
 func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
 func (*VoteInfo) ProtoMessage()    {}
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{41}
+	return fileDescriptor_252557cfdd89a31a, []int{42}
 }
 func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2995,7 +3075,7 @@ func (m *Evidence) Reset()         { *m = Evidence{} }
 func (m *Evidence) String() string { return proto.CompactTextString(m) }
 func (*Evidence) ProtoMessage()    {}
 func (*Evidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{42}
+	return fileDescriptor_252557cfdd89a31a, []int{43}
 }
 func (m *Evidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3071,7 +3151,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{43}
+	return fileDescriptor_252557cfdd89a31a, []int{44}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3175,6 +3255,7 @@ func init() {
 	proto.RegisterType((*ResponsePreprocessTxs)(nil), "tendermint.abci.ResponsePreprocessTxs")
 	proto.RegisterType((*ConsensusParams)(nil), "tendermint.abci.ConsensusParams")
 	proto.RegisterType((*BlockParams)(nil), "tendermint.abci.BlockParams")
+	proto.RegisterType((*DataAvailabilityParams)(nil), "tendermint.abci.DataAvailabilityParams")
 	proto.RegisterType((*LastCommitInfo)(nil), "tendermint.abci.LastCommitInfo")
 	proto.RegisterType((*Event)(nil), "tendermint.abci.Event")
 	proto.RegisterType((*EventAttribute)(nil), "tendermint.abci.EventAttribute")
@@ -3189,182 +3270,189 @@ func init() {
 func init() { proto.RegisterFile("tendermint/abci/types.proto", fileDescriptor_252557cfdd89a31a) }
 
 var fileDescriptor_252557cfdd89a31a = []byte{
-	// 2788 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x77, 0x23, 0xc5,
-	0x11, 0xd7, 0xe8, 0x5b, 0x25, 0x4b, 0x96, 0x7b, 0x97, 0x45, 0x88, 0xc5, 0x5e, 0x86, 0x07, 0x59,
-	0x36, 0x60, 0x83, 0x79, 0x6c, 0xe0, 0x41, 0x02, 0x96, 0xd0, 0x22, 0xb3, 0xc6, 0x76, 0xda, 0xda,
-	0x25, 0x5f, 0xec, 0xd0, 0xd2, 0xb4, 0xa5, 0x61, 0xa5, 0x99, 0x61, 0x66, 0x64, 0x6c, 0x8e, 0xf9,
-	0xb8, 0x90, 0x43, 0x38, 0xe6, 0xc2, 0xff, 0x91, 0x53, 0x2e, 0xb9, 0xf0, 0x5e, 0x2e, 0x1c, 0x73,
-	0x22, 0x79, 0xec, 0x2d, 0xb7, 0x9c, 0x72, 0xca, 0x4b, 0x5e, 0x7f, 0xcc, 0x97, 0xa4, 0x91, 0xe4,
-	0x90, 0x5b, 0x6e, 0xdd, 0x35, 0x55, 0xa5, 0xe9, 0x9a, 0xee, 0x5f, 0xfd, 0xaa, 0x5a, 0xf0, 0xa4,
-	0x47, 0x4d, 0x9d, 0x3a, 0x63, 0xc3, 0xf4, 0x76, 0x48, 0xaf, 0x6f, 0xec, 0x78, 0x17, 0x36, 0x75,
-	0xb7, 0x6d, 0xc7, 0xf2, 0x2c, 0xb4, 0x1e, 0x3e, 0xdc, 0x66, 0x0f, 0x1b, 0x4f, 0x45, 0xb4, 0xfb,
-	0xce, 0x85, 0xed, 0x59, 0x3b, 0xb6, 0x63, 0x59, 0xa7, 0x42, 0xbf, 0x71, 0x3d, 0xf2, 0x98, 0xfb,
-	0x89, 0x7a, 0x8b, 0x3d, 0x95, 0xc6, 0x0f, 0xe9, 0x85, 0xff, 0xf4, 0xa9, 0x19, 0x5b, 0x9b, 0x38,
-	0x64, 0xec, 0x3f, 0xde, 0x1a, 0x58, 0xd6, 0x60, 0x44, 0x77, 0xf8, 0xac, 0x37, 0x39, 0xdd, 0xf1,
-	0x8c, 0x31, 0x75, 0x3d, 0x32, 0xb6, 0xa5, 0xc2, 0xd5, 0x81, 0x35, 0xb0, 0xf8, 0x70, 0x87, 0x8d,
-	0x84, 0x54, 0xfd, 0xb2, 0x08, 0x05, 0x4c, 0x3f, 0x99, 0x50, 0xd7, 0x43, 0xbb, 0x90, 0xa5, 0xfd,
-	0xa1, 0x55, 0x57, 0x6e, 0x28, 0x37, 0xcb, 0xbb, 0xd7, 0xb7, 0xa7, 0x16, 0xb7, 0x2d, 0xf5, 0xda,
-	0xfd, 0xa1, 0xd5, 0x49, 0x61, 0xae, 0x8b, 0x5e, 0x85, 0xdc, 0xe9, 0x68, 0xe2, 0x0e, 0xeb, 0x69,
-	0x6e, 0xf4, 0x54, 0x92, 0xd1, 0x1d, 0xa6, 0xd4, 0x49, 0x61, 0xa1, 0xcd, 0x7e, 0xca, 0x30, 0x4f,
-	0xad, 0x7a, 0x66, 0xf1, 0x4f, 0xed, 0x9b, 0xa7, 0xfc, 0xa7, 0x98, 0x2e, 0x6a, 0x02, 0x18, 0xa6,
-	0xe1, 0x69, 0xfd, 0x21, 0x31, 0xcc, 0x7a, 0x96, 0x5b, 0x3e, 0x9d, 0x6c, 0x69, 0x78, 0x2d, 0xa6,
-	0xd8, 0x49, 0xe1, 0x92, 0xe1, 0x4f, 0xd8, 0xeb, 0x7e, 0x32, 0xa1, 0xce, 0x45, 0x3d, 0xb7, 0xf8,
-	0x75, 0x7f, 0xcc, 0x94, 0xd8, 0xeb, 0x72, 0x6d, 0xd4, 0x86, 0x72, 0x8f, 0x0e, 0x0c, 0x53, 0xeb,
-	0x8d, 0xac, 0xfe, 0xc3, 0x7a, 0x9e, 0x1b, 0xab, 0x49, 0xc6, 0x4d, 0xa6, 0xda, 0x64, 0x9a, 0x9d,
-	0x14, 0x86, 0x5e, 0x30, 0x43, 0x6f, 0x42, 0xb1, 0x3f, 0xa4, 0xfd, 0x87, 0x9a, 0x77, 0x5e, 0x2f,
-	0x70, 0x1f, 0x5b, 0x49, 0x3e, 0x5a, 0x4c, 0xaf, 0x7b, 0xde, 0x49, 0xe1, 0x42, 0x5f, 0x0c, 0xd9,
-	0xfa, 0x75, 0x3a, 0x32, 0xce, 0xa8, 0xc3, 0xec, 0x8b, 0x8b, 0xd7, 0xff, 0x8e, 0xd0, 0xe4, 0x1e,
-	0x4a, 0xba, 0x3f, 0x41, 0x6f, 0x41, 0x89, 0x9a, 0xba, 0x5c, 0x46, 0x89, 0xbb, 0xb8, 0x91, 0xf8,
-	0x9d, 0x4d, 0xdd, 0x5f, 0x44, 0x91, 0xca, 0x31, 0x7a, 0x0d, 0xf2, 0x7d, 0x6b, 0x3c, 0x36, 0xbc,
-	0x3a, 0x70, 0xeb, 0xcd, 0xc4, 0x05, 0x70, 0xad, 0x4e, 0x0a, 0x4b, 0x7d, 0x74, 0x08, 0xd5, 0x91,
-	0xe1, 0x7a, 0x9a, 0x6b, 0x12, 0xdb, 0x1d, 0x5a, 0x9e, 0x5b, 0x2f, 0x73, 0x0f, 0xcf, 0x26, 0x79,
-	0x38, 0x30, 0x5c, 0xef, 0xc4, 0x57, 0xee, 0xa4, 0x70, 0x65, 0x14, 0x15, 0x30, 0x7f, 0xd6, 0xe9,
-	0x29, 0x75, 0x02, 0x87, 0xf5, 0xb5, 0xc5, 0xfe, 0x8e, 0x98, 0xb6, 0x6f, 0xcf, 0xfc, 0x59, 0x51,
-	0x01, 0xfa, 0x39, 0x5c, 0x19, 0x59, 0x44, 0x0f, 0xdc, 0x69, 0xfd, 0xe1, 0xc4, 0x7c, 0x58, 0xaf,
-	0x70, 0xa7, 0xcf, 0x27, 0xbe, 0xa4, 0x45, 0x74, 0xdf, 0x45, 0x8b, 0x19, 0x74, 0x52, 0x78, 0x63,
-	0x34, 0x2d, 0x44, 0x0f, 0xe0, 0x2a, 0xb1, 0xed, 0xd1, 0xc5, 0xb4, 0xf7, 0x2a, 0xf7, 0x7e, 0x2b,
-	0xc9, 0xfb, 0x1e, 0xb3, 0x99, 0x76, 0x8f, 0xc8, 0x8c, 0x94, 0x05, 0xc3, 0x76, 0xa8, 0xed, 0x58,
-	0x7d, 0xea, 0xba, 0x9a, 0x77, 0xee, 0xd6, 0xd7, 0x17, 0x07, 0xe3, 0x38, 0xd0, 0xee, 0x9e, 0xf3,
-	0xe0, 0xda, 0x51, 0x41, 0xb3, 0x00, 0xb9, 0x33, 0x32, 0x9a, 0x50, 0xf5, 0x7b, 0x50, 0x8e, 0x1c,
-	0x7b, 0x54, 0x87, 0xc2, 0x98, 0xba, 0x2e, 0x19, 0x50, 0x8e, 0x12, 0x25, 0xec, 0x4f, 0xd5, 0x2a,
-	0xac, 0x45, 0x8f, 0xba, 0xfa, 0x85, 0x12, 0x58, 0xb2, 0x53, 0xcc, 0x2c, 0xcf, 0xa8, 0xe3, 0x1a,
-	0x96, 0xe9, 0x5b, 0xca, 0x29, 0x7a, 0x06, 0x2a, 0x7c, 0x3f, 0x6a, 0xfe, 0x73, 0x06, 0x25, 0x59,
-	0xbc, 0xc6, 0x85, 0xf7, 0xa5, 0xd2, 0x16, 0x94, 0xed, 0x5d, 0x3b, 0x50, 0xc9, 0x70, 0x15, 0xb0,
-	0x77, 0x6d, 0x5f, 0xe1, 0x69, 0x58, 0x63, 0xeb, 0x0b, 0x34, 0xb2, 0xfc, 0x47, 0xca, 0x4c, 0x26,
-	0x55, 0xd4, 0x3f, 0xa7, 0xa1, 0x36, 0x0d, 0x0f, 0xe8, 0x35, 0xc8, 0x32, 0xa4, 0x94, 0xa0, 0xd7,
-	0xd8, 0x16, 0x30, 0xba, 0xed, 0xc3, 0xe8, 0x76, 0xd7, 0x87, 0xd1, 0x66, 0xf1, 0xab, 0x6f, 0xb6,
-	0x52, 0x5f, 0xfc, 0x75, 0x4b, 0xc1, 0xdc, 0x02, 0x3d, 0xc1, 0x4e, 0x33, 0x31, 0x4c, 0xcd, 0xd0,
-	0xf9, 0x2b, 0x97, 0xd8, 0x51, 0x25, 0x86, 0xb9, 0xaf, 0xa3, 0xbb, 0x50, 0xeb, 0x5b, 0xa6, 0x4b,
-	0x4d, 0x77, 0xe2, 0x6a, 0x02, 0xa6, 0x25, 0xd4, 0xcd, 0x9e, 0xb6, 0x96, 0xaf, 0x78, 0xcc, 0xf5,
-	0xf0, 0x7a, 0x3f, 0x2e, 0x40, 0x77, 0x00, 0xce, 0xc8, 0xc8, 0xd0, 0x89, 0x67, 0x39, 0x6e, 0x3d,
-	0x7b, 0x23, 0x33, 0xd7, 0xcd, 0x7d, 0x5f, 0xe5, 0x9e, 0xad, 0x13, 0x8f, 0x36, 0xb3, 0xec, 0x6d,
-	0x71, 0xc4, 0x12, 0x3d, 0x07, 0xeb, 0xc4, 0xb6, 0x35, 0xd7, 0x23, 0x1e, 0xd5, 0x7a, 0x17, 0x1e,
-	0x75, 0x39, 0x0a, 0xae, 0xe1, 0x0a, 0xb1, 0xed, 0x13, 0x26, 0x6d, 0x32, 0x21, 0x7a, 0x16, 0xaa,
-	0x0c, 0x30, 0x0d, 0x32, 0xd2, 0x86, 0xd4, 0x18, 0x0c, 0x3d, 0x8e, 0x77, 0x19, 0x5c, 0x91, 0xd2,
-	0x0e, 0x17, 0xaa, 0x7a, 0xf0, 0xc1, 0x39, 0x58, 0x22, 0x04, 0x59, 0x9d, 0x78, 0x84, 0x07, 0x72,
-	0x0d, 0xf3, 0x31, 0x93, 0xd9, 0xc4, 0x1b, 0xca, 0xf0, 0xf0, 0x31, 0xba, 0x06, 0x79, 0xe9, 0x36,
-	0xc3, 0xdd, 0xca, 0x19, 0xba, 0x0a, 0x39, 0xdb, 0xb1, 0xce, 0x28, 0xff, 0x72, 0x45, 0x2c, 0x26,
-	0xea, 0xaf, 0xd3, 0xb0, 0x31, 0x03, 0xab, 0xcc, 0xef, 0x90, 0xb8, 0x43, 0xff, 0xb7, 0xd8, 0x18,
-	0xdd, 0x66, 0x7e, 0x89, 0x4e, 0x1d, 0x99, 0x8a, 0xea, 0xd1, 0x10, 0x89, 0x34, 0xdb, 0xe1, 0xcf,
-	0x65, 0x68, 0xa4, 0x36, 0x3a, 0x82, 0xda, 0x88, 0xb8, 0x9e, 0x26, 0x60, 0x4a, 0x8b, 0xa4, 0xa5,
-	0x59, 0x70, 0x3e, 0x20, 0x3e, 0xb0, 0xb1, 0x3d, 0x2d, 0x1d, 0x55, 0x47, 0x31, 0x29, 0xc2, 0x70,
-	0xb5, 0x77, 0xf1, 0x19, 0x31, 0x3d, 0xc3, 0xa4, 0xda, 0xcc, 0x97, 0x7b, 0x62, 0xc6, 0x69, 0xfb,
-	0xcc, 0xd0, 0xa9, 0xd9, 0xf7, 0x3f, 0xd9, 0x95, 0xc0, 0x38, 0xf8, 0xa4, 0xae, 0x8a, 0xa1, 0x1a,
-	0x4f, 0x0c, 0xa8, 0x0a, 0x69, 0xef, 0x5c, 0x06, 0x20, 0xed, 0x9d, 0xa3, 0x97, 0x20, 0xcb, 0x16,
-	0xc9, 0x17, 0x5f, 0x9d, 0x93, 0x51, 0xa5, 0x5d, 0xf7, 0xc2, 0xa6, 0x98, 0x6b, 0xaa, 0x6a, 0x70,
-	0x1a, 0x82, 0x64, 0x31, 0xed, 0x55, 0x7d, 0x1e, 0xd6, 0xa7, 0xb2, 0x41, 0xe4, 0xfb, 0x29, 0xd1,
-	0xef, 0xa7, 0xae, 0x43, 0x25, 0x06, 0xfd, 0xea, 0x35, 0xb8, 0x3a, 0x0f, 0xc9, 0xd5, 0x61, 0x20,
-	0x8f, 0x21, 0x32, 0x7a, 0x15, 0x8a, 0x01, 0x94, 0x8b, 0xd3, 0x38, 0x1b, 0x2b, 0x5f, 0x19, 0x07,
-	0xaa, 0xec, 0x18, 0xb2, 0x6d, 0xcd, 0xf7, 0x43, 0x9a, 0xbf, 0x78, 0x81, 0xd8, 0x76, 0x87, 0xb8,
-	0x43, 0xf5, 0x23, 0xa8, 0x27, 0xc1, 0xf4, 0xd4, 0x32, 0xb2, 0xc1, 0x36, 0xbc, 0x06, 0xf9, 0x53,
-	0xcb, 0x19, 0x13, 0x8f, 0x3b, 0xab, 0x60, 0x39, 0x63, 0xdb, 0x53, 0x40, 0x76, 0x86, 0x8b, 0xc5,
-	0x44, 0xd5, 0xe0, 0x89, 0x44, 0xa8, 0x66, 0x26, 0x86, 0xa9, 0x53, 0x11, 0xcf, 0x0a, 0x16, 0x93,
-	0xd0, 0x91, 0x78, 0x59, 0x31, 0x61, 0x3f, 0xeb, 0xf2, 0xb5, 0x72, 0xff, 0x25, 0x2c, 0x67, 0xea,
-	0xcd, 0x20, 0x58, 0x31, 0xc4, 0x46, 0x35, 0xc8, 0x30, 0x94, 0x57, 0x6e, 0x64, 0x6e, 0xae, 0x61,
-	0x36, 0x54, 0xff, 0x51, 0x84, 0x22, 0xa6, 0xae, 0xcd, 0xd0, 0x03, 0x35, 0xa1, 0x44, 0xcf, 0xfb,
-	0xd4, 0xf6, 0x7c, 0xbc, 0x9d, 0x4f, 0x57, 0x84, 0x76, 0xdb, 0xd7, 0x64, 0x5c, 0x21, 0x30, 0x43,
-	0xaf, 0x48, 0x3a, 0x98, 0xcc, 0xec, 0xa4, 0x79, 0x94, 0x0f, 0xde, 0xf6, 0xf9, 0x60, 0x26, 0x91,
-	0x1e, 0x08, 0xab, 0x29, 0x42, 0xf8, 0x8a, 0x24, 0x84, 0xd9, 0x25, 0x3f, 0x16, 0x63, 0x84, 0xad,
-	0x18, 0x23, 0xcc, 0x2d, 0x59, 0x66, 0x02, 0x25, 0xbc, 0xed, 0x53, 0xc2, 0xfc, 0x92, 0x37, 0x9e,
-	0xe2, 0x84, 0x77, 0xe2, 0x9c, 0x50, 0xf0, 0xb9, 0x67, 0x12, 0xad, 0x13, 0x49, 0xe1, 0x0f, 0x23,
-	0xa4, 0xb0, 0x98, 0xc8, 0xc8, 0x84, 0x93, 0x39, 0xac, 0xb0, 0x15, 0x63, 0x85, 0xa5, 0x25, 0x31,
-	0x48, 0xa0, 0x85, 0x6f, 0x47, 0x69, 0x21, 0x24, 0x32, 0x4b, 0xf9, 0xbd, 0xe7, 0xf1, 0xc2, 0xd7,
-	0x03, 0x5e, 0x58, 0x4e, 0x24, 0xb6, 0x72, 0x0d, 0xd3, 0xc4, 0xf0, 0x68, 0x86, 0x18, 0x0a, 0x22,
-	0xf7, 0x5c, 0xa2, 0x8b, 0x25, 0xcc, 0xf0, 0x68, 0x86, 0x19, 0x56, 0x96, 0x38, 0x5c, 0x42, 0x0d,
-	0x7f, 0x31, 0x9f, 0x1a, 0x26, 0x93, 0x37, 0xf9, 0x9a, 0xab, 0x71, 0x43, 0x2d, 0x81, 0x1b, 0x0a,
-	0x06, 0xf7, 0xfd, 0x44, 0xf7, 0x2b, 0x93, 0xc3, 0xa3, 0x19, 0x72, 0x58, 0x5b, 0x12, 0x8f, 0x55,
-	0xd9, 0xe1, 0xf3, 0x2c, 0x39, 0x4f, 0x81, 0x08, 0x03, 0x38, 0xea, 0x38, 0x96, 0x23, 0x79, 0x9e,
-	0x98, 0xa8, 0x37, 0x19, 0x5d, 0x08, 0x01, 0x63, 0x01, 0x93, 0xe4, 0x89, 0x24, 0x02, 0x12, 0xea,
-	0x1f, 0x94, 0xd0, 0x96, 0x67, 0xd8, 0x28, 0xd5, 0x28, 0x49, 0xaa, 0x11, 0xe1, 0x97, 0xe9, 0x38,
-	0xbf, 0xdc, 0x82, 0x32, 0x4b, 0x10, 0x53, 0xd4, 0x91, 0xd8, 0x01, 0x75, 0xbc, 0x05, 0x1b, 0x9c,
-	0x01, 0x08, 0x16, 0x2a, 0xb3, 0x42, 0x96, 0x27, 0xb7, 0x75, 0xf6, 0x40, 0xec, 0x76, 0x91, 0x1e,
-	0x5e, 0x84, 0x2b, 0x11, 0xdd, 0x20, 0xf1, 0x08, 0x22, 0x55, 0x0b, 0xb4, 0xf7, 0x64, 0x06, 0xfa,
-	0x93, 0x12, 0x46, 0x28, 0xe4, 0x9c, 0xf3, 0xe8, 0xa1, 0xf2, 0xbf, 0xa1, 0x87, 0xe9, 0xff, 0x9a,
-	0x1e, 0x46, 0xf3, 0x68, 0x26, 0x9e, 0x47, 0xff, 0xa9, 0x84, 0x9f, 0x24, 0x20, 0x7b, 0x7d, 0x4b,
-	0xa7, 0x32, 0xb3, 0xf1, 0x31, 0x4b, 0x49, 0x23, 0x6b, 0x20, 0xf3, 0x17, 0x1b, 0x32, 0xad, 0x00,
-	0xd4, 0x4b, 0x12, 0xb3, 0x83, 0xa4, 0x98, 0xe3, 0x01, 0x96, 0x49, 0xb1, 0x06, 0x99, 0x87, 0x54,
-	0x40, 0xf0, 0x1a, 0x66, 0x43, 0xa6, 0xc7, 0xf7, 0x18, 0x07, 0xd6, 0x35, 0x2c, 0x26, 0xe8, 0x35,
-	0x28, 0xf1, 0x7e, 0x8a, 0x66, 0xd9, 0xae, 0x44, 0xcb, 0x27, 0xa3, 0x6b, 0x15, 0x6d, 0x93, 0xed,
-	0x63, 0xa6, 0x73, 0x64, 0xbb, 0xb8, 0x68, 0xcb, 0x51, 0x24, 0xdf, 0x97, 0x62, 0xb4, 0xf3, 0x3a,
-	0x94, 0xd8, 0xdb, 0xbb, 0x36, 0xe9, 0x53, 0x0e, 0x7d, 0x25, 0x1c, 0x0a, 0xd4, 0x07, 0x80, 0x66,
-	0x01, 0x1c, 0x75, 0x20, 0x4f, 0xcf, 0xa8, 0xe9, 0x89, 0xfc, 0x5b, 0xde, 0xbd, 0x36, 0x87, 0xd3,
-	0x51, 0xd3, 0x6b, 0xd6, 0x59, 0x90, 0xff, 0xfe, 0xcd, 0x56, 0x4d, 0x68, 0xbf, 0x60, 0x8d, 0x0d,
-	0x8f, 0x8e, 0x6d, 0xef, 0x02, 0x4b, 0x7b, 0xf5, 0x57, 0x69, 0x46, 0xb0, 0x62, 0xe0, 0x3e, 0x37,
-	0xb6, 0xfe, 0x8e, 0x4f, 0x47, 0xc8, 0xf5, 0x6a, 0xf1, 0xde, 0x04, 0x18, 0x10, 0x57, 0xfb, 0x94,
-	0x98, 0x1e, 0xd5, 0x65, 0xd0, 0x23, 0x12, 0xd4, 0x80, 0x22, 0x9b, 0x4d, 0x5c, 0xaa, 0x4b, 0x9e,
-	0x1f, 0xcc, 0x23, 0xeb, 0x2c, 0x7c, 0xb7, 0x75, 0xc6, 0xa3, 0x5c, 0x9c, 0x8e, 0xf2, 0x6f, 0xd2,
-	0xe1, 0x29, 0x09, 0xb9, 0xe8, 0xff, 0x5f, 0x1c, 0x7e, 0xcb, 0x0b, 0xd4, 0x78, 0x96, 0x45, 0x27,
-	0xb0, 0x11, 0x9c, 0x52, 0x6d, 0xc2, 0x4f, 0xaf, 0xbf, 0xef, 0x56, 0x3d, 0xe6, 0xb5, 0xb3, 0xb8,
-	0xd8, 0x45, 0x3f, 0x81, 0xc7, 0xa7, 0x10, 0x28, 0x70, 0x9d, 0x5e, 0x11, 0x88, 0x1e, 0x8b, 0x03,
-	0x91, 0xef, 0x39, 0x8c, 0x55, 0xe6, 0x3b, 0x9e, 0x8d, 0x7d, 0x56, 0xf3, 0x44, 0x39, 0xc3, 0xdc,
-	0xaf, 0xff, 0x0c, 0x54, 0x1c, 0xea, 0xb1, 0x32, 0x3c, 0x56, 0x55, 0xae, 0x09, 0xa1, 0xac, 0x55,
-	0x8f, 0xe1, 0xb1, 0xb9, 0xdc, 0x01, 0xfd, 0x00, 0x4a, 0x21, 0xed, 0x50, 0x12, 0x0a, 0xb4, 0xa0,
-	0xe8, 0x08, 0x75, 0xd5, 0x3f, 0x2a, 0xa1, 0xcb, 0x78, 0x19, 0xd3, 0x86, 0xbc, 0x43, 0xdd, 0xc9,
-	0x48, 0x14, 0x16, 0xd5, 0xdd, 0x17, 0x57, 0x63, 0x1d, 0x4c, 0x3a, 0x19, 0x79, 0x58, 0x1a, 0xab,
-	0x0f, 0x20, 0x2f, 0x24, 0xa8, 0x0c, 0x85, 0x7b, 0x87, 0x77, 0x0f, 0x8f, 0x3e, 0x38, 0xac, 0xa5,
-	0x10, 0x40, 0x7e, 0xaf, 0xd5, 0x6a, 0x1f, 0x77, 0x6b, 0x0a, 0x2a, 0x41, 0x6e, 0xaf, 0x79, 0x84,
-	0xbb, 0xb5, 0x34, 0x13, 0xe3, 0xf6, 0x7b, 0xed, 0x56, 0xb7, 0x96, 0x41, 0x1b, 0x50, 0x11, 0x63,
-	0xed, 0xce, 0x11, 0x7e, 0x7f, 0xaf, 0x5b, 0xcb, 0x46, 0x44, 0x27, 0xed, 0xc3, 0x77, 0xda, 0xb8,
-	0x96, 0x53, 0x5f, 0x66, 0x95, 0x4b, 0x02, 0x4f, 0x09, 0x6b, 0x14, 0x25, 0x52, 0xa3, 0xa8, 0xbf,
-	0x4f, 0x43, 0x23, 0x99, 0x7c, 0xa0, 0xf7, 0xa6, 0x16, 0xbe, 0x7b, 0x09, 0xe6, 0x32, 0xb5, 0x7a,
-	0xf4, 0x2c, 0x54, 0x1d, 0x7a, 0x4a, 0xbd, 0xfe, 0x50, 0x90, 0x21, 0x91, 0xd8, 0x2a, 0xb8, 0x22,
-	0xa5, 0xdc, 0xc8, 0x15, 0x6a, 0x1f, 0xd3, 0xbe, 0xa7, 0x89, 0x72, 0x49, 0x6c, 0xba, 0x12, 0x53,
-	0x63, 0xd2, 0x13, 0x21, 0x54, 0x3f, 0xba, 0x54, 0x2c, 0x4b, 0x90, 0xc3, 0xed, 0x2e, 0xfe, 0x69,
-	0x2d, 0x83, 0x10, 0x54, 0xf9, 0x50, 0x3b, 0x39, 0xdc, 0x3b, 0x3e, 0xe9, 0x1c, 0xb1, 0x58, 0x5e,
-	0x81, 0x75, 0x3f, 0x96, 0xbe, 0x30, 0xa7, 0x92, 0x70, 0x37, 0x2c, 0xa9, 0xd3, 0xd0, 0x6d, 0x28,
-	0x4a, 0xa6, 0xe3, 0x9f, 0xb5, 0xc6, 0x6c, 0xa7, 0xe2, 0x7d, 0xa9, 0x81, 0x03, 0x5d, 0xf5, 0xdf,
-	0x0a, 0xac, 0x4f, 0x9d, 0x41, 0xb4, 0x0b, 0x39, 0xc1, 0xd9, 0x93, 0x5a, 0xf6, 0x1c, 0x42, 0xe4,
-	0x81, 0x15, 0xaa, 0xe8, 0x4d, 0x28, 0x52, 0xd9, 0x71, 0x98, 0x77, 0xd6, 0xc5, 0xef, 0xfb, 0x3d,
-	0x09, 0x69, 0x1a, 0x58, 0xa0, 0xb7, 0xa0, 0x14, 0x80, 0x89, 0xac, 0xf1, 0x9e, 0x9e, 0x35, 0x0f,
-	0x60, 0x48, 0xda, 0x87, 0x36, 0xe8, 0xf5, 0x90, 0xa7, 0x65, 0x67, 0x2b, 0x05, 0x69, 0x2e, 0x14,
-	0xa4, 0xb1, 0xaf, 0xaf, 0xb6, 0xa0, 0x1c, 0x59, 0x0f, 0x7a, 0x12, 0x4a, 0x63, 0x72, 0x2e, 0x3b,
-	0x59, 0xa2, 0x17, 0x51, 0x1c, 0x93, 0x73, 0xd1, 0xc4, 0x7a, 0x1c, 0x0a, 0xec, 0xe1, 0x80, 0x88,
-	0x20, 0x67, 0x70, 0x7e, 0x4c, 0xce, 0xdf, 0x25, 0xae, 0xfa, 0x21, 0x54, 0xe3, 0x5d, 0x1c, 0xb6,
-	0xd9, 0x1d, 0x6b, 0x62, 0xea, 0xdc, 0x47, 0x0e, 0x8b, 0x09, 0x7a, 0x15, 0x72, 0x67, 0x96, 0xc0,
-	0xc3, 0xf9, 0xa8, 0x70, 0xdf, 0xf2, 0x68, 0xa4, 0x0b, 0x24, 0xb4, 0xd5, 0xcf, 0x20, 0xc7, 0xf1,
-	0x8d, 0x61, 0x15, 0xef, 0xc7, 0x48, 0x8e, 0xca, 0xc6, 0xe8, 0x43, 0x00, 0xe2, 0x79, 0x8e, 0xd1,
-	0x9b, 0x84, 0x8e, 0xb7, 0xe6, 0xe3, 0xe3, 0x9e, 0xaf, 0xd7, 0xbc, 0x2e, 0x81, 0xf2, 0x6a, 0x68,
-	0x1a, 0x01, 0xcb, 0x88, 0x43, 0xf5, 0x10, 0xaa, 0x71, 0x5b, 0x9f, 0x56, 0x29, 0x73, 0x68, 0x55,
-	0x3a, 0x4a, 0xab, 0x02, 0x52, 0x96, 0x11, 0xbd, 0x37, 0x3e, 0x51, 0x3f, 0x57, 0xa0, 0xd8, 0x3d,
-	0x97, 0x27, 0x27, 0xa1, 0xed, 0x13, 0x9a, 0xa6, 0xa3, 0x4d, 0x0e, 0xd1, 0x47, 0xca, 0x04, 0xdd,
-	0xa9, 0xb7, 0x03, 0x6c, 0xc8, 0xae, 0x5a, 0xa1, 0xfa, 0x6d, 0x3a, 0x89, 0x87, 0x6f, 0x40, 0x29,
-	0xd8, 0x55, 0x8c, 0xec, 0x13, 0x5d, 0x77, 0xa8, 0xeb, 0xca, 0xb5, 0xf9, 0x53, 0xde, 0x45, 0xb4,
-	0x3e, 0x95, 0x6d, 0x94, 0x0c, 0x16, 0x13, 0x55, 0x87, 0xf5, 0xa9, 0xcc, 0x88, 0xde, 0x80, 0x82,
-	0x3d, 0xe9, 0x69, 0x7e, 0x78, 0xa6, 0x0e, 0x8f, 0xcf, 0x23, 0x27, 0xbd, 0x91, 0xd1, 0xbf, 0x4b,
-	0x2f, 0xfc, 0x97, 0xb1, 0x27, 0xbd, 0xbb, 0x22, 0x8a, 0xe2, 0x57, 0xd2, 0xd1, 0x5f, 0x39, 0x83,
-	0xa2, 0xbf, 0x29, 0xd0, 0x8f, 0xa2, 0xe7, 0x44, 0x99, 0x3d, 0xe6, 0xf1, 0x6c, 0x2d, 0xdd, 0x47,
-	0x8e, 0xc9, 0x2d, 0xd8, 0x70, 0x8d, 0x81, 0x49, 0x75, 0x2d, 0x2c, 0x37, 0xf8, 0xaf, 0x15, 0xf1,
-	0xba, 0x78, 0x70, 0xe0, 0xd7, 0x1a, 0xea, 0xbf, 0x14, 0x28, 0xfa, 0x07, 0x16, 0xbd, 0x1c, 0xd9,
-	0x77, 0xd5, 0x39, 0x8d, 0x14, 0x5f, 0x31, 0x6c, 0x04, 0xc6, 0xdf, 0x35, 0x7d, 0xf9, 0x77, 0x4d,
-	0xea, 0xe8, 0xfa, 0xad, 0xf5, 0xec, 0xa5, 0x5b, 0xeb, 0x2f, 0x00, 0xf2, 0x2c, 0x8f, 0x8c, 0xb4,
-	0x33, 0xcb, 0x33, 0xcc, 0x81, 0x26, 0x82, 0x2d, 0x48, 0x5b, 0x8d, 0x3f, 0xb9, 0xcf, 0x1f, 0x1c,
-	0xf3, 0xb8, 0xff, 0x52, 0x81, 0x62, 0x90, 0x7e, 0x2f, 0xdb, 0xd7, 0xbb, 0x06, 0x79, 0x99, 0x61,
-	0x44, 0x63, 0x4f, 0xce, 0x82, 0x16, 0x73, 0x36, 0xd2, 0x62, 0x6e, 0x30, 0xe8, 0xf6, 0x08, 0xe7,
-	0x20, 0xa2, 0xe2, 0x0b, 0xe6, 0xb7, 0x5e, 0x87, 0x72, 0xa4, 0xc5, 0xca, 0x4e, 0xde, 0x61, 0xfb,
-	0x83, 0x5a, 0xaa, 0x51, 0xf8, 0xfc, 0xcb, 0x1b, 0x99, 0x43, 0xfa, 0x29, 0xdb, 0xb3, 0xb8, 0xdd,
-	0xea, 0xb4, 0x5b, 0x77, 0x6b, 0x4a, 0xa3, 0xfc, 0xf9, 0x97, 0x37, 0x0a, 0x98, 0xf2, 0x26, 0xce,
-	0xad, 0x0e, 0xac, 0x45, 0xbf, 0x4a, 0x3c, 0x49, 0x21, 0xa8, 0xbe, 0x73, 0xef, 0xf8, 0x60, 0xbf,
-	0xb5, 0xd7, 0x6d, 0x6b, 0xf7, 0x8f, 0xba, 0xed, 0x9a, 0x82, 0x1e, 0x87, 0x2b, 0x07, 0xfb, 0xef,
-	0x76, 0xba, 0x5a, 0xeb, 0x60, 0xbf, 0x7d, 0xd8, 0xd5, 0xf6, 0xba, 0xdd, 0xbd, 0xd6, 0xdd, 0x5a,
-	0x7a, 0xf7, 0x77, 0x00, 0xeb, 0x7b, 0xcd, 0xd6, 0x3e, 0x4b, 0xb0, 0x46, 0x9f, 0xf0, 0x72, 0xbc,
-	0x05, 0x59, 0x5e, 0x70, 0x2f, 0xbc, 0xcf, 0x6d, 0x2c, 0x6e, 0xef, 0xa1, 0x3b, 0x90, 0xe3, 0xb5,
-	0x38, 0x5a, 0x7c, 0xc1, 0xdb, 0x58, 0xd2, 0xef, 0x63, 0x2f, 0xc3, 0x8f, 0xc7, 0xc2, 0x1b, 0xdf,
-	0xc6, 0xe2, 0xf6, 0x1f, 0xc2, 0x50, 0x0a, 0xab, 0x84, 0xe5, 0x37, 0xa0, 0x8d, 0x15, 0xc0, 0x06,
-	0x1d, 0x40, 0xc1, 0xaf, 0xbf, 0x96, 0xdd, 0xc9, 0x36, 0x96, 0xf6, 0xe7, 0x58, 0xb8, 0x44, 0x9d,
-	0xbc, 0xf8, 0x82, 0xb9, 0xb1, 0xa4, 0xd9, 0x88, 0xf6, 0x21, 0x2f, 0xa9, 0xef, 0x92, 0x7b, 0xd6,
-	0xc6, 0xb2, 0x7e, 0x1b, 0x0b, 0x5a, 0xd8, 0x80, 0x58, 0x7e, 0x6d, 0xde, 0x58, 0xa1, 0x8f, 0x8a,
-	0xee, 0x01, 0x44, 0xaa, 0xe2, 0x15, 0xee, 0xc3, 0x1b, 0xab, 0xf4, 0x47, 0xd1, 0x11, 0x14, 0x83,
-	0xea, 0x67, 0xe9, 0xed, 0x74, 0x63, 0x79, 0xa3, 0x12, 0x3d, 0x80, 0x4a, 0x9c, 0xf6, 0xaf, 0x76,
-	0xe7, 0xdc, 0x58, 0xb1, 0x03, 0xc9, 0xfc, 0xc7, 0x6b, 0x80, 0xd5, 0xee, 0xa0, 0x1b, 0x2b, 0x36,
-	0x24, 0xd1, 0xc7, 0xb0, 0x31, 0xcb, 0xd1, 0x57, 0xbf, 0x92, 0x6e, 0x5c, 0xa2, 0x45, 0x89, 0xc6,
-	0x80, 0xe6, 0x70, 0xfb, 0x4b, 0xdc, 0x50, 0x37, 0x2e, 0xd3, 0xb1, 0x64, 0xa1, 0x8b, 0x13, 0xe6,
-	0xd5, 0x6e, 0xac, 0x1b, 0x2b, 0xf6, 0x2e, 0x9b, 0xef, 0x7d, 0xf5, 0xed, 0xa6, 0xf2, 0xf5, 0xb7,
-	0x9b, 0xca, 0xdf, 0xbe, 0xdd, 0x54, 0xbe, 0x78, 0xb4, 0x99, 0xfa, 0xfa, 0xd1, 0x66, 0xea, 0x2f,
-	0x8f, 0x36, 0x53, 0x3f, 0x7b, 0x69, 0x60, 0x78, 0xc3, 0x49, 0x6f, 0xbb, 0x6f, 0x8d, 0x77, 0x46,
-	0xe4, 0xb3, 0x8b, 0x11, 0xd5, 0x07, 0xd4, 0x89, 0x0c, 0x5f, 0xec, 0x5b, 0x0e, 0x8d, 0xfc, 0xe7,
-	0xa7, 0x97, 0xe7, 0x99, 0xeb, 0x95, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb0, 0xf7, 0x58, 0xc2,
-	0x13, 0x24, 0x00, 0x00,
+	// 2912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x73, 0x23, 0xc5,
+	0x15, 0xd7, 0xa7, 0x2d, 0x3d, 0x7d, 0x58, 0xee, 0xf5, 0x7a, 0xb5, 0x62, 0xb1, 0x97, 0xa1, 0x80,
+	0x65, 0x03, 0x36, 0x98, 0x62, 0x03, 0x45, 0x3e, 0xb0, 0xb4, 0x5a, 0x64, 0xd6, 0xd8, 0x4e, 0x5b,
+	0xbb, 0xe4, 0x8b, 0x1d, 0x5a, 0x9a, 0xb6, 0x34, 0xac, 0x34, 0x33, 0xcc, 0x8c, 0x8c, 0xbd, 0xc7,
+	0x24, 0x27, 0x72, 0x08, 0xc7, 0x5c, 0xf8, 0x3f, 0x72, 0x49, 0x2e, 0xa9, 0x54, 0x51, 0x95, 0x0b,
+	0x97, 0x54, 0xe5, 0x44, 0x52, 0x70, 0xcb, 0x2d, 0xa7, 0x9c, 0x52, 0x95, 0xea, 0xaf, 0xd1, 0x8c,
+	0xa4, 0xb1, 0xe4, 0x90, 0x5b, 0x6e, 0xd3, 0x6f, 0xde, 0x7b, 0xd3, 0xfd, 0xd4, 0xfd, 0x7b, 0xbf,
+	0xf7, 0x5a, 0xf0, 0x94, 0x4f, 0x2d, 0x83, 0xba, 0x43, 0xd3, 0xf2, 0xb7, 0x49, 0xa7, 0x6b, 0x6e,
+	0xfb, 0xe7, 0x0e, 0xf5, 0xb6, 0x1c, 0xd7, 0xf6, 0x6d, 0xb4, 0x32, 0x7e, 0xb9, 0xc5, 0x5e, 0xd6,
+	0x9e, 0x0e, 0x69, 0x77, 0xdd, 0x73, 0xc7, 0xb7, 0xb7, 0x1d, 0xd7, 0xb6, 0x4f, 0x84, 0x7e, 0xed,
+	0x46, 0xe8, 0x35, 0xf7, 0x13, 0xf6, 0x56, 0xbb, 0x31, 0x6d, 0xfc, 0x98, 0x9e, 0xab, 0xb7, 0x4f,
+	0x4f, 0xd9, 0x3a, 0xc4, 0x25, 0x43, 0xf5, 0x7a, 0xb3, 0x67, 0xdb, 0xbd, 0x01, 0xdd, 0xe6, 0xa3,
+	0xce, 0xe8, 0x64, 0xdb, 0x37, 0x87, 0xd4, 0xf3, 0xc9, 0xd0, 0x91, 0x0a, 0x6b, 0x3d, 0xbb, 0x67,
+	0xf3, 0xc7, 0x6d, 0xf6, 0x24, 0xa4, 0xda, 0xe7, 0x39, 0x58, 0xc6, 0xf4, 0xe3, 0x11, 0xf5, 0x7c,
+	0xb4, 0x03, 0x19, 0xda, 0xed, 0xdb, 0xd5, 0xe4, 0xcd, 0xe4, 0xad, 0xc2, 0xce, 0x8d, 0xad, 0x89,
+	0xc5, 0x6d, 0x49, 0xbd, 0x66, 0xb7, 0x6f, 0xb7, 0x12, 0x98, 0xeb, 0xa2, 0xd7, 0x21, 0x7b, 0x32,
+	0x18, 0x79, 0xfd, 0x6a, 0x8a, 0x1b, 0x3d, 0x1d, 0x67, 0x74, 0x8f, 0x29, 0xb5, 0x12, 0x58, 0x68,
+	0xb3, 0x4f, 0x99, 0xd6, 0x89, 0x5d, 0x4d, 0x5f, 0xfc, 0xa9, 0x3d, 0xeb, 0x84, 0x7f, 0x8a, 0xe9,
+	0xa2, 0x3a, 0x80, 0x69, 0x99, 0xbe, 0xde, 0xed, 0x13, 0xd3, 0xaa, 0x66, 0xb8, 0xe5, 0x33, 0xf1,
+	0x96, 0xa6, 0xdf, 0x60, 0x8a, 0xad, 0x04, 0xce, 0x9b, 0x6a, 0xc0, 0xa6, 0xfb, 0xf1, 0x88, 0xba,
+	0xe7, 0xd5, 0xec, 0xc5, 0xd3, 0xfd, 0x11, 0x53, 0x62, 0xd3, 0xe5, 0xda, 0xa8, 0x09, 0x85, 0x0e,
+	0xed, 0x99, 0x96, 0xde, 0x19, 0xd8, 0xdd, 0xc7, 0xd5, 0x25, 0x6e, 0xac, 0xc5, 0x19, 0xd7, 0x99,
+	0x6a, 0x9d, 0x69, 0xb6, 0x12, 0x18, 0x3a, 0xc1, 0x08, 0x7d, 0x0f, 0x72, 0xdd, 0x3e, 0xed, 0x3e,
+	0xd6, 0xfd, 0xb3, 0xea, 0x32, 0xf7, 0xb1, 0x19, 0xe7, 0xa3, 0xc1, 0xf4, 0xda, 0x67, 0xad, 0x04,
+	0x5e, 0xee, 0x8a, 0x47, 0xb6, 0x7e, 0x83, 0x0e, 0xcc, 0x53, 0xea, 0x32, 0xfb, 0xdc, 0xc5, 0xeb,
+	0xbf, 0x2b, 0x34, 0xb9, 0x87, 0xbc, 0xa1, 0x06, 0xe8, 0x87, 0x90, 0xa7, 0x96, 0x21, 0x97, 0x91,
+	0xe7, 0x2e, 0x6e, 0xc6, 0xfe, 0xce, 0x96, 0xa1, 0x16, 0x91, 0xa3, 0xf2, 0x19, 0xbd, 0x01, 0x4b,
+	0x5d, 0x7b, 0x38, 0x34, 0xfd, 0x2a, 0x70, 0xeb, 0x8d, 0xd8, 0x05, 0x70, 0xad, 0x56, 0x02, 0x4b,
+	0x7d, 0x74, 0x00, 0xe5, 0x81, 0xe9, 0xf9, 0xba, 0x67, 0x11, 0xc7, 0xeb, 0xdb, 0xbe, 0x57, 0x2d,
+	0x70, 0x0f, 0xcf, 0xc5, 0x79, 0xd8, 0x37, 0x3d, 0xff, 0x58, 0x29, 0xb7, 0x12, 0xb8, 0x34, 0x08,
+	0x0b, 0x98, 0x3f, 0xfb, 0xe4, 0x84, 0xba, 0x81, 0xc3, 0x6a, 0xf1, 0x62, 0x7f, 0x87, 0x4c, 0x5b,
+	0xd9, 0x33, 0x7f, 0x76, 0x58, 0x80, 0x7e, 0x06, 0x57, 0x06, 0x36, 0x31, 0x02, 0x77, 0x7a, 0xb7,
+	0x3f, 0xb2, 0x1e, 0x57, 0x4b, 0xdc, 0xe9, 0x8b, 0xb1, 0x93, 0xb4, 0x89, 0xa1, 0x5c, 0x34, 0x98,
+	0x41, 0x2b, 0x81, 0x57, 0x07, 0x93, 0x42, 0xf4, 0x08, 0xd6, 0x88, 0xe3, 0x0c, 0xce, 0x27, 0xbd,
+	0x97, 0xb9, 0xf7, 0xdb, 0x71, 0xde, 0x77, 0x99, 0xcd, 0xa4, 0x7b, 0x44, 0xa6, 0xa4, 0x2c, 0x18,
+	0x8e, 0x4b, 0x1d, 0xd7, 0xee, 0x52, 0xcf, 0xd3, 0xfd, 0x33, 0xaf, 0xba, 0x72, 0x71, 0x30, 0x8e,
+	0x02, 0xed, 0xf6, 0x19, 0x0f, 0xae, 0x13, 0x16, 0xd4, 0x97, 0x21, 0x7b, 0x4a, 0x06, 0x23, 0xaa,
+	0xbd, 0x00, 0x85, 0xd0, 0xb1, 0x47, 0x55, 0x58, 0x1e, 0x52, 0xcf, 0x23, 0x3d, 0xca, 0x51, 0x22,
+	0x8f, 0xd5, 0x50, 0x2b, 0x43, 0x31, 0x7c, 0xd4, 0xb5, 0xcf, 0x92, 0x50, 0x08, 0x9d, 0x62, 0x66,
+	0x79, 0x4a, 0x5d, 0xcf, 0xb4, 0x2d, 0x65, 0x29, 0x87, 0xe8, 0x59, 0x28, 0xf1, 0xfd, 0xa8, 0xab,
+	0xf7, 0x0c, 0x4a, 0x32, 0xb8, 0xc8, 0x85, 0x0f, 0xa5, 0xd2, 0x26, 0x14, 0x9c, 0x1d, 0x27, 0x50,
+	0x49, 0x73, 0x15, 0x70, 0x76, 0x1c, 0xa5, 0xf0, 0x0c, 0x14, 0xd9, 0xfa, 0x02, 0x8d, 0x0c, 0xff,
+	0x48, 0x81, 0xc9, 0xa4, 0x8a, 0xf6, 0xe7, 0x14, 0x54, 0x26, 0xe1, 0x01, 0xbd, 0x01, 0x19, 0x86,
+	0x94, 0x12, 0xf4, 0x6a, 0x5b, 0x02, 0x46, 0xb7, 0x14, 0x8c, 0x6e, 0xb5, 0x15, 0x8c, 0xd6, 0x73,
+	0x5f, 0x7c, 0xb5, 0x99, 0xf8, 0xec, 0x6f, 0x9b, 0x49, 0xcc, 0x2d, 0xd0, 0x75, 0x76, 0x9a, 0x89,
+	0x69, 0xe9, 0xa6, 0xc1, 0xa7, 0x9c, 0x67, 0x47, 0x95, 0x98, 0xd6, 0x9e, 0x81, 0xee, 0x43, 0xa5,
+	0x6b, 0x5b, 0x1e, 0xb5, 0xbc, 0x91, 0xa7, 0x0b, 0x98, 0xae, 0xa6, 0x63, 0x4e, 0x5b, 0x43, 0x29,
+	0x1e, 0x71, 0x3d, 0xbc, 0xd2, 0x8d, 0x0a, 0xd0, 0x3d, 0x80, 0x53, 0x32, 0x30, 0x0d, 0xe2, 0xdb,
+	0xae, 0x57, 0xcd, 0xdc, 0x4c, 0xcf, 0x74, 0xf3, 0x50, 0xa9, 0x3c, 0x70, 0x0c, 0xe2, 0xd3, 0x7a,
+	0x86, 0xcd, 0x16, 0x87, 0x2c, 0xd1, 0xf3, 0xb0, 0x42, 0x1c, 0x47, 0xf7, 0x7c, 0xe2, 0x53, 0xbd,
+	0x73, 0xee, 0x53, 0x8f, 0xa3, 0x60, 0x11, 0x97, 0x88, 0xe3, 0x1c, 0x33, 0x69, 0x9d, 0x09, 0xd1,
+	0x73, 0x50, 0x66, 0x80, 0x69, 0x92, 0x81, 0xde, 0xa7, 0x66, 0xaf, 0xef, 0x73, 0xbc, 0x4b, 0xe3,
+	0x92, 0x94, 0xb6, 0xb8, 0x50, 0x33, 0xa0, 0x18, 0x06, 0x4b, 0x84, 0x20, 0x63, 0x10, 0x9f, 0xf0,
+	0x40, 0x16, 0x31, 0x7f, 0x66, 0x32, 0x87, 0xf8, 0x7d, 0x19, 0x1e, 0xfe, 0x8c, 0xd6, 0x61, 0x49,
+	0xba, 0x4d, 0x73, 0xb7, 0x72, 0x84, 0xd6, 0x20, 0xeb, 0xb8, 0xf6, 0x29, 0xe5, 0xbf, 0x5c, 0x0e,
+	0x8b, 0x81, 0xf6, 0xab, 0x14, 0xac, 0x4e, 0xc1, 0x2a, 0xf3, 0xdb, 0x27, 0x5e, 0x5f, 0x7d, 0x8b,
+	0x3d, 0xa3, 0x3b, 0xcc, 0x2f, 0x31, 0xa8, 0x2b, 0x53, 0x51, 0x35, 0x1c, 0x22, 0x91, 0x66, 0x5b,
+	0xfc, 0xbd, 0x0c, 0x8d, 0xd4, 0x46, 0x87, 0x50, 0x19, 0x10, 0xcf, 0xd7, 0x05, 0x4c, 0xe9, 0xa1,
+	0xb4, 0x34, 0x0d, 0xce, 0xfb, 0x44, 0x01, 0x1b, 0xdb, 0xd3, 0xd2, 0x51, 0x79, 0x10, 0x91, 0x22,
+	0x0c, 0x6b, 0x9d, 0xf3, 0x27, 0xc4, 0xf2, 0x4d, 0x8b, 0xea, 0x53, 0xbf, 0xdc, 0xf5, 0x29, 0xa7,
+	0xcd, 0x53, 0xd3, 0xa0, 0x56, 0x57, 0xfd, 0x64, 0x57, 0x02, 0xe3, 0xe0, 0x27, 0xf5, 0x34, 0x0c,
+	0xe5, 0x68, 0x62, 0x40, 0x65, 0x48, 0xf9, 0x67, 0x32, 0x00, 0x29, 0xff, 0x0c, 0xbd, 0x02, 0x19,
+	0xb6, 0x48, 0xbe, 0xf8, 0xf2, 0x8c, 0x8c, 0x2a, 0xed, 0xda, 0xe7, 0x0e, 0xc5, 0x5c, 0x53, 0xd3,
+	0xa0, 0x32, 0x99, 0x2c, 0x26, 0xbd, 0x6a, 0x2f, 0xc2, 0xca, 0x44, 0x36, 0x08, 0xfd, 0x7e, 0xc9,
+	0xf0, 0xef, 0xa7, 0xad, 0x40, 0x29, 0x02, 0xfd, 0xda, 0x3a, 0xac, 0xcd, 0x42, 0x72, 0xad, 0x0f,
+	0x6b, 0xb3, 0x10, 0x19, 0xbd, 0x0e, 0xb9, 0x00, 0xca, 0xc5, 0x69, 0x9c, 0x8e, 0x95, 0x52, 0xc6,
+	0x81, 0x2a, 0x3b, 0x86, 0x6c, 0x5b, 0xf3, 0xfd, 0x90, 0xe2, 0x13, 0x5f, 0x26, 0x8e, 0xd3, 0x22,
+	0x5e, 0x5f, 0xfb, 0x10, 0xaa, 0x71, 0x30, 0x3d, 0xb1, 0x8c, 0x4c, 0xb0, 0x0d, 0xd7, 0x61, 0xe9,
+	0xc4, 0x76, 0x87, 0xc4, 0xe7, 0xce, 0x4a, 0x58, 0x8e, 0xd8, 0xf6, 0x14, 0x90, 0x9d, 0xe6, 0x62,
+	0x31, 0xd0, 0x74, 0xb8, 0x1e, 0x0b, 0xd5, 0xcc, 0xc4, 0xb4, 0x0c, 0x2a, 0xe2, 0x59, 0xc2, 0x62,
+	0x30, 0x76, 0x24, 0x26, 0x2b, 0x06, 0xec, 0xb3, 0x1e, 0x5f, 0x2b, 0xf7, 0x9f, 0xc7, 0x72, 0xa4,
+	0xdd, 0x82, 0xb5, 0x59, 0x88, 0x8d, 0x2a, 0x90, 0x66, 0x28, 0x9f, 0xbc, 0x99, 0xbe, 0x55, 0xc4,
+	0xec, 0x51, 0xfb, 0x67, 0x0e, 0x72, 0x98, 0x7a, 0x0e, 0x43, 0x0f, 0x54, 0x87, 0x3c, 0x3d, 0xeb,
+	0x52, 0xc7, 0x57, 0x78, 0x3b, 0x9b, 0xae, 0x08, 0xed, 0xa6, 0xd2, 0x64, 0x5c, 0x21, 0x30, 0x43,
+	0xaf, 0x49, 0x3a, 0x18, 0xcf, 0xec, 0xa4, 0x79, 0x98, 0x0f, 0xde, 0x51, 0x7c, 0x30, 0x1d, 0x4b,
+	0x0f, 0x84, 0xd5, 0x04, 0x21, 0x7c, 0x4d, 0x12, 0xc2, 0xcc, 0x9c, 0x8f, 0x45, 0x18, 0x61, 0x23,
+	0xc2, 0x08, 0xb3, 0x73, 0x96, 0x19, 0x43, 0x09, 0xef, 0x28, 0x4a, 0xb8, 0x34, 0x67, 0xc6, 0x13,
+	0x9c, 0xf0, 0x5e, 0x94, 0x13, 0x0a, 0x3e, 0xf7, 0x6c, 0xac, 0x75, 0x2c, 0x29, 0xfc, 0x7e, 0x88,
+	0x14, 0xe6, 0x62, 0x19, 0x99, 0x70, 0x32, 0x83, 0x15, 0x36, 0x22, 0xac, 0x30, 0x3f, 0x27, 0x06,
+	0x31, 0xb4, 0xf0, 0xed, 0x30, 0x2d, 0x84, 0x58, 0x66, 0x29, 0x7f, 0xef, 0x59, 0xbc, 0xf0, 0xcd,
+	0x80, 0x17, 0x16, 0x62, 0x89, 0xad, 0x5c, 0xc3, 0x24, 0x31, 0x3c, 0x9c, 0x22, 0x86, 0x82, 0xc8,
+	0x3d, 0x1f, 0xeb, 0x62, 0x0e, 0x33, 0x3c, 0x9c, 0x62, 0x86, 0xa5, 0x39, 0x0e, 0xe7, 0x50, 0xc3,
+	0x9f, 0xcf, 0xa6, 0x86, 0xf1, 0xe4, 0x4d, 0x4e, 0x73, 0x31, 0x6e, 0xa8, 0xc7, 0x70, 0x43, 0xc1,
+	0xe0, 0xbe, 0x13, 0xeb, 0x7e, 0x61, 0x72, 0x78, 0x38, 0x45, 0x0e, 0x2b, 0x73, 0xe2, 0xb1, 0x28,
+	0x3b, 0x7c, 0x11, 0x56, 0x95, 0x49, 0x00, 0x22, 0x0c, 0xe0, 0xa8, 0xeb, 0xda, 0xae, 0xe4, 0x79,
+	0x62, 0xa0, 0xdd, 0x82, 0x62, 0xa0, 0x7a, 0x31, 0x93, 0xe4, 0x89, 0x24, 0x04, 0x12, 0xda, 0xef,
+	0x92, 0x50, 0x0c, 0x9f, 0xff, 0x08, 0xd5, 0xc8, 0x4b, 0xaa, 0x11, 0xe2, 0x97, 0xa9, 0x28, 0xbf,
+	0xdc, 0x84, 0x02, 0x4b, 0x10, 0x13, 0xd4, 0x91, 0x38, 0x01, 0x75, 0xbc, 0x0d, 0xab, 0x9c, 0x01,
+	0x08, 0x16, 0x2a, 0xb3, 0x42, 0x86, 0x27, 0xb7, 0x15, 0xf6, 0x42, 0xec, 0x76, 0x2e, 0x46, 0x2f,
+	0xc3, 0x95, 0x90, 0x6e, 0x90, 0x78, 0x04, 0x91, 0xaa, 0x04, 0xda, 0xbb, 0x32, 0x03, 0xfd, 0x31,
+	0x09, 0xab, 0x53, 0xf8, 0x33, 0x93, 0x1e, 0x26, 0xff, 0x37, 0xf4, 0x30, 0xf5, 0x5f, 0xd3, 0xc3,
+	0x70, 0x1e, 0x4d, 0x47, 0xf3, 0xe8, 0xbf, 0x92, 0x50, 0x8a, 0xa0, 0x20, 0xfb, 0x05, 0xba, 0xb6,
+	0x41, 0x65, 0x66, 0xe3, 0xcf, 0x2c, 0x25, 0x0d, 0xec, 0x9e, 0xcc, 0x5f, 0xec, 0x91, 0x69, 0x05,
+	0xa0, 0x9e, 0x97, 0x98, 0x1d, 0x24, 0xc5, 0x2c, 0x0f, 0xb0, 0x18, 0x30, 0xdb, 0xc7, 0x54, 0x40,
+	0x70, 0x11, 0xb3, 0x47, 0xb4, 0x26, 0xf7, 0x18, 0x07, 0xd6, 0x22, 0x16, 0x03, 0xf4, 0x06, 0xe4,
+	0x79, 0x3f, 0x45, 0xb7, 0x1d, 0x4f, 0xa2, 0xe5, 0x53, 0xe1, 0xb5, 0x8a, 0xb6, 0xc9, 0xd6, 0x11,
+	0xd3, 0x39, 0x74, 0x3c, 0x9c, 0x73, 0xe4, 0x53, 0x28, 0xdf, 0xe7, 0x23, 0xb4, 0xf3, 0x06, 0xe4,
+	0xd9, 0xec, 0x3d, 0x87, 0x74, 0x29, 0x87, 0xbe, 0x3c, 0x1e, 0x0b, 0xb4, 0x47, 0x80, 0xa6, 0x01,
+	0x1c, 0xb5, 0x60, 0x89, 0x9e, 0x52, 0xcb, 0x17, 0xf9, 0xb7, 0xb0, 0xb3, 0x3e, 0x83, 0xd3, 0x51,
+	0xcb, 0xaf, 0x57, 0x59, 0x90, 0xff, 0xf1, 0xd5, 0x66, 0x45, 0x68, 0xbf, 0x64, 0x0f, 0x4d, 0x9f,
+	0x0e, 0x1d, 0xff, 0x1c, 0x4b, 0x7b, 0xed, 0x97, 0x29, 0x58, 0x51, 0x1f, 0x50, 0xcc, 0x6e, 0x56,
+	0x6c, 0xd5, 0x8e, 0x4f, 0x85, 0xc8, 0xf5, 0x62, 0xf1, 0xde, 0x00, 0xe8, 0x11, 0x4f, 0xff, 0x84,
+	0x58, 0x3e, 0x35, 0x64, 0xd0, 0x43, 0x12, 0x54, 0x83, 0x1c, 0x1b, 0x8d, 0x3c, 0x6a, 0x48, 0x9e,
+	0x1f, 0x8c, 0x43, 0xeb, 0x5c, 0xfe, 0x76, 0xeb, 0x8c, 0x46, 0x39, 0x37, 0x19, 0xe5, 0xdf, 0xa7,
+	0x60, 0x75, 0x2a, 0x43, 0xfd, 0xff, 0xc5, 0x01, 0xdd, 0x81, 0x6b, 0xa6, 0xe5, 0x53, 0x77, 0x48,
+	0x0d, 0x93, 0x15, 0x69, 0xa2, 0x54, 0x73, 0x6d, 0x5b, 0x6c, 0xda, 0x22, 0xbe, 0x1a, 0x7e, 0xcd,
+	0x4b, 0x36, 0x6c, 0xdb, 0xbe, 0xf6, 0x6b, 0x5e, 0xd8, 0x46, 0xb3, 0x33, 0x3a, 0x86, 0xd5, 0xe0,
+	0x74, 0xeb, 0x23, 0x7e, 0xea, 0xd5, 0x7e, 0x5d, 0x14, 0x1e, 0x2a, 0xa7, 0x51, 0xb1, 0x87, 0x7e,
+	0x0c, 0xd7, 0x26, 0x90, 0x2b, 0x70, 0x9d, 0x5a, 0x10, 0xc0, 0xae, 0x46, 0x01, 0x4c, 0x79, 0x1e,
+	0xc7, 0x38, 0xfd, 0x2d, 0xcf, 0xd4, 0x1e, 0x94, 0x55, 0x30, 0x04, 0xd7, 0x98, 0xb9, 0x6b, 0x9e,
+	0x85, 0x92, 0x4b, 0x7d, 0x56, 0xbe, 0x47, 0xaa, 0xd1, 0xa2, 0x10, 0xca, 0x1a, 0xf7, 0x08, 0xae,
+	0xce, 0xe4, 0x1c, 0xe8, 0xbb, 0x90, 0x1f, 0xd3, 0x95, 0x64, 0x4c, 0x61, 0xa7, 0xd4, 0xf1, 0x58,
+	0x57, 0xfb, 0x43, 0x12, 0xae, 0xce, 0x64, 0x1d, 0xa8, 0x09, 0x4b, 0x2e, 0xf5, 0x46, 0x03, 0x51,
+	0x90, 0x94, 0x77, 0x5e, 0x5e, 0x8c, 0xad, 0x30, 0xe9, 0x68, 0xe0, 0x63, 0x69, 0xac, 0x3d, 0x82,
+	0x25, 0x21, 0x41, 0x05, 0x58, 0x7e, 0x70, 0x70, 0xff, 0xe0, 0xf0, 0xfd, 0x83, 0x4a, 0x02, 0x01,
+	0x2c, 0xed, 0x36, 0x1a, 0xcd, 0xa3, 0x76, 0x25, 0x89, 0xf2, 0x90, 0xdd, 0xad, 0x1f, 0xe2, 0x76,
+	0x25, 0xc5, 0xc4, 0xb8, 0xf9, 0x6e, 0xb3, 0xd1, 0xae, 0xa4, 0xd1, 0x2a, 0x94, 0xc4, 0xb3, 0x7e,
+	0xef, 0x10, 0xbf, 0xb7, 0xdb, 0xae, 0x64, 0x42, 0xa2, 0xe3, 0xe6, 0xc1, 0xdd, 0x26, 0xae, 0x64,
+	0xb5, 0x57, 0xe1, 0xba, 0x9a, 0xc7, 0x74, 0x51, 0x15, 0xd4, 0x36, 0xc9, 0x50, 0x6d, 0xa3, 0xfd,
+	0x36, 0x05, 0xb5, 0x78, 0xd2, 0x82, 0xde, 0x9d, 0x58, 0xf8, 0xce, 0x25, 0x18, 0xcf, 0xc4, 0xea,
+	0x59, 0xef, 0xc2, 0xa5, 0x27, 0xd4, 0xef, 0xf6, 0x05, 0x89, 0x12, 0x09, 0xb1, 0x84, 0x4b, 0x52,
+	0xca, 0x8d, 0x3c, 0xa1, 0xf6, 0x11, 0xed, 0xfa, 0xba, 0x28, 0xb3, 0xc4, 0xa6, 0xcb, 0xe3, 0x92,
+	0x90, 0x1e, 0x0b, 0xa1, 0xf6, 0xe1, 0xa5, 0x62, 0x99, 0x87, 0x2c, 0x6e, 0xb6, 0xf1, 0x4f, 0x2a,
+	0x69, 0x84, 0xa0, 0xcc, 0x1f, 0xf5, 0xe3, 0x83, 0xdd, 0xa3, 0xe3, 0xd6, 0x21, 0x8b, 0xe5, 0x15,
+	0x58, 0x51, 0xb1, 0x54, 0xc2, 0xac, 0xf6, 0xa7, 0xd0, 0x76, 0x98, 0x53, 0xe0, 0xa1, 0x3b, 0x90,
+	0x93, 0x14, 0x49, 0x1d, 0xb6, 0xda, 0x74, 0x8b, 0xe3, 0x3d, 0xa9, 0x81, 0x03, 0x5d, 0xd4, 0x81,
+	0x6a, 0x0c, 0xaa, 0xa8, 0xa6, 0xd4, 0xad, 0x69, 0x3f, 0x7b, 0xb3, 0x80, 0xc6, 0xc3, 0xeb, 0x33,
+	0x01, 0xc8, 0xd3, 0xfe, 0x92, 0x82, 0x95, 0x89, 0x83, 0x8e, 0x76, 0x20, 0x2b, 0x0a, 0x8a, 0xb8,
+	0xfb, 0x04, 0x8e, 0x53, 0x42, 0x19, 0x67, 0x3b, 0xaa, 0x43, 0x4e, 0x65, 0x3b, 0x64, 0x16, 0xa0,
+	0x88, 0xb9, 0xa9, 0x86, 0x89, 0x34, 0x0d, 0x2c, 0x58, 0x77, 0x3b, 0x40, 0xac, 0x6a, 0x7a, 0xba,
+	0x8c, 0x11, 0xe6, 0x01, 0xd6, 0x49, 0xfb, 0xb1, 0x0d, 0x7a, 0x73, 0x4c, 0x22, 0x33, 0xd3, 0x65,
+	0x8c, 0x34, 0x17, 0x0a, 0xd2, 0x58, 0xe9, 0xa3, 0x36, 0xac, 0x32, 0x5c, 0xd1, 0xc9, 0x29, 0x31,
+	0x07, 0xa4, 0x63, 0x0e, 0x4c, 0x5f, 0xdd, 0x32, 0xbc, 0x30, 0xb5, 0xf2, 0xbb, 0xc4, 0x27, 0xbb,
+	0x21, 0x45, 0xe9, 0xac, 0x62, 0x4c, 0xc8, 0xb5, 0x06, 0x14, 0x42, 0x51, 0x42, 0x4f, 0x41, 0x7e,
+	0x48, 0xce, 0x64, 0xf3, 0x4e, 0xb4, 0x5f, 0x72, 0x43, 0x72, 0x26, 0xfa, 0x76, 0xd7, 0x60, 0x99,
+	0xbd, 0xec, 0x11, 0xb1, 0x3d, 0xd2, 0x78, 0x69, 0x48, 0xce, 0xde, 0x21, 0xac, 0xe1, 0xb2, 0x3e,
+	0xfb, 0x83, 0xac, 0x25, 0xc8, 0x4c, 0xbc, 0x8f, 0x47, 0xc4, 0xa5, 0xba, 0x67, 0x3e, 0x51, 0xd9,
+	0xb6, 0x34, 0x24, 0x67, 0xc7, 0x5c, 0x7a, 0x6c, 0x3e, 0xa1, 0x5c, 0xcf, 0xb4, 0x22, 0x7a, 0x29,
+	0xa9, 0x67, 0x5a, 0x63, 0x3d, 0xed, 0x03, 0x28, 0x47, 0x5b, 0x64, 0x0c, 0x11, 0x5c, 0x7b, 0x64,
+	0x19, 0xdc, 0x6f, 0x16, 0x8b, 0x01, 0xbb, 0x86, 0x39, 0xb5, 0x45, 0xd2, 0x98, 0x0d, 0x9d, 0x0f,
+	0x6d, 0x9f, 0x86, 0x5a, 0x6c, 0x42, 0x5b, 0x7b, 0x02, 0x59, 0x9e, 0x04, 0x18, 0xa0, 0xf3, 0x66,
+	0x97, 0x2c, 0x00, 0xd8, 0x33, 0xfa, 0x00, 0x80, 0xf8, 0xbe, 0x6b, 0x76, 0x46, 0x63, 0xc7, 0x9b,
+	0xb3, 0x93, 0xc8, 0xae, 0xd2, 0xab, 0xdf, 0x90, 0xd9, 0x64, 0x6d, 0x6c, 0x1a, 0xca, 0x28, 0x21,
+	0x87, 0xda, 0x01, 0x94, 0xa3, 0xb6, 0x8a, 0xb3, 0x26, 0x67, 0x70, 0xd6, 0x54, 0x98, 0xb3, 0x06,
+	0x8c, 0x37, 0x2d, 0x1a, 0x9b, 0x7c, 0xa0, 0x7d, 0x9a, 0x84, 0x5c, 0xfb, 0x4c, 0xc2, 0x4b, 0x4c,
+	0x4f, 0x6d, 0x6c, 0x9a, 0x0a, 0x77, 0x90, 0x44, 0x93, 0x2e, 0x1d, 0xb4, 0xfe, 0xde, 0x0e, 0x00,
+	0x34, 0xb3, 0x68, 0xf9, 0xaf, 0x7a, 0xa0, 0x32, 0x69, 0xbc, 0x05, 0xf9, 0xe0, 0x54, 0xb0, 0x4a,
+	0x8a, 0x18, 0x86, 0x4b, 0x3d, 0x4f, 0xae, 0x4d, 0x0d, 0xd9, 0x74, 0x1c, 0xfb, 0x13, 0xd9, 0xa3,
+	0x4a, 0x63, 0x31, 0xd0, 0x0c, 0x58, 0x99, 0xa0, 0x0f, 0xe8, 0x2d, 0x58, 0x76, 0x46, 0x1d, 0x5d,
+	0x85, 0x67, 0xe2, 0xf0, 0x2b, 0x92, 0x3e, 0xea, 0x0c, 0xcc, 0xee, 0x7d, 0x7a, 0xae, 0x26, 0xe3,
+	0x8c, 0x3a, 0xf7, 0x45, 0x14, 0xc5, 0x57, 0x52, 0xe1, 0xaf, 0x9c, 0x42, 0x4e, 0x6d, 0x0a, 0xf4,
+	0x83, 0xf0, 0x39, 0x4f, 0x4e, 0x43, 0x61, 0x94, 0xd2, 0x48, 0xf7, 0x63, 0x13, 0x56, 0xf0, 0x79,
+	0x66, 0xcf, 0xa2, 0x86, 0x3e, 0xae, 0xe5, 0xf8, 0xd7, 0x72, 0x78, 0x45, 0xbc, 0xd8, 0x57, 0x85,
+	0x9c, 0xf6, 0xef, 0x24, 0xe4, 0x14, 0xe0, 0xa0, 0x57, 0x43, 0xfb, 0xae, 0x3c, 0xa3, 0x4b, 0xa5,
+	0x14, 0xc7, 0x5d, 0xd6, 0xe8, 0x5c, 0x53, 0x97, 0x9f, 0x6b, 0x5c, 0xbb, 0x5c, 0xdd, 0x5b, 0x64,
+	0x2e, 0x7d, 0x6f, 0xf1, 0x12, 0x20, 0xdf, 0xf6, 0xc9, 0x40, 0x3f, 0xb5, 0x7d, 0xd3, 0xea, 0xe9,
+	0x22, 0xd8, 0x82, 0x11, 0x57, 0xf8, 0x9b, 0x87, 0xfc, 0xc5, 0x11, 0x8f, 0xfb, 0x2f, 0x92, 0x90,
+	0x0b, 0x38, 0xca, 0x65, 0x9b, 0xa6, 0xeb, 0xb0, 0x24, 0xd3, 0xb0, 0xe8, 0x9a, 0xca, 0x51, 0xd0,
+	0xbf, 0xcf, 0x84, 0xfa, 0xf7, 0x35, 0x96, 0xde, 0x7c, 0xc2, 0x89, 0x9a, 0x28, 0xa7, 0x83, 0xf1,
+	0xed, 0x37, 0xa1, 0x10, 0xea, 0x5f, 0xb3, 0x93, 0x77, 0xd0, 0x7c, 0xbf, 0x92, 0xa8, 0x2d, 0x7f,
+	0xfa, 0xf9, 0xcd, 0xf4, 0x01, 0xfd, 0x84, 0xed, 0x59, 0xdc, 0x6c, 0xb4, 0x9a, 0x8d, 0xfb, 0x95,
+	0x64, 0xad, 0xf0, 0xe9, 0xe7, 0x37, 0x97, 0x31, 0xe5, 0x1d, 0xb2, 0xdb, 0x2d, 0x28, 0x86, 0x7f,
+	0x95, 0x68, 0x26, 0x47, 0x50, 0xbe, 0xfb, 0xe0, 0x68, 0x7f, 0xaf, 0xb1, 0xdb, 0x6e, 0xea, 0x0f,
+	0x0f, 0xdb, 0xcd, 0x4a, 0x12, 0x5d, 0x83, 0x2b, 0xfb, 0x7b, 0xef, 0xb4, 0xda, 0x7a, 0x63, 0x7f,
+	0xaf, 0x79, 0xd0, 0xd6, 0x77, 0xdb, 0xed, 0xdd, 0xc6, 0xfd, 0x4a, 0x6a, 0xe7, 0x37, 0x00, 0x2b,
+	0xbb, 0xf5, 0xc6, 0x1e, 0x63, 0x21, 0x66, 0x97, 0xf0, 0x5e, 0x47, 0x03, 0x32, 0xbc, 0x9b, 0x71,
+	0xe1, 0x65, 0x79, 0xed, 0xe2, 0xde, 0x29, 0xba, 0x07, 0x59, 0xde, 0xe8, 0x40, 0x17, 0xdf, 0x9e,
+	0xd7, 0xe6, 0x34, 0x53, 0xd9, 0x64, 0xf8, 0xf1, 0xb8, 0xf0, 0x3a, 0xbd, 0x76, 0x71, 0x6f, 0x15,
+	0x61, 0xc8, 0x8f, 0x4b, 0xb0, 0xf9, 0xd7, 0xcb, 0xb5, 0x05, 0xc0, 0x06, 0xed, 0xc3, 0xb2, 0x2a,
+	0x6e, 0xe7, 0x5d, 0x78, 0xd7, 0xe6, 0x36, 0x3f, 0x59, 0xb8, 0x44, 0x13, 0xe2, 0xe2, 0xdb, 0xfb,
+	0xda, 0x9c, 0x4e, 0x2e, 0xda, 0x83, 0x25, 0x59, 0x1f, 0xcc, 0xb9, 0xc4, 0xae, 0xcd, 0x6b, 0x66,
+	0xb2, 0xa0, 0x8d, 0xbb, 0x3b, 0xf3, 0xff, 0x93, 0x50, 0x5b, 0xa0, 0x49, 0x8d, 0x1e, 0x00, 0x84,
+	0x5a, 0x0e, 0x0b, 0xfc, 0xd9, 0xa0, 0xb6, 0x48, 0xf3, 0x19, 0x1d, 0x42, 0x2e, 0x28, 0x11, 0xe7,
+	0x5e, 0xfd, 0xd7, 0xe6, 0x77, 0x81, 0xd1, 0x23, 0x28, 0x45, 0x6b, 0xa3, 0xc5, 0x2e, 0xf4, 0x6b,
+	0x0b, 0xb6, 0x77, 0x99, 0xff, 0x68, 0xa1, 0xb4, 0xd8, 0x05, 0x7f, 0x6d, 0xc1, 0x6e, 0x2f, 0xfa,
+	0x08, 0x56, 0xa7, 0x0b, 0x99, 0xc5, 0xef, 0xfb, 0x6b, 0x97, 0xe8, 0xff, 0xa2, 0x21, 0xa0, 0x19,
+	0x05, 0xd0, 0x25, 0xae, 0xff, 0x6b, 0x97, 0x69, 0x07, 0xb3, 0xd0, 0x45, 0x8b, 0x8a, 0xc5, 0xfe,
+	0x0e, 0x50, 0x5b, 0xb0, 0x31, 0x5c, 0x7f, 0xf7, 0x8b, 0xaf, 0x37, 0x92, 0x5f, 0x7e, 0xbd, 0x91,
+	0xfc, 0xfb, 0xd7, 0x1b, 0xc9, 0xcf, 0xbe, 0xd9, 0x48, 0x7c, 0xf9, 0xcd, 0x46, 0xe2, 0xaf, 0xdf,
+	0x6c, 0x24, 0x7e, 0xfa, 0x4a, 0xcf, 0xf4, 0xfb, 0xa3, 0xce, 0x56, 0xd7, 0x1e, 0x6e, 0x0f, 0xc8,
+	0x93, 0xf3, 0x01, 0x35, 0x7a, 0xd4, 0x0d, 0x3d, 0xbe, 0xdc, 0xb5, 0x5d, 0x1a, 0xfa, 0x43, 0x55,
+	0x67, 0x89, 0x67, 0xae, 0xd7, 0xfe, 0x33, 0x00, 0x98, 0x46, 0x3c, 0x1f, 0x70, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5668,6 +5756,13 @@ func (m *ResponseDeliverTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IntermediateStateRoot) > 0 {
+		i -= len(m.IntermediateStateRoot)
+		copy(dAtA[i:], m.IntermediateStateRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IntermediateStateRoot)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Codespace) > 0 {
 		i -= len(m.Codespace)
 		copy(dAtA[i:], m.Codespace)
@@ -5996,6 +6091,18 @@ func (m *ResponsePreprocessTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IntermediateStateRoots != nil {
+		{
+			size, err := m.IntermediateStateRoots.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Messages != nil {
 		{
 			size, err := m.Messages.MarshalToSizedBuffer(dAtA[:i])
@@ -6040,6 +6147,18 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DataAvailability != nil {
+		{
+			size, err := m.DataAvailability.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Version != nil {
 		{
 			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
@@ -6124,6 +6243,39 @@ func (m *BlockParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DataAvailabilityParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataAvailabilityParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataAvailabilityParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinSquareSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinSquareSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxSquareSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxSquareSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LastCommitInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6448,12 +6600,12 @@ func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n50, err50 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err50 != nil {
-		return 0, err50
+	n52, err52 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err52 != nil {
+		return 0, err52
 	}
-	i -= n50
-	i = encodeVarintTypes(dAtA, i, uint64(n50))
+	i -= n52
+	i = encodeVarintTypes(dAtA, i, uint64(n52))
 	i--
 	dAtA[i] = 0x22
 	if m.Height != 0 {
@@ -7409,6 +7561,10 @@ func (m *ResponseDeliverTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.IntermediateStateRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -7534,6 +7690,10 @@ func (m *ResponsePreprocessTxs) Size() (n int) {
 		l = m.Messages.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.IntermediateStateRoots != nil {
+		l = m.IntermediateStateRoots.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -7559,6 +7719,10 @@ func (m *ConsensusParams) Size() (n int) {
 		l = m.Version.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.DataAvailability != nil {
+		l = m.DataAvailability.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -7577,6 +7741,21 @@ func (m *BlockParams) Size() (n int) {
 	return n
 }
 
+func (m *DataAvailabilityParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSquareSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxSquareSize))
+	}
+	if m.MinSquareSize != 0 {
+		n += 1 + sovTypes(uint64(m.MinSquareSize))
+	}
+	return n
+}
+
 func (m *LastCommitInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12140,6 +12319,40 @@ func (m *ResponseDeliverTx) Unmarshal(dAtA []byte) error {
 			}
 			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediateStateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediateStateRoot = append(m.IntermediateStateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.IntermediateStateRoot == nil {
+				m.IntermediateStateRoot = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -12950,6 +13163,42 @@ func (m *ResponsePreprocessTxs) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediateStateRoots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IntermediateStateRoots == nil {
+				m.IntermediateStateRoots = &types1.IntermediateStateRoots{}
+			}
+			if err := m.IntermediateStateRoots.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -13147,6 +13396,42 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAvailability", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataAvailability == nil {
+				m.DataAvailability = &DataAvailabilityParams{}
+			}
+			if err := m.DataAvailability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -13262,6 +13547,97 @@ func (m *BlockParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DataAvailabilityParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataAvailabilityParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataAvailabilityParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSquareSize", wireType)
+			}
+			m.MaxSquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSquareSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSquareSize", wireType)
+			}
+			m.MinSquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSquareSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LastCommitInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
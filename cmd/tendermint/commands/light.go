@@ -13,6 +13,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
 
 	"github.com/lazyledger/lazyledger-core/crypto/merkle"
@@ -26,6 +28,7 @@ import (
 	lproxy "github.com/lazyledger/lazyledger-core/light/proxy"
 	lrpc "github.com/lazyledger/lazyledger-core/light/rpc"
 	dbs "github.com/lazyledger/lazyledger-core/light/store/db"
+	"github.com/lazyledger/lazyledger-core/p2p/ipld"
 	rpchttp "github.com/lazyledger/lazyledger-core/rpc/client/http"
 	rpcserver "github.com/lazyledger/lazyledger-core/rpc/jsonrpc/server"
 )
@@ -66,13 +69,14 @@ var (
 	dir                string
 	maxOpenConnections int
 
-	daSampling     bool
-	numSamples     uint32
-	sequential     bool
-	trustingPeriod time.Duration
-	trustedHeight  int64
-	trustedHash    []byte
-	trustLevelStr  string
+	daSampling         bool
+	samplingConfidence float64
+	samplingTimeout    time.Duration
+	sequential         bool
+	trustingPeriod     time.Duration
+	trustedHeight      int64
+	trustedHash        []byte
+	trustLevelStr      string
 
 	verbose bool
 
@@ -108,8 +112,10 @@ func init() {
 	LightCmd.Flags().BoolVar(&daSampling, "da-sampling", false,
 		"data availability sampling. Verify each header's data availability via sampling",
 	)
-	LightCmd.Flags().Uint32Var(&numSamples, "num-samples", 15,
-		"Number of data availability samples until block data deemed available.")
+	LightCmd.Flags().Float64Var(&samplingConfidence, "sampling-confidence", ipfs.DefaultConfig().SamplingConfidence,
+		"Confidence with which data availability sampling detects unavailable block data. Must be within (0, 1].")
+	LightCmd.Flags().DurationVar(&samplingTimeout, "sampling-timeout", ipfs.DefaultConfig().SamplingTimeout,
+		"Time within which all samples have to be retrieved for block data to be deemed available.")
 }
 
 func runProxy(cmd *cobra.Command, args []string) error {
@@ -126,31 +132,14 @@ func runProxy(cmd *cobra.Command, args []string) error {
 	chainID = args[0]
 	logger.Info("Creating client...", "chainID", chainID)
 
-	witnessesAddrs := []string{}
-	if witnessAddrsJoined != "" {
-		witnessesAddrs = strings.Split(witnessAddrsJoined, ",")
-	}
-
 	db, err := badgerdb.NewDB("light-client-db", dir)
 	if err != nil {
 		return fmt.Errorf("can't create a db: %w", err)
 	}
 
-	if primaryAddr == "" { // check to see if we can start from an existing state
-		var err error
-		primaryAddr, witnessesAddrs, err = checkForExistingProviders(db)
-		if err != nil {
-			return fmt.Errorf("failed to retrieve primary or witness from db: %w", err)
-		}
-		if primaryAddr == "" {
-			return errors.New("no primary address was provided nor found. Please provide a primary (using -p)." +
-				" Run the command: tendermint light --help for more information")
-		}
-	} else {
-		err := saveProviders(db, primaryAddr, witnessAddrsJoined)
-		if err != nil {
-			logger.Error("Unable to save primary and or witness addresses", "err", err)
-		}
+	witnessesAddrs, err := loadOrSaveProviders(db, logger)
+	if err != nil {
+		return err
 	}
 
 	trustLevel, err := tmmath.ParseFraction(trustLevelStr)
@@ -178,7 +167,10 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		}),
 	}
 
-	var ipfsCloser io.Closer
+	var (
+		ipfsCloser io.Closer
+		metricsSrv *http.Server
+	)
 	switch {
 	case daSampling:
 		cfg := ipfs.DefaultConfig()
@@ -189,38 +181,19 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return fmt.Errorf("could not start ipfs API: %w", err)
 		}
-		options = append(options, light.DataAvailabilitySampling(numSamples, ipfsNode.DAG))
+		var metrics *ipld.Metrics
+		metrics, metricsSrv = startLightMetrics(logger)
+		options = append(options,
+			light.DataAvailabilitySampling(samplingConfidence, samplingTimeout, ipfsNode.DAG),
+			light.IPLDMetrics(metrics),
+		)
 	case sequential:
 		options = append(options, light.SequentialVerification())
 	default:
 		options = append(options, light.SkippingVerification(trustLevel))
 	}
 
-	var c *light.Client
-	if trustedHeight > 0 && len(trustedHash) > 0 { // fresh installation
-		c, err = light.NewHTTPClient(
-			context.Background(),
-			chainID,
-			light.TrustOptions{
-				Period: trustingPeriod,
-				Height: trustedHeight,
-				Hash:   trustedHash,
-			},
-			primaryAddr,
-			witnessesAddrs,
-			dbs.New(db, chainID),
-			options...,
-		)
-	} else { // continue from latest state
-		c, err = light.NewHTTPClientFromTrustedStore(
-			chainID,
-			trustingPeriod,
-			primaryAddr,
-			witnessesAddrs,
-			dbs.New(db, chainID),
-			options...,
-		)
-	}
+	c, err := newLightClient(db, witnessesAddrs, options)
 	if err != nil {
 		return err
 	}
@@ -253,6 +226,9 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		if ipfsCloser != nil {
 			ipfsCloser.Close()
 		}
+		if metricsSrv != nil {
+			metricsSrv.Close()
+		}
 	})
 
 	logger.Info("Starting proxy...", "laddr", listenAddr)
@@ -264,6 +240,93 @@ func runProxy(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// startLightMetrics returns the metrics reported by the light client during
+// data availability sampling. If Prometheus is enabled in the config, they are
+// served on the configured address by the returned server, which is nil
+// otherwise.
+func startLightMetrics(logger log.Logger) (*ipld.Metrics, *http.Server) {
+	if !config.Instrumentation.Prometheus {
+		return ipld.NopMetrics(), nil
+	}
+
+	metrics := ipld.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", chainID)
+	srv := &http.Server{
+		Addr: config.Instrumentation.PrometheusListenAddr,
+		Handler: promhttp.InstrumentMetricHandler(
+			prometheus.DefaultRegisterer, promhttp.HandlerFor(
+				prometheus.DefaultGatherer,
+				promhttp.HandlerOpts{MaxRequestsInFlight: config.Instrumentation.MaxOpenConnections},
+			),
+		),
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+			// Error starting or closing listener:
+			logger.Error("Prometheus HTTP server ListenAndServe", "err", err)
+		}
+	}()
+	return metrics, srv
+}
+
+// loadOrSaveProviders saves the primary and witness addresses passed as flags
+// to the db. If no primary address was passed, it loads them from the db
+// instead. It returns the witness addresses.
+func loadOrSaveProviders(db dbm.DB, logger log.Logger) ([]string, error) {
+	witnessesAddrs := []string{}
+	if witnessAddrsJoined != "" {
+		witnessesAddrs = strings.Split(witnessAddrsJoined, ",")
+	}
+
+	if primaryAddr == "" { // check to see if we can start from an existing state
+		var err error
+		primaryAddr, witnessesAddrs, err = checkForExistingProviders(db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve primary or witness from db: %w", err)
+		}
+		if primaryAddr == "" {
+			return nil, errors.New("no primary address was provided nor found. Please provide a primary (using -p)." +
+				" Run the command: tendermint light --help for more information")
+		}
+	} else {
+		err := saveProviders(db, primaryAddr, witnessAddrsJoined)
+		if err != nil {
+			logger.Error("Unable to save primary and or witness addresses", "err", err)
+		}
+	}
+
+	return witnessesAddrs, nil
+}
+
+// newLightClient creates a light client for chainID connected to the primary
+// and witnesses. It is initialized from the trusted height and hash if given
+// and continues from the latest trusted state in the db otherwise.
+func newLightClient(db dbm.DB, witnessesAddrs []string, options []light.Option) (*light.Client, error) {
+	if trustedHeight > 0 && len(trustedHash) > 0 { // fresh installation
+		return light.NewHTTPClient(
+			context.Background(),
+			chainID,
+			light.TrustOptions{
+				Period: trustingPeriod,
+				Height: trustedHeight,
+				Hash:   trustedHash,
+			},
+			primaryAddr,
+			witnessesAddrs,
+			dbs.New(db, chainID),
+			options...,
+		)
+	}
+	// continue from latest state
+	return light.NewHTTPClientFromTrustedStore(
+		chainID,
+		trustingPeriod,
+		primaryAddr,
+		witnessesAddrs,
+		dbs.New(db, chainID),
+		options...,
+	)
+}
+
 func checkForExistingProviders(db dbm.DB) (string, []string, error) {
 	primaryBytes, err := db.Get(primaryKey)
 	if err != nil {
@@ -0,0 +1,174 @@
+package commands
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/lazyledger/lazyledger-core/ipfs"
+	"github.com/lazyledger/lazyledger-core/libs/db/badgerdb"
+	"github.com/lazyledger/lazyledger-core/libs/log"
+	tmos "github.com/lazyledger/lazyledger-core/libs/os"
+	"github.com/lazyledger/lazyledger-core/light"
+	"github.com/lazyledger/lazyledger-core/light/das"
+	rpcserver "github.com/lazyledger/lazyledger-core/rpc/jsonrpc/server"
+)
+
+// LightDASCmd runs a light node which continuously samples the data
+// availability of every new block.
+var LightDASCmd = &cobra.Command{
+	Use:   "light-das [chainID]",
+	Short: "Run a data availability sampling light node",
+	Long: `Run a data availability sampling light node.
+
+The node verifies every new header of the primary sequentially and samples the
+block data it commits to from the IPFS network using an embedded IPFS node,
+which runs the DHT in client mode. A header is only trusted once its data was
+deemed available. The result of sampling each height is persisted.
+
+Furthermore to the chainID, a fresh instance of a light node will need a
+primary RPC address, witness RPC addresses and a trusted hash and height. To
+restart the node, thereafter only the chainID is required.
+
+The following RPC endpoints are served:
+
+	/das_status                  progress of the node
+	/das_available?height=H      whether the data of height H is available
+
+If Prometheus is enabled in the instrumentation config, the sampling metrics are
+served on the configured address.
+`,
+	RunE: runLightDAS,
+	Args: cobra.ExactArgs(1),
+	Example: `light-das lazyledger-1 -p http://127.0.0.1:26657 -w http://127.0.0.1:26657
+	--height 1 --hash 28B97BE9F6DE51AC69F70E0B7BFD7E5C9CD1A595B7DC31AFF27C50D4948020CD`,
+}
+
+var (
+	dasListenAddr    string
+	dasDir           string
+	samplingInterval time.Duration
+)
+
+func init() {
+	LightDASCmd.Flags().StringVar(&dasListenAddr, "laddr", "tcp://localhost:8889",
+		"serve the RPC on the given address")
+	LightDASCmd.Flags().StringVarP(&primaryAddr, "primary", "p", "",
+		"connect to a Tendermint node at this address")
+	LightDASCmd.Flags().StringVarP(&witnessAddrsJoined, "witnesses", "w", "",
+		"tendermint nodes to cross-check the primary node, comma-separated")
+	LightDASCmd.Flags().StringVarP(&dasDir, "dir", "d", os.ExpandEnv(filepath.Join("$HOME", ".tendermint-light-das")),
+		"specify the directory")
+	LightDASCmd.Flags().IntVar(
+		&maxOpenConnections,
+		"max-open-connections",
+		900,
+		"maximum number of simultaneous connections (including WebSocket).")
+	LightDASCmd.Flags().DurationVar(&trustingPeriod, "trusting-period", 168*time.Hour,
+		"trusting period that headers can be verified within. Should be significantly less than the unbonding period")
+	LightDASCmd.Flags().Int64Var(&trustedHeight, "height", 1, "Trusted header's height")
+	LightDASCmd.Flags().BytesHexVar(&trustedHash, "hash", []byte{}, "Trusted header's hash")
+	LightDASCmd.Flags().BoolVar(&verbose, "verbose", false, "Verbose output")
+	LightDASCmd.Flags().Float64Var(&samplingConfidence, "sampling-confidence", ipfs.DefaultConfig().SamplingConfidence,
+		"Confidence with which data availability sampling detects unavailable block data. Must be within (0, 1].")
+	LightDASCmd.Flags().DurationVar(&samplingTimeout, "sampling-timeout", ipfs.DefaultConfig().SamplingTimeout,
+		"Time within which all samples have to be retrieved for block data to be deemed available.")
+	LightDASCmd.Flags().DurationVar(&samplingInterval, "sampling-interval", time.Second,
+		"interval in which the primary is polled for new headers to sample")
+}
+
+func runLightDAS(cmd *cobra.Command, args []string) error {
+	// Initialise logger.
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	var option log.Option
+	if verbose {
+		option, _ = log.AllowLevel("debug")
+	} else {
+		option, _ = log.AllowLevel("info")
+	}
+	logger = log.NewFilter(logger, option)
+
+	chainID = args[0]
+	logger.Info("Creating client...", "chainID", chainID)
+
+	db, err := badgerdb.NewDB("light-client-db", dasDir)
+	if err != nil {
+		return fmt.Errorf("can't create a db: %w", err)
+	}
+
+	witnessesAddrs, err := loadOrSaveProviders(db, logger)
+	if err != nil {
+		return err
+	}
+
+	ipfsCfg := ipfs.DefaultConfig()
+	ipfsCfg.RootDir = dasDir
+	// the node only samples, thus it does not need to serve DHT records
+	ipfsCfg.DHTClient = true
+	// TODO(ismail): share badger instance
+	ipfsNode, err := ipfs.Embedded(true, ipfsCfg, logger)()
+	if err != nil {
+		return fmt.Errorf("could not start ipfs node: %w", err)
+	}
+
+	metrics, metricsSrv := startLightMetrics(logger)
+	closeMetrics := func() {
+		if metricsSrv != nil {
+			_ = metricsSrv.Close()
+		}
+	}
+
+	c, err := newLightClient(db, witnessesAddrs, []light.Option{
+		light.Logger(logger),
+		light.DataAvailabilitySampling(samplingConfidence, samplingTimeout, ipfsNode.DAG),
+		light.IPLDMetrics(metrics),
+	})
+	if err != nil {
+		closeMetrics()
+		_ = ipfsNode.Close()
+		return err
+	}
+
+	daemon := das.NewDaemon(c, das.NewStore(db, chainID), samplingInterval, logger.With("module", "das"))
+	if err := daemon.Start(); err != nil {
+		_ = ipfsNode.Close()
+		closeMetrics()
+		return fmt.Errorf("failed to start sampling: %w", err)
+	}
+
+	cfg := rpcserver.DefaultConfig()
+	cfg.MaxOpenConnections = maxOpenConnections
+	listener, err := rpcserver.Listen(dasListenAddr, cfg)
+	if err != nil {
+		_ = daemon.Stop()
+		_ = ipfsNode.Close()
+		closeMetrics()
+		return err
+	}
+	mux := http.NewServeMux()
+	rpcserver.RegisterRPCFuncs(mux, das.RPCRoutes(daemon), logger.With("module", "rpc-server"))
+
+	// Stop upon receiving SIGTERM or CTRL-C.
+	tmos.TrapSignal(logger, func() {
+		listener.Close()
+		if err := daemon.Stop(); err != nil {
+			logger.Error("Failed to stop sampling", "err", err)
+		}
+		if err := ipfsNode.Close(); err != nil {
+			logger.Error("Failed to close ipfs node", "err", err)
+		}
+		closeMetrics()
+	})
+
+	logger.Info("Starting RPC server...", "laddr", dasListenAddr)
+	if err := rpcserver.Serve(listener, mux, logger, cfg); err != http.ErrServerClosed {
+		// Error starting or closing listener:
+		logger.Error("RPC ListenAndServe", "err", err)
+	}
+
+	return nil
+}
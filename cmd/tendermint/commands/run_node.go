@@ -101,6 +101,11 @@ func AddNodeFlags(cmd *cobra.Command) {
 		config.IPFS.ServeAPI,
 		"set this to expose IPFS API(useful for debugging)",
 	)
+	cmd.Flags().String(
+		"ipfs.remote-api",
+		config.IPFS.RemoteAPI,
+		"HTTP API address of an external IPFS daemon to use instead of the embedded IPFS node",
+	)
 	cmd.Flags().BoolVar(
 		&initIPFS,
 		"ipfs.init",
@@ -121,11 +126,11 @@ func NewRunNodeCmd(nodeProvider nm.Provider) *cobra.Command {
 				return err
 			}
 
-			n, err := nodeProvider(
-				config,
-				ipfs.Embedded(initIPFS, config.IPFS, logger),
-				logger,
-			)
+			ipfsProvider := ipfs.Embedded(initIPFS, config.IPFS, logger)
+			if config.IPFS.RemoteAPI != "" {
+				ipfsProvider = ipfs.Remote(config.IPFS, logger)
+			}
+			n, err := nodeProvider(config, ipfsProvider, logger)
 			if err != nil {
 				return fmt.Errorf("failed to create node: %w", err)
 			}
@@ -0,0 +1,139 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	blockstore "github.com/ipfs/go-ipfs-blockstore"
+	"github.com/spf13/cobra"
+
+	"github.com/lazyledger/lazyledger-core/ipfs"
+	"github.com/lazyledger/lazyledger-core/libs/db/badgerdb"
+	"github.com/lazyledger/lazyledger-core/p2p/ipld"
+	"github.com/lazyledger/lazyledger-core/store"
+	"github.com/lazyledger/lazyledger-core/types"
+)
+
+// ExportSquareCmd writes the extended data square of a height to a CAR file.
+var ExportSquareCmd = &cobra.Command{
+	Use:   "export-square [file]",
+	Short: "Export the block data square of a height to a CAR file",
+	Long: `Export the block data square of a height to a CAR file.
+
+All the NMT nodes of the row and column trees committed to by the
+DataAvailabilityHeader of the height are read from the IPFS repo and written to
+the file in the CARv1 format. The square can be loaded into the IPFS repo of
+another node with import-square.
+
+The node must be stopped, as its IPFS repo and block store are opened directly.
+`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    exportSquare,
+	Example: `export-square square.car --height 10`,
+}
+
+// ImportSquareCmd loads an extended data square from a CAR file into the
+// IPFS repo.
+var ImportSquareCmd = &cobra.Command{
+	Use:   "import-square [file]",
+	Short: "Import a block data square from a CAR file",
+	Long: `Import a block data square from a CAR file written by export-square.
+
+The NMT nodes contained in the file are checked against their CIDs and added to
+the IPFS repo. If a height is given, the square must match the
+DataAvailabilityHeader the block store holds for it.
+
+The node must be stopped, as its IPFS repo and block store are opened directly.
+`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    importSquare,
+	Example: `import-square square.car --height 10`,
+}
+
+var squareHeight int64
+
+func init() {
+	ExportSquareCmd.Flags().Int64Var(&squareHeight, "height", 0, "height of the square to export")
+	ImportSquareCmd.Flags().Int64Var(&squareHeight, "height", 0,
+		"height whose DataAvailabilityHeader the square is checked against, 0 skips the check")
+}
+
+func exportSquare(cmd *cobra.Command, args []string) error {
+	if squareHeight <= 0 {
+		return errors.New("height must be greater than 0")
+	}
+
+	ipfsNode, err := ipfs.Offline(config.IPFS)()
+	if err != nil {
+		return fmt.Errorf("could not open ipfs repo: %w", err)
+	}
+	defer ipfsNode.Close()
+
+	dah, err := loadDAHeader(ipfsNode.Blockstore, squareHeight)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(args[0])
+	if err != nil {
+		return err
+	}
+	if err := ipld.ExportSquare(context.Background(), ipfsNode.DAG, dah, f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	logger.Info("Exported square", "height", squareHeight, "dah", dah.Hash(), "file", args[0])
+	return nil
+}
+
+func importSquare(cmd *cobra.Command, args []string) error {
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	ipfsNode, err := ipfs.Offline(config.IPFS)()
+	if err != nil {
+		return fmt.Errorf("could not open ipfs repo: %w", err)
+	}
+	defer ipfsNode.Close()
+
+	var expected *types.DataAvailabilityHeader
+	if squareHeight > 0 {
+		expected, err = loadDAHeader(ipfsNode.Blockstore, squareHeight)
+		if err != nil {
+			return err
+		}
+	}
+
+	dah, err := ipld.ImportSquare(context.Background(), ipfsNode.DAG, f, expected)
+	if err != nil {
+		return err
+	}
+	logger.Info("Imported square", "dah", dah.Hash(), "file", args[0])
+	return nil
+}
+
+// loadDAHeader reads the DataAvailabilityHeader of the given height from the
+// block store of the node, whose block data is stored in the given IPFS
+// blockstore.
+func loadDAHeader(bstore blockstore.Blockstore, height int64) (*types.DataAvailabilityHeader, error) {
+	db, err := badgerdb.NewDB("blockstore", config.DBDir())
+	if err != nil {
+		return nil, fmt.Errorf("can't open block store: %w", err)
+	}
+	defer db.Close()
+
+	dah := store.NewBlockStore(db, bstore, logger).LoadDAHeader(height)
+	if dah == nil {
+		return nil, fmt.Errorf("no DataAvailabilityHeader found for height %d", height)
+	}
+	return dah, nil
+}
@@ -0,0 +1,290 @@
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	cs "github.com/lazyledger/lazyledger-core/consensus"
+	tmjson "github.com/lazyledger/lazyledger-core/libs/json"
+)
+
+// WALCmd contains the subcommands inspecting and repairing the consensus WAL.
+var WALCmd = &cobra.Command{
+	Use:   "wal",
+	Short: "Inspect and repair the consensus WAL",
+	Long: `Inspect and repair the consensus write-ahead log (WAL).
+
+All the files of the WAL, i.e. the head and the rotated files next to it, are
+read starting with the oldest one. The WAL of the node is used unless another
+one is given with --wal-file.
+
+The node must be stopped, as the WAL is read and modified directly.
+`,
+}
+
+var walInspectCmd = &cobra.Command{
+	Use:   "inspect",
+	Short: "List the heights and message types of the WAL",
+	Long: `List the heights of the WAL together with the number of messages of each type
+written for them. With --verbose, every message is listed with its position,
+time and type instead.`,
+	Args: cobra.NoArgs,
+	RunE: inspectWAL,
+}
+
+var walVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the checksums of all messages of the WAL",
+	Long: `Decode all messages of the WAL and verify their checksums. The position of
+each corrupted message is reported and the command fails if there is any.`,
+	Args: cobra.NoArgs,
+	RunE: verifyWAL,
+}
+
+var walTruncateCmd = &cobra.Command{
+	Use:   "truncate",
+	Short: "Truncate the corrupted tail of the WAL",
+	Long: `Truncate the corrupted tail a crash while writing leaves behind in the WAL.
+
+The head file of the WAL is truncated at its first corrupted message. The
+removed bytes are written to the backup file first, which must not exist yet.
+Corruptions before the tail are not repaired, as the messages following them
+would be lost.`,
+	Args: cobra.NoArgs,
+	RunE: truncateWAL,
+}
+
+var walToJSONCmd = &cobra.Command{
+	Use:   "to-json",
+	Short: "Convert the WAL to JSON",
+	Long: `Write the messages of the WAL to the standard output as JSON, one message per
+line. Each EndHeightMessage is followed by an "ENDHEIGHT <height>" line, which
+is ignored by from-json. Corrupted messages are skipped and make the command
+fail after all other messages have been written.`,
+	Args: cobra.NoArgs,
+	RunE: walToJSON,
+}
+
+var walFromJSONCmd = &cobra.Command{
+	Use:   "from-json [file]",
+	Short: "Convert JSON written by to-json to a WAL",
+	Long:  `Convert JSON written by to-json to a WAL. The WAL file must not exist yet.`,
+	Args:  cobra.ExactArgs(1),
+	RunE:  walFromJSON,
+}
+
+var (
+	walFile       string
+	walVerbose    bool
+	walDryRun     bool
+	walBackupFile string
+)
+
+func init() {
+	WALCmd.PersistentFlags().StringVar(&walFile, "wal-file", "",
+		"path to the head file of the WAL, defaults to the WAL of the node")
+	walInspectCmd.Flags().BoolVarP(&walVerbose, "verbose", "v", false, "list every message")
+	walTruncateCmd.Flags().BoolVar(&walDryRun, "dry-run", false, "only report what would be truncated")
+	walTruncateCmd.Flags().StringVar(&walBackupFile, "backup-file", "",
+		"file the truncated bytes are written to, defaults to the WAL file with the suffix .corrupted")
+
+	WALCmd.AddCommand(walInspectCmd, walVerifyCmd, walTruncateCmd, walToJSONCmd, walFromJSONCmd)
+}
+
+func walFilePath() string {
+	if walFile != "" {
+		return walFile
+	}
+	return config.Consensus.WalFile()
+}
+
+func inspectWAL(cmd *cobra.Command, args []string) error {
+	if walVerbose {
+		corruptions, err := cs.ScanWAL(walFilePath(), func(pos cs.WALPosition, msg *cs.TimedWALMessage) error {
+			fmt.Printf("%v\t%v\t%s\n", pos, msg.Time, cs.WALMessageType(msg.Msg))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		printWALCorruptions(os.Stdout, corruptions)
+		return nil
+	}
+
+	heights, corruptions, err := cs.InspectWAL(walFilePath())
+	if err != nil {
+		return err
+	}
+	for _, h := range heights {
+		counts := make([]string, 0, len(h.Messages))
+		total := 0
+		for typ, n := range h.Messages {
+			counts = append(counts, fmt.Sprintf("%s=%d", typ, n))
+			total += n
+		}
+		sort.Strings(counts)
+		ended := ""
+		if !h.Ended {
+			ended = " (not ended)"
+		}
+		fmt.Printf("height %d%s at %v: %d messages %s\n", h.Height, ended, h.Start, total, strings.Join(counts, " "))
+	}
+	printWALCorruptions(os.Stdout, corruptions)
+	return nil
+}
+
+func verifyWAL(cmd *cobra.Command, args []string) error {
+	var messages int
+	corruptions, err := cs.ScanWAL(walFilePath(), func(cs.WALPosition, *cs.TimedWALMessage) error {
+		messages++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	printWALCorruptions(os.Stdout, corruptions)
+	if len(corruptions) > 0 {
+		return fmt.Errorf("WAL is corrupted at %d positions, %d messages are valid", len(corruptions), messages)
+	}
+	fmt.Printf("WAL is valid, %d messages\n", messages)
+	return nil
+}
+
+func truncateWAL(cmd *cobra.Command, args []string) error {
+	path := walFilePath()
+	if walDryRun {
+		corruptions, err := cs.ScanWAL(path, nil)
+		if err != nil {
+			return err
+		}
+		printWALCorruptions(os.Stdout, corruptions)
+		if len(corruptions) == 0 {
+			fmt.Println("WAL is not corrupted, nothing to truncate")
+		}
+		return nil
+	}
+
+	backupPath := walBackupFile
+	if backupPath == "" {
+		backupPath = path + ".corrupted"
+	}
+	backup, err := os.OpenFile(backupPath, os.O_EXCL|os.O_WRONLY|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create backup file: %w", err)
+	}
+	corruption, err := cs.TruncateWAL(path, backup)
+	if cerr := backup.Close(); err == nil {
+		err = cerr
+	}
+	if corruption == nil {
+		// the backup is empty
+		_ = os.Remove(backupPath)
+	}
+	if err != nil {
+		return err
+	}
+
+	if corruption == nil {
+		fmt.Println("WAL is not corrupted, nothing to truncate")
+		return nil
+	}
+	logger.Info("Truncated corrupted tail of WAL", "at", corruption.WALPosition, "err", corruption.Err,
+		"backup", backupPath)
+	return nil
+}
+
+func walToJSON(cmd *cobra.Command, args []string) error {
+	out := bufio.NewWriter(os.Stdout)
+	corruptions, err := cs.ScanWAL(walFilePath(), func(_ cs.WALPosition, msg *cs.TimedWALMessage) error {
+		bz, err := tmjson.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("failed to marshal msg: %w", err)
+		}
+		if _, err := out.Write(append(bz, '\n')); err != nil {
+			return err
+		}
+		if m, ok := msg.Msg.(cs.EndHeightMessage); ok {
+			if _, err := fmt.Fprintf(out, "ENDHEIGHT %d\n", m.Height); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if ferr := out.Flush(); err == nil {
+		err = ferr
+	}
+	if err != nil {
+		return err
+	}
+	if len(corruptions) > 0 {
+		printWALCorruptions(os.Stderr, corruptions)
+		return errors.New("WAL is corrupted, the corrupted messages were skipped")
+	}
+	return nil
+}
+
+func walFromJSON(cmd *cobra.Command, args []string) error {
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wal, err := os.OpenFile(walFilePath(), os.O_EXCL|os.O_WRONLY|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create WAL file: %w", err)
+	}
+	out := bufio.NewWriter(wal)
+	err = encodeWALFromJSON(f, out)
+	if err == nil {
+		err = out.Flush()
+	}
+	if err == nil {
+		err = wal.Sync()
+	}
+	if cerr := wal.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// encodeWALFromJSON encodes the messages read from JSON written by to-json.
+func encodeWALFromJSON(rd io.Reader, wr io.Writer) error {
+	enc := cs.NewWALEncoder(wr)
+	// lines can be arbitrarily long, as they contain the block parts
+	br := bufio.NewReader(rd)
+	for line := 1; ; line++ {
+		bz, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		bz = bytes.TrimSpace(bz)
+		// the ENDHEIGHT lines are only for reading
+		if len(bz) > 0 && !bytes.HasPrefix(bz, []byte("ENDHEIGHT")) {
+			var msg cs.TimedWALMessage
+			if err := tmjson.Unmarshal(bz, &msg); err != nil {
+				return fmt.Errorf("failed to unmarshal line %d: %w", line, err)
+			}
+			if err := enc.Encode(&msg); err != nil {
+				return fmt.Errorf("failed to encode line %d: %w", line, err)
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+func printWALCorruptions(w io.Writer, corruptions []cs.WALCorruption) {
+	for _, c := range corruptions {
+		fmt.Fprintf(w, "corrupted message at %v: %v\n", c.WALPosition, c.Err)
+	}
+}
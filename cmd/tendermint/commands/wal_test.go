@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cs "github.com/lazyledger/lazyledger-core/consensus"
+	tmjson "github.com/lazyledger/lazyledger-core/libs/json"
+)
+
+func TestEncodeWALFromJSON(t *testing.T) {
+	now := time.Now().UTC()
+	msgs := []*cs.TimedWALMessage{
+		{Time: now, Msg: cs.EndHeightMessage{Height: 0}},
+		{Time: now.Add(time.Second), Msg: cs.EndHeightMessage{Height: 1}},
+	}
+
+	// JSON as written by to-json
+	var js bytes.Buffer
+	for _, msg := range msgs {
+		bz, err := tmjson.Marshal(msg)
+		require.NoError(t, err)
+		js.Write(append(bz, '\n'))
+		fmt.Fprintf(&js, "ENDHEIGHT %d\n", msg.Msg.(cs.EndHeightMessage).Height)
+	}
+
+	var wal bytes.Buffer
+	require.NoError(t, encodeWALFromJSON(&js, &wal))
+
+	dec := cs.NewWALDecoder(&wal)
+	for _, msg := range msgs {
+		decoded, err := dec.Decode()
+		require.NoError(t, err)
+		assert.True(t, msg.Time.Equal(decoded.Time))
+		assert.Equal(t, msg.Msg, decoded.Msg)
+	}
+	_, err := dec.Decode()
+	assert.Equal(t, io.EOF, err)
+
+	// invalid lines are reported
+	err = encodeWALFromJSON(bytes.NewBufferString("{}\nnot json\n"), &wal)
+	assert.Error(t, err)
+}
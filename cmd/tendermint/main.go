@@ -18,8 +18,12 @@ func main() {
 		cmd.InitFilesCmd,
 		cmd.ProbeUpnpCmd,
 		cmd.LightCmd,
+		cmd.LightDASCmd,
 		cmd.ReplayCmd,
 		cmd.ReplayConsoleCmd,
+		cmd.WALCmd,
+		cmd.ExportSquareCmd,
+		cmd.ImportSquareCmd,
 		cmd.ResetAllCmd,
 		cmd.ResetPrivValidatorCmd,
 		cmd.ShowValidatorCmd,
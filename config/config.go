@@ -141,6 +141,9 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Instrumentation.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [instrumentation] section: %w", err)
 	}
+	if err := cfg.IPFS.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [ipfs] section: %w", err)
+	}
 	return nil
 }
 
@@ -818,6 +821,14 @@ type ConsensusConfig struct {
 	PeerQueryMaj23SleepDuration time.Duration `mapstructure:"peer-query-maj23-sleep-duration"`
 
 	DoubleSignCheckHeight int64 `mapstructure:"double-sign-check-height"`
+
+	// Propose blocks by their DataAvailabilityHeader only: peers retrieve the
+	// block data from IPFS instead of receiving the block in parts
+	ProposeByDAH bool `mapstructure:"propose-by-dah"`
+
+	// Prevote nil for proposal blocks whose data is not sampled as available
+	// on the IPFS network within timeout-prevote
+	SampleAvailability bool `mapstructure:"sample-availability"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service
@@ -837,6 +848,8 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		PeerGossipSleepDuration:     100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
 		DoubleSignCheckHeight:       int64(0),
+		ProposeByDAH:                false,
+		SampleAvailability:          false,
 	}
 }
 
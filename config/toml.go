@@ -393,6 +393,17 @@ create-empty-blocks-interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
 peer-gossip-sleep-duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer-query-maj23-sleep-duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# Propose blocks by their DataAvailabilityHeader only. Instead of receiving
+# the block in parts, other nodes retrieve the block data from IPFS.
+# Nodes accept proposals made either way regardless of this setting.
+propose-by-dah = {{ .Consensus.ProposeByDAH }}
+
+# Prevote nil for proposal blocks whose data is not sampled as available on the
+# IPFS network. Sampling starts once the proposal is received and has to finish
+# within timeout-prevote (plus its delta for each round). The number of samples
+# depends on sampling-confidence of the [ipfs] section.
+sample-availability = {{ .Consensus.SampleAvailability }}
+
 #######################################################
 ###   Transaction Indexer Configuration Options     ###
 #######################################################
@@ -439,6 +450,41 @@ namespace = "{{ .Instrumentation.Namespace }}"
 # IPFS related configuration
 repo-path = "{{ .IPFS.RepoPath}}"
 serve-api = "{{ .IPFS.ServeAPI}}"
+
+# Minimum number of recent heights whose block data is kept in the IPFS repo
+# when blocks are pruned (see retain_height of the ABCI Commit response). This
+# allows serving samples for longer than the blocks are retained.
+# 0 - prune the block data together with the blocks.
+retain-blocks = {{ .IPFS.RetainBlocks }}
+
+# If true, the IPFS node only fetches records from the DHT and neither stores
+# nor serves them to other peers. Useful for nodes that only sample.
+dht-client = {{ .IPFS.DHTClient }}
+
+# Address of the HTTP API of an external IPFS daemon, e.g.
+# "/ip4/127.0.0.1/tcp/5001". If set, the daemon is used instead of an embedded
+# IPFS node and repo-path, serve-api and dht-client are ignored. The daemon
+# must have the NMT plugin loaded.
+remote-api = "{{ .IPFS.RemoteAPI }}"
+
+# Number of proposal blocks which can be queued or being put to IPFS at once.
+# Proposals are not published while the queue is full, see publish_info of the
+# status RPC endpoint for the backlog.
+publish-queue-size = {{ .IPFS.PublishQueueSize }}
+
+# Interval in which the block data of all retained heights is announced to the
+# DHT again, so that it remains discoverable after the provider records expire.
+# 0 - never announce the block data again.
+reprovide-interval = "{{ .IPFS.ReprovideInterval }}"
+
+# Confidence with which data availability sampling detects that block data is
+# unavailable, e.g. 0.9999. The number of samples is derived from it and the
+# size of the block data. Must be within (0, 1].
+sampling-confidence = {{ .IPFS.SamplingConfidence }}
+
+# Time within which all samples have to be retrieved for block data to be
+# deemed available.
+sampling-timeout = "{{ .IPFS.SamplingTimeout }}"
 `
 
 /****** these are for test settings ***********/
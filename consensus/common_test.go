@@ -406,7 +406,7 @@ func newStateWithConfigAndBlockStore(
 	}
 
 	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyAppConnCon, mempool, evpool)
-	cs := NewState(thisConfig.Consensus, state, blockExec, blockStore, mempool, dag, ipfs.MockRouting(), evpool)
+	cs := NewState(thisConfig.Consensus, state, blockExec, blockStore, mempool, dag, nil, evpool)
 	cs.SetLogger(log.TestingLogger().With("module", "consensus"))
 	cs.SetPrivValidator(pv)
 
@@ -830,7 +830,7 @@ func randGenesisState(numValidators int, randPower bool, minPower int64) (sm.Sta
 func newMockTickerFunc(onlyOnce bool) func() TimeoutTicker {
 	return func() TimeoutTicker {
 		return &mockTicker{
-			c:        make(chan timeoutInfo, 10),
+			c:        make(chan TimeoutInfo, 10),
 			onlyOnce: onlyOnce,
 		}
 	}
@@ -839,7 +839,7 @@ func newMockTickerFunc(onlyOnce bool) func() TimeoutTicker {
 // mock ticker only fires on RoundStepNewHeight
 // and only once if onlyOnce=true
 type mockTicker struct {
-	c chan timeoutInfo
+	c chan TimeoutInfo
 
 	mtx      sync.Mutex
 	onlyOnce bool
@@ -854,7 +854,7 @@ func (m *mockTicker) Stop() error {
 	return nil
 }
 
-func (m *mockTicker) ScheduleTimeout(ti timeoutInfo) {
+func (m *mockTicker) ScheduleTimeout(ti TimeoutInfo) {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 	if m.onlyOnce && m.fired {
@@ -866,7 +866,7 @@ func (m *mockTicker) ScheduleTimeout(ti timeoutInfo) {
 	}
 }
 
-func (m *mockTicker) Chan() <-chan timeoutInfo {
+func (m *mockTicker) Chan() <-chan TimeoutInfo {
 	return m.c
 }
 
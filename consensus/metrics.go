@@ -60,6 +60,12 @@ type Metrics struct {
 
 	// Number of blockparts transmitted by peer.
 	BlockParts metrics.Counter
+
+	// Time it took to sample the data of a proposal block.
+	ProposalSamplingSeconds metrics.Histogram
+	// Number of proposal blocks prevoted nil because their data was not
+	// sampled as available.
+	UnavailableProposals metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -186,6 +192,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "block_parts",
 			Help:      "Number of blockparts transmitted by peer.",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+		ProposalSamplingSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "proposal_sampling_seconds",
+			Help:      "Time it took to sample the data of a proposal block.",
+		}, labels).With(labelsAndValues...),
+		UnavailableProposals: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "unavailable_proposals",
+			Help:      "Number of proposal blocks prevoted nil because their data was not sampled as available.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -216,5 +234,8 @@ func NopMetrics() *Metrics {
 		FastSyncing:     discard.NewGauge(),
 		StateSyncing:    discard.NewGauge(),
 		BlockParts:      discard.NewCounter(),
+
+		ProposalSamplingSeconds: discard.NewHistogram(),
+		UnavailableProposals:    discard.NewCounter(),
 	}
 }
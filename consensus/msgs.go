@@ -87,6 +87,17 @@ func MsgToProto(msg Message) (*tmcons.Message, error) {
 				},
 			},
 		}
+	case *BlockHeaderMessage:
+		pb = tmcons.Message{
+			Sum: &tmcons.Message_BlockHeader{
+				BlockHeader: &tmcons.BlockHeader{
+					Height:     msg.Height,
+					Round:      msg.Round,
+					Header:     *msg.Header.ToProto(),
+					LastCommit: msg.LastCommit.ToProto(),
+				},
+			},
+		}
 	case *VoteMessage:
 		vote := msg.Vote.ToProto()
 		pb = tmcons.Message{
@@ -217,6 +228,21 @@ func MsgFromProto(msg *tmcons.Message) (Message, error) {
 			Round:  msg.BlockPart.Round,
 			Part:   parts,
 		}
+	case *tmcons.Message_BlockHeader:
+		header, err := types.HeaderFromProto(&msg.BlockHeader.Header)
+		if err != nil {
+			return nil, fmt.Errorf("block header msg to proto error: %w", err)
+		}
+		lastCommit, err := types.CommitFromProto(msg.BlockHeader.LastCommit)
+		if err != nil {
+			return nil, fmt.Errorf("block header msg to proto error: %w", err)
+		}
+		pb = &BlockHeaderMessage{
+			Height:     msg.BlockHeader.Height,
+			Round:      msg.BlockHeader.Round,
+			Header:     &header,
+			LastCommit: lastCommit,
+		}
 	case *tmcons.Message_Vote:
 		vote, err := types.VoteFromProto(msg.Vote.Vote)
 		if err != nil {
@@ -315,7 +341,7 @@ func WALToProto(msg WALMessage) (*tmcons.WALMessage, error) {
 				},
 			},
 		}
-	case timeoutInfo:
+	case TimeoutInfo:
 		pb = tmcons.WALMessage{
 			Sum: &tmcons.WALMessage_TimeoutInfo{
 				TimeoutInfo: &tmcons.TimeoutInfo{
@@ -371,7 +397,7 @@ func WALFromProto(msg *tmcons.WALMessage) (WALMessage, error) {
 		if err != nil {
 			return nil, fmt.Errorf("denying message due to possible overflow: %w", err)
 		}
-		pb = timeoutInfo{
+		pb = TimeoutInfo{
 			Duration: msg.TimeoutInfo.Duration,
 			Height:   msg.TimeoutInfo.Height,
 			Round:    msg.TimeoutInfo.Round,
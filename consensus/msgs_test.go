@@ -17,8 +17,10 @@ import (
 	"github.com/lazyledger/lazyledger-core/p2p"
 	tmcons "github.com/lazyledger/lazyledger-core/proto/tendermint/consensus"
 	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+	tmversion "github.com/lazyledger/lazyledger-core/proto/tendermint/version"
 	"github.com/lazyledger/lazyledger-core/types"
 	"github.com/lazyledger/lazyledger-core/types/consts"
+	"github.com/lazyledger/lazyledger-core/version"
 )
 
 func TestMsgToProto(t *testing.T) {
@@ -77,6 +79,18 @@ func TestMsgToProto(t *testing.T) {
 	require.NoError(t, err)
 	pbVote := vote.ToProto()
 
+	lastCommit := types.NewCommit(1, 0, bi, []types.CommitSig{vote.CommitSig()})
+	lastCommit.HeaderHash = tmrand.Bytes(tmhash.Size)
+	header := types.Header{
+		Version:         tmversion.Consensus{Block: version.BlockProtocol},
+		ChainID:         "chainID",
+		Height:          2,
+		Time:            time.Now(),
+		LastBlockID:     bi,
+		DataHash:        tmrand.Bytes(tmhash.Size),
+		ProposerAddress: pk.Address(),
+	}
+
 	testsCases := []struct {
 		testName string
 		msg      Message
@@ -131,6 +145,21 @@ func TestMsgToProto(t *testing.T) {
 				},
 			},
 		}, false},
+		{"successful BlockHeaderMessage", &BlockHeaderMessage{
+			Height:     2,
+			Round:      1,
+			Header:     &header,
+			LastCommit: lastCommit,
+		}, &tmcons.Message{
+			Sum: &tmcons.Message_BlockHeader{
+				BlockHeader: &tmcons.BlockHeader{
+					Height:     2,
+					Round:      1,
+					Header:     *header.ToProto(),
+					LastCommit: lastCommit.ToProto(),
+				},
+			},
+		}, false},
 		{"successful ProposalPOLMessage", &ProposalPOLMessage{
 			Height:           1,
 			ProposalPOLRound: 1,
@@ -275,7 +304,7 @@ func TestWALMsgProto(t *testing.T) {
 				},
 			},
 		}, false},
-		{"successful timeoutInfo", timeoutInfo{
+		{"successful timeoutInfo", TimeoutInfo{
 			Duration: time.Duration(100),
 			Height:   1,
 			Round:    1,
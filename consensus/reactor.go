@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"reflect"
@@ -317,6 +318,9 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			ps.SetHasProposalBlockPart(msg.Height, msg.Round, int(msg.Part.Index))
 			conR.Metrics.BlockParts.With("peer_id", string(src.ID())).Add(1)
 			conR.conS.peerMsgQueue <- msgInfo{msg, src.ID()}
+		case *BlockHeaderMessage:
+			ps.SetHasProposalBlockHeader(msg.Height, msg.Round)
+			conR.conS.peerMsgQueue <- msgInfo{msg, src.ID()}
 		default:
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
@@ -503,7 +507,8 @@ OUTER_LOOP:
 		prs := ps.GetRoundState()
 
 		// Send proposal Block parts?
-		if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) {
+		// Not if the proposal block is retrieved from IPFS, peers get its header instead.
+		if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) && !proposedByDAH(rs) {
 			if index, ok := rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.Copy()).PickRandom(); ok {
 				part := rs.ProposalBlockParts.GetPart(index)
 				msg := &BlockPartMessage{
@@ -580,12 +585,36 @@ OUTER_LOOP:
 			continue OUTER_LOOP
 		}
 
+		// Send the header of a proposal block to retrieve from IPFS?
+		// Peer must receive ProposalMessage first.
+		if proposedByDAH(rs) && prs.Proposal && !prs.ProposalBlockHeader {
+			msg := &BlockHeaderMessage{
+				Height:     rs.Height,
+				Round:      rs.Round,
+				Header:     rs.ProposalBlockHeader,
+				LastCommit: rs.ProposalLastCommit,
+			}
+			logger.Debug("Sending proposal block header", "height", prs.Height, "round", prs.Round)
+			if peer.Send(DataChannel, MustEncode(msg)) {
+				ps.SetHasProposalBlockHeader(rs.Height, rs.Round)
+			}
+			continue OUTER_LOOP
+		}
+
 		// Nothing to do. Sleep.
 		time.Sleep(conR.conS.config.PeerGossipSleepDuration)
 		continue OUTER_LOOP
 	}
 }
 
+// proposedByDAH returns true if the block of the current proposal is
+// retrieved from IPFS by its DataAvailabilityHeader instead of being gossiped
+// in parts. The header is only set for the current proposal once both match.
+func proposedByDAH(rs *cstypes.RoundState) bool {
+	return rs.Proposal != nil && rs.ProposalBlockHeader != nil &&
+		rs.ProposalBlockParts.HasHeader(rs.Proposal.BlockID.PartSetHeader)
+}
+
 func (conR *Reactor) gossipDataForCatchup(logger log.Logger, rs *cstypes.RoundState,
 	prs *cstypes.PeerRoundState, ps *PeerState, peer p2p.Peer) {
 
@@ -1023,6 +1052,18 @@ func (ps *PeerState) SetHasProposal(proposal *types.Proposal) {
 	ps.PRS.ProposalPOL = nil // Nil until ProposalPOLMessage received.
 }
 
+// SetHasProposalBlockHeader sets the given proposal block header as known for the peer.
+func (ps *PeerState) SetHasProposalBlockHeader(height int64, round int32) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.PRS.Height != height || ps.PRS.Round != round {
+		return
+	}
+
+	ps.PRS.ProposalBlockHeader = true
+}
+
 // InitProposalBlockParts initializes the peer's proposal block parts header and bit array.
 func (ps *PeerState) InitProposalBlockParts(partSetHeader types.PartSetHeader) {
 	ps.mtx.Lock()
@@ -1283,6 +1324,7 @@ func (ps *PeerState) ApplyNewRoundStepMessage(msg *NewRoundStepMessage) {
 	ps.PRS.StartTime = startTime
 	if psHeight != msg.Height || psRound != msg.Round {
 		ps.PRS.Proposal = false
+		ps.PRS.ProposalBlockHeader = false
 		ps.PRS.ProposalBlockPartSetHeader = types.PartSetHeader{}
 		ps.PRS.ProposalBlockParts = nil
 		ps.PRS.ProposalPOLRound = -1
@@ -1414,6 +1456,7 @@ func init() {
 	tmjson.RegisterType(&ProposalMessage{}, "tendermint/Proposal")
 	tmjson.RegisterType(&ProposalPOLMessage{}, "tendermint/ProposalPOL")
 	tmjson.RegisterType(&BlockPartMessage{}, "tendermint/BlockPart")
+	tmjson.RegisterType(&BlockHeaderMessage{}, "tendermint/BlockHeader")
 	tmjson.RegisterType(&VoteMessage{}, "tendermint/Vote")
 	tmjson.RegisterType(&HasVoteMessage{}, "tendermint/HasVote")
 	tmjson.RegisterType(&VoteSetMaj23Message{}, "tendermint/VoteSetMaj23")
@@ -1610,6 +1653,53 @@ func (m *BlockPartMessage) String() string {
 
 //-------------------------------------
 
+// BlockHeaderMessage is sent with a proposal to let peers retrieve the
+// proposed block data from IPFS by the proposal's DataAvailabilityHeader,
+// instead of receiving the block in parts. The header and the last commit
+// are not part of the block data and so are sent along.
+type BlockHeaderMessage struct {
+	Height     int64
+	Round      int32
+	Header     *types.Header
+	LastCommit *types.Commit
+}
+
+// ValidateBasic performs basic validation.
+func (m *BlockHeaderMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	if m.Round < 0 {
+		return errors.New("negative Round")
+	}
+	if m.Header == nil {
+		return errors.New("nil Header")
+	}
+	if err := m.Header.ValidateBasic(); err != nil {
+		return fmt.Errorf("wrong Header: %v", err)
+	}
+	if m.Header.Height != m.Height {
+		return fmt.Errorf("header height %d does not match message height %d", m.Header.Height, m.Height)
+	}
+	if m.LastCommit == nil {
+		return errors.New("nil LastCommit")
+	}
+	if err := m.LastCommit.ValidateBasic(); err != nil {
+		return fmt.Errorf("wrong LastCommit: %v", err)
+	}
+	if w, g := m.LastCommit.Hash(), m.Header.LastCommitHash; !bytes.Equal(w, g) {
+		return fmt.Errorf("wrong Header.LastCommitHash. Expected %X, got %X", w, g)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *BlockHeaderMessage) String() string {
+	return fmt.Sprintf("[BlockHeader H:%v R:%v %v]", m.Height, m.Round, m.Header.Hash())
+}
+
+//-------------------------------------
+
 // VoteMessage is sent when voting for a proposal (or lack thereof).
 type VoteMessage struct {
 	Vote *types.Vote
@@ -21,13 +21,14 @@ import (
 	abci "github.com/lazyledger/lazyledger-core/abci/types"
 	cfg "github.com/lazyledger/lazyledger-core/config"
 	cstypes "github.com/lazyledger/lazyledger-core/consensus/types"
+	"github.com/lazyledger/lazyledger-core/crypto"
 	cryptoenc "github.com/lazyledger/lazyledger-core/crypto/encoding"
 	"github.com/lazyledger/lazyledger-core/crypto/tmhash"
-	"github.com/lazyledger/lazyledger-core/ipfs"
 	"github.com/lazyledger/lazyledger-core/libs/bits"
 	"github.com/lazyledger/lazyledger-core/libs/bytes"
 	"github.com/lazyledger/lazyledger-core/libs/db/memdb"
 	"github.com/lazyledger/lazyledger-core/libs/log"
+	tmrand "github.com/lazyledger/lazyledger-core/libs/rand"
 	tmsync "github.com/lazyledger/lazyledger-core/libs/sync"
 	mempl "github.com/lazyledger/lazyledger-core/mempool"
 	"github.com/lazyledger/lazyledger-core/p2p"
@@ -184,7 +185,7 @@ func TestReactorWithEvidence(t *testing.T) {
 		// Make State
 		blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyAppConnCon, mempool, evpool)
 		cs := NewState(thisConfig.Consensus, state, blockExec, blockStore,
-			mempool, dag, ipfs.MockRouting(), evpool2)
+			mempool, dag, nil, evpool2)
 		cs.SetLogger(log.TestingLogger().With("module", "consensus"))
 		cs.SetPrivValidator(pv)
 
@@ -883,6 +884,48 @@ func TestBlockPartMessageValidateBasic(t *testing.T) {
 	assert.Equal(t, true, message.ValidateBasic() != nil, "Validate Basic had an unexpected result")
 }
 
+func TestBlockHeaderMessageValidateBasic(t *testing.T) {
+	testCases := []struct {
+		malleateFn func(*BlockHeaderMessage)
+		expErr     string
+	}{
+		{func(msg *BlockHeaderMessage) {}, ""},
+		{func(msg *BlockHeaderMessage) { msg.Height = -1 }, "negative Height"},
+		{func(msg *BlockHeaderMessage) { msg.Round = -1 }, "negative Round"},
+		{func(msg *BlockHeaderMessage) { msg.Header = nil }, "nil Header"},
+		{func(msg *BlockHeaderMessage) { msg.Header.ProposerAddress = nil }, "wrong Header"},
+		{func(msg *BlockHeaderMessage) { msg.Height = 2 }, "does not match message height"},
+		{func(msg *BlockHeaderMessage) { msg.LastCommit = nil }, "nil LastCommit"},
+		{func(msg *BlockHeaderMessage) { msg.LastCommit.Round = -1 }, "wrong LastCommit"},
+		{
+			func(msg *BlockHeaderMessage) { msg.Header.LastCommitHash = tmhash.Sum([]byte("other")) },
+			"wrong Header.LastCommitHash",
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("#%d", i), func(t *testing.T) {
+			block := types.MakeBlock(1, []types.Tx{}, nil, nil, types.Messages{}, &types.Commit{})
+			block.ProposerAddress = tmrand.Bytes(crypto.AddressSize)
+			msg := &BlockHeaderMessage{
+				Height:     1,
+				Round:      0,
+				Header:     &block.Header,
+				LastCommit: block.LastCommit,
+			}
+
+			tc.malleateFn(msg)
+			err := msg.ValidateBasic()
+			if tc.expErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tc.expErr)
+			}
+		})
+	}
+}
+
 func TestHasVoteMessageValidateBasic(t *testing.T) {
 	const (
 		validSignedMsgType   tmproto.SignedMsgType = 0x01
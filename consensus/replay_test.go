@@ -1212,6 +1212,9 @@ func (bs *mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 		Header:  block.Header,
 	}
 }
+func (bs *mockBlockStore) LoadDAHeader(height int64) *types.DataAvailabilityHeader {
+	return &bs.chain[height-1].DataAvailabilityHeader
+}
 func (bs *mockBlockStore) LoadBlockPart(height int64, index int) *types.Part { return nil }
 func (bs *mockBlockStore) SaveBlock(
 	ctx context.Context,
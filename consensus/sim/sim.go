@@ -0,0 +1,565 @@
+// Package sim provides a harness running consensus.State validators in-process
+// on virtual time, to test consensus scenarios deterministically.
+package sim
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-blockservice"
+	offline "github.com/ipfs/go-ipfs-exchange-offline"
+	"github.com/ipfs/go-merkledag"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/lazyledger/lazyledger-core/abci/client"
+	"github.com/lazyledger/lazyledger-core/abci/example/kvstore"
+	abci "github.com/lazyledger/lazyledger-core/abci/types"
+	cfg "github.com/lazyledger/lazyledger-core/config"
+	"github.com/lazyledger/lazyledger-core/consensus"
+	cstypes "github.com/lazyledger/lazyledger-core/consensus/types"
+	"github.com/lazyledger/lazyledger-core/crypto"
+	"github.com/lazyledger/lazyledger-core/crypto/ed25519"
+	"github.com/lazyledger/lazyledger-core/ipfs"
+	tmbytes "github.com/lazyledger/lazyledger-core/libs/bytes"
+	"github.com/lazyledger/lazyledger-core/libs/db/memdb"
+	"github.com/lazyledger/lazyledger-core/libs/log"
+	tmsync "github.com/lazyledger/lazyledger-core/libs/sync"
+	mempl "github.com/lazyledger/lazyledger-core/mempool"
+	"github.com/lazyledger/lazyledger-core/p2p"
+	sm "github.com/lazyledger/lazyledger-core/state"
+	"github.com/lazyledger/lazyledger-core/store"
+	"github.com/lazyledger/lazyledger-core/types"
+)
+
+var genesisTime = time.Date(2017, 1, 2, 15, 4, 5, 0, time.UTC)
+
+// Simulation runs validators in-process on virtual time. Instead of running
+// their receive routines, the simulation hands the messages and timeouts of
+// all validators to them one at a time, ordered by the virtual time they are
+// due at. Everything random, i.e. the keys of the validators and the jitter
+// of the message latency, is derived from the seed, so a simulation with the
+// same seed and rules always has the same outcome.
+//
+// The messages a validator sends to itself, i.e. its proposals, block parts
+// and votes, are broadcast to all other validators through the router. There
+// is no gossip, so messages which are dropped are lost for good. Messages
+// which arrive before a validator reached their height or round are held back
+// until it does, like the reactor only gossips them then.
+type Simulation struct {
+	// Latency is the minimum delay of every message, to which a random
+	// jitter of up to Jitter is added.
+	Latency time.Duration
+	Jitter  time.Duration
+	// FIFO keeps the messages sent from one validator to another in order,
+	// like the connection between them does. Without it, messages are
+	// reordered by the jitter.
+	FIFO bool
+
+	t     *testing.T
+	rng   *rand.Rand
+	nodes []*Node
+
+	start  time.Time
+	now    time.Time
+	seq    uint64
+	events events
+
+	arrived map[[2]int]time.Time
+	rules   []Rule
+}
+
+// Node is a validator of the simulation.
+type Node struct {
+	Index      int
+	ID         p2p.ID
+	State      *consensus.State
+	BlockStore *store.BlockStore
+
+	pubKey crypto.PubKey
+	ticker *ticker
+	// messages which arrived before the node reached their height or round
+	held []envelope
+}
+
+// Rule decides how a message from one validator to another is routed. It
+// returns the delay added to the latency of the message and whether the
+// message is delivered at all. The rules are applied in the order they have
+// been added.
+type Rule func(now time.Time, from, to int, msg consensus.Message) (time.Duration, bool)
+
+// New returns a simulation of n validators of equal voting power, using the
+// default consensus config. The validators are ordered by address, and their
+// clocks start at the genesis time.
+func New(t *testing.T, n int, seed int64) *Simulation {
+	rng := rand.New(rand.NewSource(seed))
+	privVals := make([]types.PrivValidator, n)
+	for i := range privVals {
+		secret := make([]byte, 32)
+		rng.Read(secret)
+		privVals[i] = types.NewMockPVWithParams(ed25519.GenPrivKeyFromSecret(secret), false, false)
+	}
+	sort.Sort(types.PrivValidatorsByAddress(privVals))
+
+	validators := make([]types.GenesisValidator, n)
+	for i, pv := range privVals {
+		pubKey, err := pv.GetPubKey()
+		require.NoError(t, err)
+		validators[i] = types.GenesisValidator{PubKey: pubKey, Power: 10}
+	}
+	genDoc := &types.GenesisDoc{
+		GenesisTime:   genesisTime,
+		InitialHeight: 1,
+		ChainID:       "sim_chain",
+		Validators:    validators,
+	}
+
+	s := &Simulation{
+		Latency: 50 * time.Millisecond,
+		Jitter:  100 * time.Millisecond,
+		FIFO:    true,
+		t:       t,
+		rng:     rng,
+		start:   genDoc.GenesisTime,
+		now:     genDoc.GenesisTime,
+		arrived: make(map[[2]int]time.Time),
+	}
+	for i, pv := range privVals {
+		s.nodes = append(s.nodes, s.newNode(i, genDoc, pv, validators[i].PubKey))
+	}
+	return s
+}
+
+// newNode returns a validator running a kvstore application, whose State
+// reads the virtual time and schedules its timeouts on it.
+func (s *Simulation) newNode(
+	index int,
+	genDoc *types.GenesisDoc,
+	pv types.PrivValidator,
+	pubKey crypto.PubKey,
+) *Node {
+	t := s.t
+	logger := log.TestingLogger().With("validator", index)
+
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+	app := kvstore.NewApplication()
+	app.InitChain(abci.RequestInitChain{Validators: types.TM2PB.ValidatorUpdates(state.Validators)})
+
+	config := cfg.TestConfig()
+	config.Consensus = cfg.DefaultConsensusConfig()
+
+	blockDB := memdb.NewDB()
+	bs := ipfs.MockBlockStore()
+	dag := merkledag.NewDAGService(blockservice.New(bs, offline.Exchange(bs)))
+	blockStore := store.NewBlockStore(blockDB, bs, logger)
+
+	// one for mempool, one for consensus
+	mtx := new(tmsync.Mutex)
+	proxyAppConnMem := abcicli.NewLocalClient(mtx, app)
+	proxyAppConnCon := abcicli.NewLocalClient(mtx, app)
+
+	mempool := mempl.NewCListMempool(config.Mempool, proxyAppConnMem, 0)
+	mempool.SetLogger(logger.With("module", "mempool"))
+	evpool := sm.EmptyEvidencePool{}
+
+	stateStore := sm.NewStore(blockDB)
+	// for save height 1's validators info
+	require.NoError(t, stateStore.Save(state))
+
+	blockExec := sm.NewBlockExecutor(stateStore, logger, proxyAppConnCon, mempool, evpool)
+	cs := consensus.NewState(config.Consensus, state, blockExec, blockStore, mempool, dag, nil, evpool,
+		consensus.StateClock(s.Now))
+	node := &Node{
+		Index:      index,
+		ID:         p2p.ID(fmt.Sprintf("node%d", index)),
+		State:      cs,
+		BlockStore: blockStore,
+		pubKey:     pubKey,
+		ticker:     &ticker{sim: s, node: index},
+	}
+	cs.SetTimeoutTicker(node.ticker)
+	cs.SetLogger(logger.With("module", "consensus"))
+	cs.SetPrivValidator(pv)
+
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(logger.With("module", "events"))
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	cs.SetEventBus(eventBus)
+	return node
+}
+
+// Now returns the virtual time.
+func (s *Simulation) Now() time.Time {
+	return s.now
+}
+
+// GenesisTime returns the virtual time the simulation started at.
+func (s *Simulation) GenesisTime() time.Time {
+	return s.start
+}
+
+// Node returns the validator with the given index.
+func (s *Simulation) Node(index int) *Node {
+	return s.nodes[index]
+}
+
+// AddRule adds a rule to the router.
+func (s *Simulation) AddRule(rule Rule) {
+	s.rules = append(s.rules, rule)
+}
+
+// StartNodes lets all validators enter the first height, as State.Start does.
+func (s *Simulation) StartNodes() {
+	for _, node := range s.nodes {
+		node.State.ScheduleRound0()
+	}
+}
+
+// RunUntil processes events until cond holds. The test fails if cond does not
+// hold before the given virtual time since the start has passed.
+func (s *Simulation) RunUntil(cond func() bool, limit time.Duration) {
+	deadline := s.start.Add(limit)
+	for !cond() {
+		if len(s.events) == 0 || s.events[0].at.After(deadline) {
+			s.t.Fatalf("condition not met after %v", limit)
+		}
+		s.step()
+	}
+}
+
+// RunFor processes all events due within the given virtual time.
+func (s *Simulation) RunFor(d time.Duration) {
+	deadline := s.now.Add(d)
+	for len(s.events) > 0 && !s.events[0].at.After(deadline) {
+		s.step()
+	}
+	s.now = deadline
+}
+
+// Committed returns a condition holding once all validators committed the
+// given height.
+func (s *Simulation) Committed(height int64) func() bool {
+	return func() bool {
+		for _, node := range s.nodes {
+			if node.BlockStore.Height() < height {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Commit is a block committed by a validator.
+type Commit struct {
+	Round int32
+	Hash  tmbytes.HexBytes
+	Time  time.Time
+}
+
+// Commits returns the blocks committed by the validator with the given index,
+// starting with the first height.
+func (s *Simulation) Commits(index int) []Commit {
+	blockStore := s.nodes[index].BlockStore
+	commits := make([]Commit, 0, blockStore.Height())
+	for height := int64(1); height <= blockStore.Height(); height++ {
+		meta := blockStore.LoadBlockMeta(height)
+		commits = append(commits, Commit{
+			Round: blockStore.LoadSeenCommit(height).Round,
+			Hash:  meta.BlockID.Hash,
+			Time:  meta.Header.Time,
+		})
+	}
+	return commits
+}
+
+// RequireCommitted requires the given validators to have committed the same
+// block at the given height in the given round.
+func (s *Simulation) RequireCommitted(height int64, round int32, indices ...int) {
+	var hash tmbytes.HexBytes
+	for _, i := range indices {
+		commits := s.Commits(i)
+		require.GreaterOrEqual(s.t, int64(len(commits)), height, "validator %d", i)
+		commit := commits[height-1]
+		require.Equal(s.t, round, commit.Round, "validator %d at height %d", i, height)
+		if hash == nil {
+			hash = commit.Hash
+		}
+		require.Equal(s.t, hash, commit.Hash, "validator %d at height %d", i, height)
+	}
+}
+
+// Proposer returns the index of the proposer of the first round of the
+// current height.
+func (s *Simulation) Proposer() int {
+	address := s.nodes[0].State.GetRoundState().Validators.GetProposer().Address
+	for i, node := range s.nodes {
+		if bytes.Equal(node.pubKey.Address(), address) {
+			return i
+		}
+	}
+	s.t.Fatal("proposer not found")
+	return -1
+}
+
+// All returns the indices of all validators.
+func (s *Simulation) All() []int {
+	indices := make([]int, len(s.nodes))
+	for i := range indices {
+		indices[i] = i
+	}
+	return indices
+}
+
+// step processes the next event.
+func (s *Simulation) step() {
+	ev := heap.Pop(&s.events).(*event)
+	s.now = ev.at
+	node := s.nodes[ev.node]
+	if ev.ti != nil {
+		// the timeout has been replaced by a later one
+		if ev.gen != node.ticker.gen {
+			return
+		}
+		node.State.HandleTimeout(*ev.ti)
+	} else {
+		if isEarly(node.State.GetRoundState(), ev.env.msg) {
+			node.held = append(node.held, *ev.env)
+			return
+		}
+		node.State.HandleMessage(ev.env.msg, ev.env.peerID)
+	}
+	s.process(node)
+}
+
+// process handles the messages the node sent to itself and broadcasts them,
+// and hands it the held back messages which are not early anymore.
+func (s *Simulation) process(node *Node) {
+	for {
+		if msg, ok := node.State.NextInternalMessage(); ok {
+			node.State.HandleMessage(msg, "")
+			s.broadcast(node, msg)
+			continue
+		}
+
+		rs := node.State.GetRoundState()
+		var ready []envelope
+		held := node.held[:0]
+		for _, env := range node.held {
+			if isEarly(rs, env.msg) {
+				held = append(held, env)
+			} else {
+				ready = append(ready, env)
+			}
+		}
+		node.held = held
+		if len(ready) == 0 {
+			return
+		}
+		for _, env := range ready {
+			node.State.HandleMessage(env.msg, env.peerID)
+		}
+	}
+}
+
+func (s *Simulation) broadcast(from *Node, msg consensus.Message) {
+	for _, to := range s.nodes {
+		if to == from {
+			continue
+		}
+		delay, ok := s.route(from.Index, to.Index, msg)
+		if !ok {
+			continue
+		}
+		at := s.now.Add(delay)
+		if s.FIFO {
+			link := [2]int{from.Index, to.Index}
+			if last := s.arrived[link]; at.Before(last) {
+				at = last
+			}
+			s.arrived[link] = at
+		}
+		// like on the wire, every validator gets a copy of its own
+		s.schedule(&event{at: at, node: to.Index, env: &envelope{s.copyMsg(msg), from.ID}})
+	}
+}
+
+func (s *Simulation) route(from, to int, msg consensus.Message) (time.Duration, bool) {
+	delay := s.Latency
+	if s.Jitter > 0 {
+		delay += time.Duration(s.rng.Int63n(int64(s.Jitter)))
+	}
+	for _, rule := range s.rules {
+		d, ok := rule(s.now, from, to, msg)
+		if !ok {
+			return 0, false
+		}
+		delay += d
+	}
+	return delay, true
+}
+
+func (s *Simulation) copyMsg(msg consensus.Message) consensus.Message {
+	pb, err := consensus.MsgToProto(msg)
+	require.NoError(s.t, err)
+	msg, err = consensus.MsgFromProto(pb)
+	require.NoError(s.t, err)
+	return msg
+}
+
+func (s *Simulation) schedule(ev *event) {
+	ev.seq = s.seq
+	s.seq++
+	heap.Push(&s.events, ev)
+}
+
+// isEarly returns true if the message is for a later height or round than the
+// one of the given round state. Votes for later rounds of the height are
+// tracked by the round state already.
+func isEarly(rs *cstypes.RoundState, msg consensus.Message) bool {
+	early := func(height int64, round int32) bool {
+		return height > rs.Height || (height == rs.Height && round > rs.Round)
+	}
+	switch msg := msg.(type) {
+	case *consensus.ProposalMessage:
+		return early(msg.Proposal.Height, msg.Proposal.Round)
+	case *consensus.BlockPartMessage:
+		return early(msg.Height, msg.Round)
+	case *consensus.BlockHeaderMessage:
+		return early(msg.Height, msg.Round)
+	case *consensus.VoteMessage:
+		return msg.Vote.Height > rs.Height
+	default:
+		return false
+	}
+}
+
+// DropRule drops the messages matching match.
+func DropRule(match func(from, to int, msg consensus.Message) bool) Rule {
+	return func(_ time.Time, from, to int, msg consensus.Message) (time.Duration, bool) {
+		return 0, !match(from, to, msg)
+	}
+}
+
+// DelayRule delays the messages matching match by d.
+func DelayRule(d time.Duration, match func(from, to int, msg consensus.Message) bool) Rule {
+	return func(_ time.Time, from, to int, msg consensus.Message) (time.Duration, bool) {
+		if match(from, to, msg) {
+			return d, true
+		}
+		return 0, true
+	}
+}
+
+// PartitionRule partitions the validators into the given groups until heal.
+// Messages between the groups are held back until then, as if the connections
+// were reestablished, or dropped if heal is zero. Validators not in any group
+// form a group of their own.
+func PartitionRule(heal time.Time, groups ...[]int) Rule {
+	group := make(map[int]int)
+	for g, indices := range groups {
+		for _, i := range indices {
+			group[i] = g + 1
+		}
+	}
+	return func(now time.Time, from, to int, msg consensus.Message) (time.Duration, bool) {
+		if group[from] == group[to] || (!heal.IsZero() && !now.Before(heal)) {
+			return 0, true
+		}
+		if heal.IsZero() {
+			return 0, false
+		}
+		return heal.Sub(now), true
+	}
+}
+
+// ticker is a TimeoutTicker scheduling the timeouts on the virtual time of
+// the simulation.
+type ticker struct {
+	sim  *Simulation
+	node int
+	// the last scheduled timeout and its generation
+	ti  consensus.TimeoutInfo
+	gen uint64
+}
+
+var _ consensus.TimeoutTicker = (*ticker)(nil)
+
+func (t *ticker) Start() error                       { return nil }
+func (t *ticker) Stop() error                        { return nil }
+func (t *ticker) Chan() <-chan consensus.TimeoutInfo { return nil }
+func (t *ticker) SetLogger(log.Logger)               {}
+
+// ScheduleTimeout replaces the last scheduled timeout unless it is for a later
+// height/round/step, like the ticker of consensus.NewTimeoutTicker does.
+func (t *ticker) ScheduleTimeout(ti consensus.TimeoutInfo) {
+	if ti.Height < t.ti.Height {
+		return
+	} else if ti.Height == t.ti.Height {
+		if ti.Round < t.ti.Round {
+			return
+		} else if ti.Round == t.ti.Round && t.ti.Step > 0 && ti.Step <= t.ti.Step {
+			return
+		}
+	}
+
+	t.ti = ti
+	t.gen++
+	duration := ti.Duration
+	if duration < 0 {
+		duration = 0
+	}
+	t.sim.schedule(&event{at: t.sim.now.Add(duration), node: t.node, ti: &ti, gen: t.gen})
+}
+
+// envelope is a message received from the peer with the given ID.
+type envelope struct {
+	msg    consensus.Message
+	peerID p2p.ID
+}
+
+// event is a message delivered to or a timeout of the validator with the
+// index node.
+type event struct {
+	at   time.Time
+	seq  uint64
+	node int
+
+	env *envelope
+	ti  *consensus.TimeoutInfo
+	gen uint64
+}
+
+// events is a priority queue of events ordered by the time they are due at,
+// and the order they were scheduled in.
+type events []*event
+
+func (q events) Len() int { return len(q) }
+
+func (q events) Less(i, j int) bool {
+	if q[i].at.Equal(q[j].at) {
+		return q[i].seq < q[j].seq
+	}
+	return q[i].at.Before(q[j].at)
+}
+
+func (q events) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *events) Push(x interface{}) { *q = append(*q, x.(*event)) }
+
+func (q *events) Pop() interface{} {
+	old := *q
+	ev := old[len(old)-1]
+	old[len(old)-1] = nil
+	*q = old[:len(old)-1]
+	return ev
+}
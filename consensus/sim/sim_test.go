@@ -0,0 +1,109 @@
+package sim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/lazyledger/lazyledger-core/consensus"
+)
+
+func TestSimulationCommits(t *testing.T) {
+	s := New(t, 4, 1)
+	s.StartNodes()
+	s.RunUntil(s.Committed(3), time.Minute)
+	for height := int64(1); height <= 3; height++ {
+		s.RequireCommitted(height, 0, s.All()...)
+	}
+}
+
+func TestSimulationDeterministic(t *testing.T) {
+	run := func(seed int64) ([][]Commit, time.Time) {
+		s := New(t, 4, seed)
+		s.StartNodes()
+		s.RunUntil(s.Committed(3), time.Minute)
+		commits := make([][]Commit, len(s.All()))
+		for i := range commits {
+			commits[i] = s.Commits(i)
+		}
+		return commits, s.Now()
+	}
+
+	commits, now := run(7)
+	// the block times depend on the vote times, and so on the latencies
+	commitsAgain, nowAgain := run(7)
+	require.Equal(t, commits, commitsAgain)
+	require.Equal(t, now, nowAgain)
+}
+
+func TestSimulationProposerWithholdsBlockParts(t *testing.T) {
+	s := New(t, 4, 2)
+	proposer := s.Proposer()
+	s.AddRule(DropRule(func(from, _ int, msg consensus.Message) bool {
+		m, ok := msg.(*consensus.BlockPartMessage)
+		return ok && from == proposer && m.Height == 1 && m.Round == 0
+	}))
+	s.StartNodes()
+
+	// the other validators prevote nil, so the block of the next proposer is
+	// committed in round 1
+	s.RunUntil(s.Committed(1), time.Minute)
+	s.RequireCommitted(1, 1, s.All()...)
+}
+
+func TestSimulationProposerDelayed(t *testing.T) {
+	s := New(t, 4, 3)
+	proposer := s.Proposer()
+	// the proposal still arrives before the propose timeout
+	s.AddRule(DelayRule(2*time.Second, func(from, _ int, _ consensus.Message) bool {
+		return from == proposer
+	}))
+	s.StartNodes()
+
+	s.RunUntil(s.Committed(1), time.Minute)
+	s.RequireCommitted(1, 0, s.All()...)
+}
+
+func TestSimulationPartitionHeals(t *testing.T) {
+	s := New(t, 4, 4)
+	s.AddRule(PartitionRule(s.GenesisTime().Add(10*time.Second), []int{0, 1}, []int{2, 3}))
+	s.StartNodes()
+
+	// neither half has two thirds of the voting power
+	s.RunFor(10 * time.Second)
+	for _, i := range s.All() {
+		node := s.Node(i)
+		require.Zero(t, node.BlockStore.Height())
+		require.Equal(t, int32(0), node.State.GetRoundState().Round)
+	}
+
+	// the prevotes of round 0 are split, so the height is committed in round 1
+	s.RunUntil(s.Committed(1), time.Minute)
+	s.RequireCommitted(1, 1, s.All()...)
+}
+
+func TestSimulationProposerIsolated(t *testing.T) {
+	s := New(t, 4, 5)
+	proposer := s.Proposer()
+	s.AddRule(PartitionRule(time.Time{}, []int{proposer}))
+	s.StartNodes()
+
+	var others []int
+	for _, i := range s.All() {
+		if i != proposer {
+			others = append(others, i)
+		}
+	}
+	s.RunUntil(func() bool {
+		for _, i := range others {
+			if s.Node(i).BlockStore.Height() < 2 {
+				return false
+			}
+		}
+		return true
+	}, time.Minute)
+	s.RequireCommitted(1, 1, others...)
+	// nothing is received by the isolated validator
+	require.Zero(t, s.Node(proposer).BlockStore.Height())
+}
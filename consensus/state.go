@@ -13,11 +13,11 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	format "github.com/ipfs/go-ipld-format"
-	"github.com/libp2p/go-libp2p-core/routing"
 
 	cfg "github.com/lazyledger/lazyledger-core/config"
 	cstypes "github.com/lazyledger/lazyledger-core/consensus/types"
 	"github.com/lazyledger/lazyledger-core/crypto"
+	"github.com/lazyledger/lazyledger-core/ipfs"
 	tmevents "github.com/lazyledger/lazyledger-core/libs/events"
 	"github.com/lazyledger/lazyledger-core/libs/fail"
 	tmjson "github.com/lazyledger/lazyledger-core/libs/json"
@@ -32,6 +32,8 @@ import (
 	sm "github.com/lazyledger/lazyledger-core/state"
 	"github.com/lazyledger/lazyledger-core/types"
 	tmtime "github.com/lazyledger/lazyledger-core/types/time"
+	"github.com/lazyledger/nmt/namespace"
+	"github.com/lazyledger/rsmt2d"
 )
 
 //-----------------------------------------------------------------------------
@@ -43,7 +45,8 @@ var (
 	ErrAddingVote                 = errors.New("error adding vote")
 	ErrSignatureFoundInPastBlocks = errors.New("found signature from the same key")
 
-	errPubKeyIsNotSet = errors.New("pubkey is not set. Look for \"Can't get private validator pubkey\" errors")
+	errPubKeyIsNotSet            = errors.New("pubkey is not set. Look for \"Can't get private validator pubkey\" errors")
+	errBlockHeaderBeforeProposal = errors.New("proposal block header received before the proposal")
 )
 
 //-----------------------------------------------------------------------------
@@ -58,18 +61,55 @@ type msgInfo struct {
 	PeerID p2p.ID  `json:"peer_key"`
 }
 
-// internally generated messages which may update the state
-type timeoutInfo struct {
+// TimeoutInfo is an internally generated message which may update the state.
+// It is scheduled on the TimeoutTicker and fired once its duration passed.
+type TimeoutInfo struct {
 	Duration time.Duration         `json:"duration"`
 	Height   int64                 `json:"height"`
 	Round    int32                 `json:"round"`
 	Step     cstypes.RoundStepType `json:"step"`
 }
 
-func (ti *timeoutInfo) String() string {
+func (ti *TimeoutInfo) String() string {
 	return fmt.Sprintf("%v ; %d/%d %v", ti.Duration, ti.Height, ti.Round, ti.Step)
 }
 
+// a proposal block retrieved from IPFS by its DataAvailabilityHeader, or the
+// error retrieving it
+type proposalBlockInfo struct {
+	height   int64
+	proposal *types.Proposal
+	block    *types.Block
+	parts    *types.PartSet
+	err      error
+	// the fraud proof for the block data, if it is not erasure coded correctly
+	evidence types.Evidence
+}
+
+// availabilityCheck samples the data of a proposal block in the background,
+// see sampleProposalData.
+type availabilityCheck struct {
+	dataHash []byte
+	round    int32 // bounding the sampling by its prevote timeout
+	cancel   context.CancelFunc
+	done     chan struct{}
+	err      error // set once done is closed
+
+	// the round whose prevote awaits the result, see defaultDoPrevote
+	prevotePending bool
+	prevoteRound   int32
+}
+
+// failed returns true if the check completed and the data is unavailable.
+func (check *availabilityCheck) failed() bool {
+	select {
+	case <-check.done:
+		return check.err != nil
+	default:
+		return false
+	}
+}
+
 // interface to the mempool
 type txNotifier interface {
 	TxsAvailable() <-chan struct{}
@@ -80,6 +120,14 @@ type evidencePool interface {
 	// Adds consensus based evidence to the evidence pool. This function differs to
 	// AddEvidence by bypassing verification and adding it immediately to the pool
 	AddEvidenceFromConsensus(types.Evidence) error
+	// Verifies the evidence and adds it to the evidence pool
+	AddEvidence(types.Evidence) error
+}
+
+// interface to the publisher putting block data to IPFS
+type blockDataPublisher interface {
+	// Queues the block data to be put with the given codec and provided
+	Publish(block *types.Block, codec rsmt2d.Codec) error
 }
 
 // State handles execution of the consensus algorithm.
@@ -96,8 +144,9 @@ type State struct {
 	// store blocks and commits
 	blockStore sm.BlockStore
 
-	dag    format.DAGService
-	croute routing.ContentRouting
+	dag format.DAGService
+	// publishes the block data of own proposals, may be nil
+	publisher blockDataPublisher
 
 	// create and execute blocks
 	blockExec *sm.BlockExecutor
@@ -144,6 +193,7 @@ type State struct {
 	decideProposal func(height int64, round int32)
 	doPrevote      func(height int64, round int32)
 	setProposal    func(proposal *types.Proposal) error
+	now            func() time.Time
 
 	// closed when we finish shutting down
 	done chan struct{}
@@ -153,11 +203,23 @@ type State struct {
 	evsw tmevents.EventSwitch
 
 	// for reporting metrics
-	metrics *Metrics
+	metrics     *Metrics
+	ipldMetrics *ipld.Metrics
+
+	// proposal blocks retrieved from IPFS, see retrieveProposalBlock
+	proposalBlockQueue chan proposalBlockInfo
+	// cancels retrieving the proposal block of the current round
+	retrievalCancel context.CancelFunc
 
-	// context of the recent proposed block
-	proposalCtx    context.Context
-	proposalCancel context.CancelFunc
+	// confidence with which the data of proposal blocks is sampled
+	samplingConfidence float64
+	// sampling of the data of the most recent proposal block at this height
+	availability *availabilityCheck
+	// completed availability checks, see sampleProposalData
+	availabilityQueue chan *availabilityCheck
+	// bad encoding fraud proofs for the proposal blocks of this height by
+	// their DataHash, see reportBadEncoding
+	badEncodingProofs map[string]types.Evidence
 }
 
 // StateOption sets an optional parameter on the State.
@@ -171,32 +233,42 @@ func NewState(
 	blockStore sm.BlockStore,
 	txNotifier txNotifier,
 	dag format.DAGService,
-	croute routing.ContentRouting,
+	publisher blockDataPublisher,
 	evpool evidencePool,
 	options ...StateOption,
 ) *State {
 	cs := &State{
-		config:           config,
-		blockExec:        blockExec,
-		blockStore:       blockStore,
-		dag:              dag,
-		croute:           croute,
-		txNotifier:       txNotifier,
-		peerMsgQueue:     make(chan msgInfo, msgQueueSize),
-		internalMsgQueue: make(chan msgInfo, msgQueueSize),
-		timeoutTicker:    NewTimeoutTicker(),
-		statsMsgQueue:    make(chan msgInfo, msgQueueSize),
-		done:             make(chan struct{}),
-		doWALCatchup:     true,
-		wal:              nilWAL{},
-		evpool:           evpool,
-		evsw:             tmevents.NewEventSwitch(),
-		metrics:          NopMetrics(),
+		config:             config,
+		blockExec:          blockExec,
+		blockStore:         blockStore,
+		dag:                dag,
+		publisher:          publisher,
+		txNotifier:         txNotifier,
+		peerMsgQueue:       make(chan msgInfo, msgQueueSize),
+		internalMsgQueue:   make(chan msgInfo, msgQueueSize),
+		timeoutTicker:      NewTimeoutTicker(),
+		statsMsgQueue:      make(chan msgInfo, msgQueueSize),
+		proposalBlockQueue: make(chan proposalBlockInfo, 1),
+		availabilityQueue:  make(chan *availabilityCheck, 1),
+		done:               make(chan struct{}),
+		doWALCatchup:       true,
+		wal:                nilWAL{},
+		evpool:             evpool,
+		evsw:               tmevents.NewEventSwitch(),
+		metrics:            NopMetrics(),
+		ipldMetrics:        ipld.NopMetrics(),
+		samplingConfidence: ipfs.DefaultConfig().SamplingConfidence,
 	}
 	// set function defaults (may be overwritten before calling Start)
 	cs.decideProposal = cs.defaultDecideProposal
 	cs.doPrevote = cs.defaultDoPrevote
 	cs.setProposal = cs.defaultSetProposal
+	cs.now = tmtime.Now
+
+	// options are applied first, as the clock is read by updateToState
+	for _, option := range options {
+		option(cs)
+	}
 
 	// We have no votes, so reconstruct LastCommit from SeenCommit.
 	if state.LastBlockHeight > 0 {
@@ -209,9 +281,6 @@ func NewState(
 	// We do that upon Start().
 
 	cs.BaseService = *service.NewBaseService(nil, "State", cs)
-	for _, option := range options {
-		option(cs)
-	}
 	return cs
 }
 
@@ -235,6 +304,24 @@ func StateMetrics(metrics *Metrics) StateOption {
 	return func(cs *State) { cs.metrics = metrics }
 }
 
+// StateIPLDMetrics sets the metrics reported when putting proposed blocks to
+// IPFS.
+func StateIPLDMetrics(metrics *ipld.Metrics) StateOption {
+	return func(cs *State) { cs.ipldMetrics = metrics }
+}
+
+// StateSamplingConfidence sets the confidence with which the data of proposal
+// blocks is sampled if sample-availability is enabled.
+func StateSamplingConfidence(confidence float64) StateOption {
+	return func(cs *State) { cs.samplingConfidence = confidence }
+}
+
+// StateClock sets the clock the current time is read from, e.g. to run the
+// State on virtual time. It defaults to tmtime.Now.
+func StateClock(now func() time.Time) StateOption {
+	return func(cs *State) { cs.now = now }
+}
+
 // String returns a string.
 func (cs *State) String() string {
 	// better not to access shared variables
@@ -536,13 +623,13 @@ func (cs *State) updateRoundStep(round int32, step cstypes.RoundStepType) {
 // enterNewRound(height, 0) at cs.StartTime.
 func (cs *State) scheduleRound0(rs *cstypes.RoundState) {
 	// cs.Logger.Info("scheduleRound0", "now", tmtime.Now(), "startTime", cs.StartTime)
-	sleepDuration := rs.StartTime.Sub(tmtime.Now())
+	sleepDuration := rs.StartTime.Sub(cs.now())
 	cs.scheduleTimeout(sleepDuration, rs.Height, 0, cstypes.RoundStepNewHeight)
 }
 
-// Attempt to schedule a timeout (by sending timeoutInfo on the tickChan)
+// Attempt to schedule a timeout (by sending TimeoutInfo on the tickChan)
 func (cs *State) scheduleTimeout(duration time.Duration, height int64, round int32, step cstypes.RoundStepType) {
-	cs.timeoutTicker.ScheduleTimeout(timeoutInfo{duration, height, round, step})
+	cs.timeoutTicker.ScheduleTimeout(TimeoutInfo{duration, height, round, step})
 }
 
 // send a msg into the receiveRoutine regarding our own proposal, block part, or vote
@@ -649,7 +736,7 @@ func (cs *State) updateToState(state sm.State) {
 		// to be gathered for the first block.
 		// And alternative solution that relies on clocks:
 		// cs.StartTime = state.LastBlockTime.Add(timeoutCommit)
-		cs.StartTime = cs.config.Commit(tmtime.Now())
+		cs.StartTime = cs.config.Commit(cs.now())
 	} else {
 		cs.StartTime = cs.config.Commit(cs.CommitTime)
 	}
@@ -658,6 +745,11 @@ func (cs *State) updateToState(state sm.State) {
 	cs.Proposal = nil
 	cs.ProposalBlock = nil
 	cs.ProposalBlockParts = nil
+	cs.ProposalBlockHeader = nil
+	cs.ProposalLastCommit = nil
+	cs.cancelProposalBlockRetrieval()
+	cs.cancelAvailabilityCheck()
+	cs.badEncodingProofs = nil
 	cs.LockedRound = -1
 	cs.LockedBlock = nil
 	cs.LockedBlockParts = nil
@@ -765,6 +857,14 @@ func (cs *State) receiveRoutine(maxSteps int) {
 
 			// handles proposals, block parts, votes
 			cs.handleMsg(mi)
+		case pbi := <-cs.proposalBlockQueue:
+			// not written to the WAL, the block is retrieved again
+			// when replaying the block header message
+			cs.handleProposalBlock(pbi)
+		case check := <-cs.availabilityQueue:
+			// not written to the WAL, the data is sampled again
+			// when replaying the proposal
+			cs.handleAvailabilityCheck(check)
 		case ti := <-cs.timeoutTicker.Chan(): // tockChan:
 			if err := cs.wal.Write(ti); err != nil {
 				cs.Logger.Error("Error writing to wal", "err", err)
@@ -812,6 +912,14 @@ func (cs *State) handleMsg(mi msgInfo) {
 				msg.Round)
 			err = nil
 		}
+	case *BlockHeaderMessage:
+		// will not cause transition.
+		// once the proposal and the header are set, the block data is retrieved from IPFS.
+		// Our own proposal blocks are added from their parts instead.
+		err = cs.setProposalBlockHeader(msg)
+		if err == nil && peerID != "" {
+			cs.tryRetrieveProposalBlock()
+		}
 	case *VoteMessage:
 		// attempt to add the vote and dupeout the validator if its a duplicate signature
 		// if the vote gives us a 2/3-any or 2/3-one, we transition
@@ -845,7 +953,7 @@ func (cs *State) handleMsg(mi msgInfo) {
 	}
 }
 
-func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
+func (cs *State) handleTimeout(ti TimeoutInfo, rs cstypes.RoundState) {
 	cs.Logger.Debug("Received tock", "timeout", ti.Duration, "height", ti.Height, "round", ti.Round, "step", ti.Step)
 
 	// timeouts must be for current height, round, step
@@ -904,7 +1012,7 @@ func (cs *State) handleTxsAvailable() {
 		}
 
 		// +1ms to ensure RoundStepNewRound timeout always happens after RoundStepNewHeight
-		timeoutCommit := cs.StartTime.Sub(tmtime.Now()) + 1*time.Millisecond
+		timeoutCommit := cs.StartTime.Sub(cs.now()) + 1*time.Millisecond
 		cs.scheduleTimeout(timeoutCommit, cs.Height, 0, cstypes.RoundStepNewRound)
 	case cstypes.RoundStepNewRound: // after timeoutCommit
 		cs.enterPropose(cs.Height, 0)
@@ -935,7 +1043,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 		return
 	}
 
-	if now := tmtime.Now(); cs.StartTime.After(now) {
+	if now := cs.now(); cs.StartTime.After(now) {
 		logger.Info("Need to set a buffer and log message here for sanity.", "startTime", cs.StartTime, "now", now)
 	}
 
@@ -962,6 +1070,9 @@ func (cs *State) enterNewRound(height int64, round int32) {
 		cs.Proposal = nil
 		cs.ProposalBlock = nil
 		cs.ProposalBlockParts = nil
+		cs.ProposalBlockHeader = nil
+		cs.ProposalLastCommit = nil
+		cs.cancelProposalBlockRetrieval()
 	}
 	cs.Votes.SetRound(tmmath.SafeAddInt32(round, 1)) // also track next round (round+1) to allow round-skipping
 	cs.TriggeredTimeoutPrecommit = false
@@ -1111,6 +1222,12 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 
 		// send proposal and block parts on internal msg queue
 		cs.sendInternalMessage(msgInfo{&ProposalMessage{proposal}, ""})
+		if cs.config.ProposeByDAH {
+			// peers retrieve the block data from IPFS instead of receiving the
+			// block parts, which are only used internally then
+			header := &BlockHeaderMessage{cs.Height, cs.Round, &block.Header, block.LastCommit}
+			cs.sendInternalMessage(msgInfo{header, ""})
+		}
 		for i := 0; i < int(blockParts.Total()); i++ {
 			part := blockParts.GetPart(i)
 			cs.sendInternalMessage(msgInfo{&BlockPartMessage{cs.Height, cs.Round, part}, ""})
@@ -1121,40 +1238,18 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 		cs.Logger.Error("enterPropose: Error signing proposal", "height", height, "round", round, "err", err)
 	}
 
-	// cancel ctx for previous proposal block to ensure block putting/providing does not queues up
-	if cs.proposalCancel != nil {
-		// FIXME(ismail): below commented out cancel tries to prevent block putting
-		// and providing no to queue up endlessly.
-		// But in a real network proposers should have enough time in between.
-		// And even if not, queuing up to a problematic extent will take a lot of time:
-		// Even on the Cosmos Hub the largest validator only proposes every 15 blocks.
-		// With an average block time of roughly 7.5 seconds this means almost
-		// two minutes between two different proposals by the same validator.
-		// For other validators much more time passes in between.
-		// In our case block interval times will likely be larger.
-		// And independent of this DHT providing will be made faster:
-		//  - https://github.com/lazyledger/lazyledger-core/issues/395
-		//
-		// Furthermore, and independent of all of the above,
-		// the provide timeout could still be larger than just the time between
-		// two consecutive proposals.
-		//
-		cs.proposalCancel()
-	}
-	cs.proposalCtx, cs.proposalCancel = context.WithCancel(context.TODO())
-	go func(ctx context.Context) {
-		cs.Logger.Info("Putting Block to IPFS", "height", block.Height)
-		err = ipld.PutBlock(ctx, cs.dag, block, cs.croute, cs.Logger)
-		if err != nil {
-			if errors.Is(err, context.Canceled) {
-				cs.Logger.Error("Putting Block didn't finish in time and was terminated", "height", block.Height)
-				return
-			}
-			cs.Logger.Error("Failed to put Block to IPFS", "err", err, "height", block.Height)
-			return
-		}
-		cs.Logger.Info("Finished putting block to IPFS", "height", block.Height)
-	}(cs.proposalCtx)
+	if cs.publisher == nil {
+		return
+	}
+	codec, err := types.Codec(cs.state.ConsensusParams.DataAvailability.Codec)
+	if err != nil {
+		cs.Logger.Error("enterPropose: Unsupported erasure codec", "height", height, "round", round, "err", err)
+		return
+	}
+	// the block data is put in the background, so peers can sample it
+	if err := cs.publisher.Publish(block, codec); err != nil {
+		cs.Logger.Error("enterPropose: Failed to publish block data", "height", height, "round", round, "err", err)
+	}
 }
 
 // Returns true if the proposal block is complete &&
@@ -1267,6 +1362,37 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		return
 	}
 
+	// Prevote nil if the app does not reproduce the intermediate state roots
+	// of the proposal block, as committing it would not be possible.
+	if !cs.isOwnProposal() {
+		if err := cs.blockExec.ValidateIntermediateStateRoots(cs.ProposalBlock); err != nil {
+			logger.Error("enterPrevote: ProposalBlock has invalid intermediate state roots", "err", err)
+			cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+			return
+		}
+	}
+
+	// Prevote nil if the proposal block data is not available on the IPFS network.
+	// Sampling may take up to the prevote timeout, hence we prevote once it
+	// is done instead of waiting for it here, see handleAvailabilityCheck.
+	if cs.shouldSampleProposalData() {
+		cs.sampleProposalData(&cs.ProposalBlock.DataAvailabilityHeader, round)
+		select {
+		case <-cs.availability.done:
+		default:
+			logger.Info("enterPrevote: Sampling ProposalBlock data before prevoting")
+			cs.availability.prevotePending = true
+			cs.availability.prevoteRound = round
+			return
+		}
+		if err := cs.availability.err; err != nil {
+			logger.Error("enterPrevote: ProposalBlock data is unavailable", "err", err)
+			cs.metrics.UnavailableProposals.Add(1)
+			cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+			return
+		}
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
@@ -1468,7 +1594,7 @@ func (cs *State) enterCommit(height int64, commitRound int32) {
 		// keep cs.Round the same, commitRound points to the right Precommits set.
 		cs.updateRoundStep(cs.Round, cstypes.RoundStepCommit)
 		cs.CommitRound = commitRound
-		cs.CommitTime = tmtime.Now()
+		cs.CommitTime = cs.now()
 		cs.newStep()
 
 		// Maybe finalize immediately.
@@ -1635,6 +1761,8 @@ func (cs *State) finalizeCommit(height int64) {
 
 	fail.Fail() // XXX
 
+	cs.reportBadEncoding(block)
+
 	// Prune old heights, if requested by ABCI app.
 	if retainHeight > 0 {
 		pruned, err := cs.pruneBlocks(retainHeight)
@@ -1668,6 +1796,19 @@ func (cs *State) finalizeCommit(height int64) {
 	// * cs.StartTime is set to when we will start round0.
 }
 
+// reportBadEncoding submits the fraud proof built while retrieving the data of
+// the committed block, if any, to the evidence pool, like the BlockStore does
+// when loading a block whose data can not be repaired.
+func (cs *State) reportBadEncoding(block *types.Block) {
+	befp, ok := cs.badEncodingProofs[string(block.DataHash)]
+	if !ok {
+		return
+	}
+	if err := cs.evpool.AddEvidence(befp); err != nil {
+		cs.Logger.Error("Failed to add bad encoding fraud proof", "height", block.Height, "err", err)
+	}
+}
+
 func (cs *State) pruneBlocks(retainHeight int64) (uint64, error) {
 	base := cs.blockStore.Base()
 	if retainHeight <= base {
@@ -1813,9 +1954,201 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 		cs.ProposalBlockParts = types.NewPartSetFromHeader(proposal.BlockID.PartSetHeader)
 	}
 	cs.Logger.Info("Received proposal", "proposal", proposal)
+	cs.tryRetrieveProposalBlock()
+	// Start sampling right away so the result is likely in when prevoting.
+	if proposal.DAHeader != nil && cs.shouldSampleProposalData() {
+		cs.sampleProposalData(proposal.DAHeader, proposal.Round)
+	}
+	return nil
+}
+
+// setProposalBlockHeader sets the header and the last commit of the proposal
+// block of the current round, which are needed next to the block data to
+// retrieve the block from IPFS.
+func (cs *State) setProposalBlockHeader(msg *BlockHeaderMessage) error {
+	// Already have one
+	if cs.ProposalBlockHeader != nil {
+		return nil
+	}
+
+	// Does not apply
+	if msg.Height != cs.Height || msg.Round != cs.Round {
+		return nil
+	}
+
+	// The header can not be checked without the proposal. Peers send it
+	// after the proposal, see gossipDataRoutine.
+	if cs.Proposal == nil {
+		return errBlockHeaderBeforeProposal
+	}
+	if err := checkProposalBlockHeader(cs.Proposal, msg.Header); err != nil {
+		return err
+	}
+
+	cs.ProposalBlockHeader = msg.Header
+	cs.ProposalLastCommit = msg.LastCommit
+	cs.Logger.Info("Received proposal block header", "height", msg.Height, "round", msg.Round,
+		"hash", msg.Header.Hash())
+	return nil
+}
+
+// checkProposalBlockHeader returns an error if the header is not the one of
+// the block committed to by the proposal and its DataAvailabilityHeader.
+// The last commit is checked against the header by
+// BlockHeaderMessage.ValidateBasic.
+func checkProposalBlockHeader(proposal *types.Proposal, header *types.Header) error {
+	if proposal.DAHeader == nil {
+		return errors.New("proposal has no DataAvailabilityHeader")
+	}
+	if hash := header.Hash(); !bytes.Equal(hash, proposal.BlockID.Hash) {
+		return fmt.Errorf("header hash %X does not match proposal block hash %X", hash, proposal.BlockID.Hash)
+	}
+	if daHash := proposal.DAHeader.Hash(); !bytes.Equal(header.DataHash, daHash) {
+		return fmt.Errorf("header data hash %X does not match proposal DataAvailabilityHeader %X",
+			header.DataHash, daHash)
+	}
 	return nil
 }
 
+// tryRetrieveProposalBlock starts retrieving the proposal block from IPFS once
+// both the proposal and the header of its block are set, unless the block is
+// complete already or is being retrieved.
+func (cs *State) tryRetrieveProposalBlock() {
+	if cs.Proposal == nil || cs.ProposalBlockHeader == nil || cs.ProposalBlock != nil ||
+		cs.retrievalCancel != nil || cs.dag == nil {
+		return
+	}
+	// We are waiting for another block, e.g. after seeing +2/3 prevotes for it.
+	if !cs.ProposalBlockParts.HasHeader(cs.Proposal.BlockID.PartSetHeader) {
+		return
+	}
+
+	codec, err := types.Codec(cs.state.ConsensusParams.DataAvailability.Codec)
+	if err != nil {
+		cs.Logger.Error("Unsupported erasure codec", "height", cs.Height, "round", cs.Round, "err", err)
+		return
+	}
+	var ctx context.Context
+	ctx, cs.retrievalCancel = context.WithCancel(context.TODO())
+	go cs.retrieveProposalBlock(ctx, codec, cs.Proposal, cs.ProposalBlockHeader, cs.ProposalLastCommit)
+}
+
+// retrieveProposalBlock retrieves the data of the proposal block from IPFS,
+// and queues the block once it is assembled from the data, the header and the
+// last commit, and its parts match the proposal. If the data is not erasure
+// coded correctly, a fraud proof is queued along with the error, see
+// reportBadEncoding.
+func (cs *State) retrieveProposalBlock(
+	ctx context.Context,
+	codec rsmt2d.Codec,
+	proposal *types.Proposal,
+	header *types.Header,
+	lastCommit *types.Commit,
+) {
+	logger := cs.Logger.With("height", proposal.Height, "round", proposal.Round)
+	logger.Info("Retrieving proposal block from IPFS", "hash", proposal.BlockID.Hash)
+	data, err := ipld.RetrieveBlockDataStreaming(ctx, proposal.DAHeader, cs.dag, codec, cs.ipldMetrics)
+	if err != nil {
+		if ctx.Err() != nil {
+			logger.Debug("Retrieving proposal block was canceled")
+			return
+		}
+		pbi := proposalBlockInfo{
+			height:   proposal.Height,
+			proposal: proposal,
+			err:      fmt.Errorf("failed to retrieve proposal block from IPFS: %w", err),
+		}
+		var byzErr *ipld.ErrByzantineData
+		if errors.As(err, &byzErr) {
+			befp, err := ipld.NewBadEncodingFraudProof(ctx, cs.dag, proposal.DAHeader, byzErr, proposal.Height, header.Time)
+			if err != nil {
+				logger.Error("Failed to build bad encoding fraud proof", "err", err)
+			} else {
+				pbi.evidence = befp
+			}
+		}
+		cs.queueProposalBlock(ctx, pbi)
+		return
+	}
+
+	block := &types.Block{
+		Header:                 *header,
+		Data:                   data,
+		DataAvailabilityHeader: *proposal.DAHeader,
+		LastCommit:             lastCommit,
+	}
+	parts := block.MakePartSet(types.BlockPartSizeBytes)
+	if !parts.HasHeader(proposal.BlockID.PartSetHeader) {
+		cs.queueProposalBlock(ctx, proposalBlockInfo{
+			height:   proposal.Height,
+			proposal: proposal,
+			err: fmt.Errorf("retrieved proposal block parts %v do not match the proposal %v",
+				parts.Header(), proposal.BlockID.PartSetHeader),
+		})
+		return
+	}
+
+	cs.queueProposalBlock(ctx, proposalBlockInfo{height: proposal.Height, proposal: proposal, block: block, parts: parts})
+}
+
+// queueProposalBlock queues the retrieved proposal block, or the error
+// retrieving it, to be handled by the receive routine, see handleProposalBlock.
+func (cs *State) queueProposalBlock(ctx context.Context, pbi proposalBlockInfo) {
+	select {
+	case cs.proposalBlockQueue <- pbi:
+	case <-ctx.Done():
+	case <-cs.Quit():
+	}
+}
+
+// cancelProposalBlockRetrieval stops retrieving the proposal block, if any.
+func (cs *State) cancelProposalBlockRetrieval() {
+	if cs.retrievalCancel != nil {
+		cs.retrievalCancel()
+		cs.retrievalCancel = nil
+	}
+}
+
+// handleProposalBlock sets a proposal block retrieved from IPFS as if all of
+// its parts were received, bypassing addProposalBlockPart.
+func (cs *State) handleProposalBlock(pbi proposalBlockInfo) {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	if pbi.err != nil {
+		cs.Logger.Error("Failed to retrieve proposal block", "height", pbi.height,
+			"round", pbi.proposal.Round, "err", pbi.err)
+		// The fraud proof can only be verified once its block is committed.
+		if pbi.evidence != nil && cs.Height == pbi.height {
+			if cs.badEncodingProofs == nil {
+				cs.badEncodingProofs = make(map[string]types.Evidence)
+			}
+			cs.badEncodingProofs[string(pbi.proposal.DAHeader.Hash())] = pbi.evidence
+		}
+		// Reset the retrieval of the current proposal, so that it is retried
+		// once the header of its block is received from another peer.
+		if cs.Proposal == pbi.proposal {
+			cs.cancelProposalBlockRetrieval()
+		}
+		return
+	}
+
+	// Blocks might be reused, so round mismatch is OK
+	if cs.Height != pbi.height || cs.ProposalBlock != nil {
+		return
+	}
+	// We are not waiting for this block anymore.
+	if !cs.ProposalBlockParts.HasHeader(pbi.parts.Header()) {
+		return
+	}
+
+	cs.ProposalBlockParts = pbi.parts
+	cs.ProposalBlock = pbi.block
+	cs.Logger.Info("Retrieved complete proposal block", "height", cs.ProposalBlock.Height,
+		"hash", cs.ProposalBlock.Hash())
+	cs.handleCompleteProposal(pbi.height)
+}
+
 // NOTE: block is not necessarily valid.
 // Asynchronously triggers either enterPrevote (before we timeout of propose) or tryFinalizeCommit,
 // once we have the full block.
@@ -1866,41 +2199,151 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 		cs.ProposalBlock = block
 		// NOTE: it's possible to receive complete proposal blocks for future rounds without having the proposal
 		cs.Logger.Info("Received complete proposal block", "height", cs.ProposalBlock.Height, "hash", cs.ProposalBlock.Hash())
-		if err := cs.eventBus.PublishEventCompleteProposal(cs.CompleteProposalEvent()); err != nil {
-			cs.Logger.Error("Error publishing event complete proposal", "err", err)
-		}
-
-		// Update Valid* if we can.
-		prevotes := cs.Votes.Prevotes(cs.Round)
-		blockID, hasTwoThirds := prevotes.TwoThirdsMajority()
-		if hasTwoThirds && !blockID.IsZero() && (cs.ValidRound < cs.Round) {
-			if cs.ProposalBlock.HashesTo(blockID.Hash) {
-				cs.Logger.Info("Updating valid block to new proposal block",
-					"valid-round", cs.Round, "valid-block-hash", cs.ProposalBlock.Hash())
-				cs.ValidRound = cs.Round
-				cs.ValidBlock = cs.ProposalBlock
-				cs.ValidBlockParts = cs.ProposalBlockParts
-			}
-			// TODO: In case there is +2/3 majority in Prevotes set for some
-			// block and cs.ProposalBlock contains different block, either
-			// proposer is faulty or voting power of faulty processes is more
-			// than 1/3. We should trigger in the future accountability
-			// procedure at this point.
+		cs.handleCompleteProposal(height)
+		return added, nil
+	}
+	return added, nil
+}
+
+// handleCompleteProposal publishes the complete proposal block and
+// asynchronously triggers either enterPrevote (before we timeout of propose)
+// or tryFinalizeCommit.
+func (cs *State) handleCompleteProposal(height int64) {
+	if err := cs.eventBus.PublishEventCompleteProposal(cs.CompleteProposalEvent()); err != nil {
+		cs.Logger.Error("Error publishing event complete proposal", "err", err)
+	}
+
+	// Update Valid* if we can.
+	prevotes := cs.Votes.Prevotes(cs.Round)
+	blockID, hasTwoThirds := prevotes.TwoThirdsMajority()
+	if hasTwoThirds && !blockID.IsZero() && (cs.ValidRound < cs.Round) {
+		if cs.ProposalBlock.HashesTo(blockID.Hash) {
+			cs.Logger.Info("Updating valid block to new proposal block",
+				"valid-round", cs.Round, "valid-block-hash", cs.ProposalBlock.Hash())
+			cs.ValidRound = cs.Round
+			cs.ValidBlock = cs.ProposalBlock
+			cs.ValidBlockParts = cs.ProposalBlockParts
 		}
+		// TODO: In case there is +2/3 majority in Prevotes set for some
+		// block and cs.ProposalBlock contains different block, either
+		// proposer is faulty or voting power of faulty processes is more
+		// than 1/3. We should trigger in the future accountability
+		// procedure at this point.
+	}
+
+	if cs.Step <= cstypes.RoundStepPropose && cs.isProposalComplete() {
+		// Move onto the next step
+		cs.enterPrevote(height, cs.Round)
+		if hasTwoThirds { // this is optimisation as this will be triggered when prevote is added
+			cs.enterPrecommit(height, cs.Round)
+		}
+	} else if cs.Step == cstypes.RoundStepCommit {
+		// If we're waiting on the proposal block...
+		cs.tryFinalizeCommit(height)
+	}
+}
 
-		if cs.Step <= cstypes.RoundStepPropose && cs.isProposalComplete() {
-			// Move onto the next step
-			cs.enterPrevote(height, cs.Round)
-			if hasTwoThirds { // this is optimisation as this will be triggered when prevote is added
-				cs.enterPrecommit(height, cs.Round)
+// isOwnProposal returns true if we are the proposer of the current round.
+// Proposers do not sample the data of their own proposal blocks.
+func (cs *State) isOwnProposal() bool {
+	return cs.privValidatorPubKey != nil && cs.isProposer(cs.privValidatorPubKey.Address())
+}
+
+// shouldSampleProposalData returns true if the data of the proposal block of
+// the current round is to be sampled before prevoting it.
+func (cs *State) shouldSampleProposalData() bool {
+	return cs.config.SampleAvailability && cs.dag != nil && !cs.isOwnProposal()
+}
+
+// sampleProposalData starts sampling the data of a proposal block in the
+// background unless it is sampled already. Sampling times out after the
+// prevote timeout of the round, thus a failed check is only reused within its
+// round and the data of a block proposed again in a later round, e.g. the
+// valid block, is sampled again. The completed check is queued to
+// receiveRoutine, see handleAvailabilityCheck.
+func (cs *State) sampleProposalData(dah *types.DataAvailabilityHeader, round int32) {
+	dataHash := dah.Hash()
+	if check := cs.availability; check != nil && bytes.Equal(check.dataHash, dataHash) &&
+		(check.round == round || !check.failed()) {
+		return
+	}
+	cs.cancelAvailabilityCheck()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	check := &availabilityCheck{dataHash: dataHash, round: round, cancel: cancel, done: make(chan struct{})}
+	cs.availability = check
+	timeout := cs.config.Prevote(round)
+	logger := cs.Logger.With("height", cs.Height, "round", round)
+	go func() {
+		defer func() {
+			close(check.done)
+			select {
+			case cs.availabilityQueue <- check:
+			case <-ctx.Done():
+			case <-cs.Quit():
 			}
-		} else if cs.Step == cstypes.RoundStepCommit {
-			// If we're waiting on the proposal block...
-			cs.tryFinalizeCommit(height)
+		}()
+		start := time.Now()
+		confidence, err := ipld.ValidateAvailability(
+			ctx,
+			cs.dag,
+			dah,
+			cs.samplingConfidence,
+			timeout,
+			func(data namespace.PrefixedData8) {}, // noop
+			cs.ipldMetrics,
+		)
+		if err != nil {
+			check.err = err
+			return
 		}
-		return added, nil
+		elapsed := time.Since(start)
+		cs.metrics.ProposalSamplingSeconds.Observe(elapsed.Seconds())
+		logger.Info("Sampled proposal block data", "confidence", confidence, "elapsed", elapsed)
+	}()
+}
+
+// handleAvailabilityCheck casts the prevote which awaits the completed
+// availability check, if any. The proposal block is prevoted if its data is
+// available and nil otherwise.
+func (cs *State) handleAvailabilityCheck(check *availabilityCheck) {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	if check != cs.availability || !check.prevotePending {
+		return
+	}
+	check.prevotePending = false
+	// We moved on without prevoting in the meantime.
+	if check.prevoteRound != cs.Round ||
+		(cs.Step != cstypes.RoundStepPrevote && cs.Step != cstypes.RoundStepPrevoteWait) {
+		return
+	}
+
+	logger := cs.Logger.With("height", cs.Height, "round", cs.Round)
+	if check.err != nil {
+		logger.Error("enterPrevote: ProposalBlock data is unavailable", "err", check.err)
+		cs.metrics.UnavailableProposals.Add(1)
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+	// The proposal block changed while sampling, e.g. after +2/3 prevotes
+	// for another block.
+	if cs.ProposalBlock == nil || !bytes.Equal(cs.ProposalBlock.DataAvailabilityHeader.Hash(), check.dataHash) {
+		logger.Info("enterPrevote: ProposalBlock changed while sampling its data")
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+	logger.Info("enterPrevote: ProposalBlock is valid")
+	cs.signAddVote(tmproto.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header())
+}
+
+// cancelAvailabilityCheck stops sampling the data of a proposal block, if any.
+func (cs *State) cancelAvailabilityCheck() {
+	if cs.availability != nil {
+		cs.availability.cancel()
+		cs.availability = nil
 	}
-	return added, nil
 }
 
 // Attempt to add the vote. if its a duplicate signature, dupeout the validator
@@ -2161,7 +2604,7 @@ func (cs *State) signVote(
 }
 
 func (cs *State) voteTime() time.Time {
-	now := tmtime.Now()
+	now := cs.now()
 	minVoteTime := now
 	// TODO: We should remove next line in case we don't vote for v in case cs.ProposalBlock == nil,
 	// even if cs.LockedBlock != nil. See https://docs.tendermint.com/master/spec/.
@@ -7,19 +7,26 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-kit/kit/metrics/generic"
 	mdutils "github.com/ipfs/go-merkledag/test"
+	"github.com/lazyledger/rsmt2d"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/lazyledger/lazyledger-core/abci/example/counter"
 	cstypes "github.com/lazyledger/lazyledger-core/consensus/types"
 	"github.com/lazyledger/lazyledger-core/crypto/tmhash"
+	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
 	"github.com/lazyledger/lazyledger-core/libs/log"
 	tmpubsub "github.com/lazyledger/lazyledger-core/libs/pubsub"
 	tmrand "github.com/lazyledger/lazyledger-core/libs/rand"
+	"github.com/lazyledger/lazyledger-core/p2p/ipld"
+	"github.com/lazyledger/lazyledger-core/p2p/ipld/wrapper"
 	p2pmock "github.com/lazyledger/lazyledger-core/p2p/mock"
 	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+	sm "github.com/lazyledger/lazyledger-core/state"
 	"github.com/lazyledger/lazyledger-core/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
 )
 
 /*
@@ -303,6 +310,314 @@ func TestStateOversizedBlock(t *testing.T) {
 	signAddVotes(cs1, tmproto.PrecommitType, propBlock.Hash(), propBlock.MakePartSet(partSize).Header(), vs2)
 }
 
+// What we want:
+// P0 receives a proposal and the header of its block instead of the block parts.
+// It retrieves the block data from IPFS and prevotes for the proposal block.
+func TestStateProposalBlockFromIPFS(t *testing.T) {
+	cs1, vss := randState(4)
+	vs2, vs3, vs4 := vss[1], vss[2], vss[3]
+	height, round := cs1.Height, int32(1)
+
+	incrementRound(vs2, vs3, vs4)
+
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	pv1, err := cs1.privValidator.GetPubKey()
+	require.NoError(t, err)
+	addr := pv1.Address()
+	voteCh := subscribeToVoter(cs1, addr)
+
+	prop, propBlock := decideProposal(cs1, vs2, vs2.Height, vs2.Round)
+	propBlockHash := propBlock.Hash()
+	codec, err := types.Codec(cs1.state.ConsensusParams.DataAvailability.Codec)
+	require.NoError(t, err)
+	err = ipld.PutBlock(context.Background(), cs1.dag, propBlock, codec, ipld.NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+
+	// start round in which PO is not proposer
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+
+	// a header received before the proposal is rejected
+	cs1.mtx.Lock()
+	err = cs1.setProposalBlockHeader(&BlockHeaderMessage{height, round, &propBlock.Header, propBlock.LastCommit})
+	cs1.mtx.Unlock()
+	assert.Equal(t, errBlockHeaderBeforeProposal, err)
+
+	// a header of another block is rejected
+	otherHeader := propBlock.Header
+	otherHeader.Time = otherHeader.Time.Add(time.Second)
+	cs1.peerMsgQueue <- msgInfo{&ProposalMessage{prop}, "some peer"}
+	cs1.peerMsgQueue <- msgInfo{&BlockHeaderMessage{height, round, &otherHeader, propBlock.LastCommit}, "some peer"}
+	cs1.peerMsgQueue <- msgInfo{&BlockHeaderMessage{height, round, &propBlock.Header, propBlock.LastCommit}, "some peer"}
+
+	ensureNewProposal(proposalCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vss[0], propBlockHash)
+
+	rs := cs1.GetRoundState()
+	assert.True(t, rs.ProposalBlock.HashesTo(propBlockHash))
+	assert.True(t, rs.ProposalBlockParts.IsComplete())
+	assert.True(t, rs.ProposalBlockParts.HasHeader(prop.BlockID.PartSetHeader))
+	assert.Equal(t, propBlockHash, rs.ProposalBlockHeader.Hash())
+}
+
+// What we want:
+// P0 retries retrieving a proposal block from IPFS after it failed.
+func TestStateRetryProposalBlockRetrieval(t *testing.T) {
+	cs1, vss := randState(2)
+	vs2 := vss[1]
+
+	prop, propBlock := decideProposal(cs1, vs2, vs2.Height, vs2.Round)
+	cs1.Proposal = prop
+	cs1.ProposalBlockParts = types.NewPartSetFromHeader(prop.BlockID.PartSetHeader)
+	cs1.ProposalBlockHeader = &propBlock.Header
+	cs1.ProposalLastCommit = propBlock.LastCommit
+
+	receive := func() proposalBlockInfo {
+		select {
+		case pbi := <-cs1.proposalBlockQueue:
+			return pbi
+		case <-time.After(ensureTimeout):
+			t.Fatal("Timeout expired while waiting for the proposal block")
+			return proposalBlockInfo{}
+		}
+	}
+
+	// the block data is not in the DAG, so retrieving it fails
+	cs1.tryRetrieveProposalBlock()
+	pbi := receive()
+	require.Error(t, pbi.err)
+	cs1.handleProposalBlock(pbi)
+	assert.Nil(t, cs1.retrievalCancel)
+
+	codec, err := types.Codec(cs1.state.ConsensusParams.DataAvailability.Codec)
+	require.NoError(t, err)
+	err = ipld.PutBlock(context.Background(), cs1.dag, propBlock, codec, ipld.NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+
+	cs1.tryRetrieveProposalBlock()
+	pbi = receive()
+	require.NoError(t, pbi.err)
+	assert.True(t, pbi.block.HashesTo(propBlock.Hash()))
+}
+
+// What we want:
+// P0 builds a fraud proof if the retrieved proposal block data is not erasure
+// coded correctly, and reports it once the block is committed.
+func TestStateProposalBlockBadEncoding(t *testing.T) {
+	cs1, vss := randState(2)
+	vs2 := vss[1]
+	evpool := &evidenceRecorder{}
+	cs1.evpool = evpool
+
+	prop, propBlock := decideProposal(cs1, vs2, vs2.Height, vs2.Round)
+	codec, err := types.Codec(cs1.state.ConsensusParams.DataAvailability.Codec)
+	require.NoError(t, err)
+
+	// corrupt the first parity share of the first row and commit to the
+	// corrupted square
+	eds := propBlock.ExtendedDataSquare()
+	require.NotNil(t, eds)
+	width := eds.Width()
+	shares := make([][]byte, 0, width*width)
+	for i := uint(0); i < width; i++ {
+		shares = append(shares, eds.Row(i)...)
+	}
+	shares[width/2] = bytes.Repeat([]byte{0xFF}, consts.ShareSize)
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(width / 2))
+	badEDS, err := rsmt2d.ImportExtendedDataSquare(shares, codec, tree.Constructor)
+	require.NoError(t, err)
+	badDAH, err := types.NewDataAvailabilityHeader(badEDS)
+	require.NoError(t, err)
+	badBlock := &types.Block{Header: propBlock.Header, Data: propBlock.Data, LastCommit: propBlock.LastCommit}
+	badBlock.SetExtendedDataSquare(badEDS)
+	err = ipld.PutBlock(context.Background(), cs1.dag, badBlock, codec, ipld.NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+
+	// the first row is repaired from its corrupted parity share, as one of its
+	// original shares is missing
+	root := plugin.MustCidFromNamespacedSha256(badDAH.RowsRoots[0].Bytes())
+	nd, err := ipld.GetLeaf(context.Background(), cs1.dag, root, 0, uint32(width))
+	require.NoError(t, err)
+	require.NoError(t, cs1.dag.Remove(context.Background(), nd.Cid()))
+
+	prop.DAHeader = &badDAH
+	cs1.Proposal = prop
+	cs1.ProposalBlockParts = types.NewPartSetFromHeader(prop.BlockID.PartSetHeader)
+	cs1.ProposalBlockHeader = &propBlock.Header
+	cs1.ProposalLastCommit = propBlock.LastCommit
+
+	cs1.tryRetrieveProposalBlock()
+	var pbi proposalBlockInfo
+	select {
+	case pbi = <-cs1.proposalBlockQueue:
+	case <-time.After(ensureTimeout):
+		t.Fatal("Timeout expired while waiting for the proposal block")
+	}
+	var byzErr *ipld.ErrByzantineData
+	require.ErrorAs(t, pbi.err, &byzErr)
+	befp, ok := pbi.evidence.(*types.BadEncodingFraudProof)
+	require.True(t, ok)
+	assert.Equal(t, propBlock.Height, befp.BlockHeight)
+	assert.Equal(t, propBlock.Time, befp.Timestamp)
+	assert.True(t, befp.IsRow)
+	assert.Equal(t, uint32(0), befp.Index)
+
+	cs1.handleProposalBlock(pbi)
+	assert.Nil(t, cs1.retrievalCancel)
+	assert.Empty(t, evpool.added)
+
+	// only the fraud proof for the data of the committed block is reported
+	cs1.reportBadEncoding(propBlock)
+	assert.Empty(t, evpool.added)
+	cs1.reportBadEncoding(&types.Block{Header: types.Header{Height: propBlock.Height, DataHash: badDAH.Hash()}})
+	assert.Equal(t, []types.Evidence{befp}, evpool.added)
+}
+
+// evidenceRecorder records the evidence added to the pool after verification.
+type evidenceRecorder struct {
+	sm.EmptyEvidencePool
+	added []types.Evidence
+}
+
+func (r *evidenceRecorder) AddEvidence(ev types.Evidence) error {
+	r.added = append(r.added, ev)
+	return nil
+}
+
+// What we want:
+// P0 samples the data of a proposal block before prevoting. It prevotes for the
+// block if its data is available and nil otherwise. Without a DAG, the data is
+// not sampled.
+func TestStatePrevoteSampledAvailability(t *testing.T) {
+	testCases := []struct {
+		name      string
+		available bool
+		noDAG     bool
+	}{
+		{"available", true, false},
+		{"unavailable", false, false},
+		{"no DAG", true, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cs1, vss := randState(4)
+			vs2, vs3, vs4 := vss[1], vss[2], vss[3]
+			height, round := cs1.Height, int32(1)
+
+			csConfig := *cs1.config
+			csConfig.SampleAvailability = true
+			cs1.config = &csConfig
+			unavailable := generic.NewCounter("unavailable_proposals")
+			cs1.metrics.UnavailableProposals = unavailable
+
+			incrementRound(vs2, vs3, vs4)
+
+			newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+			pv1, err := cs1.privValidator.GetPubKey()
+			require.NoError(t, err)
+			addr := pv1.Address()
+			voteCh := subscribeToVoter(cs1, addr)
+
+			prop, propBlock := decideProposal(cs1, vs2, vs2.Height, vs2.Round)
+			propBlockParts := propBlock.MakePartSet(types.BlockPartSizeBytes)
+			if tc.available {
+				codec, err := types.Codec(cs1.state.ConsensusParams.DataAvailability.Codec)
+				require.NoError(t, err)
+				err = ipld.PutBlock(context.Background(), cs1.dag, propBlock, codec, ipld.NopMetrics(), log.TestingLogger())
+				require.NoError(t, err)
+			}
+			if tc.noDAG {
+				cs1.dag = nil
+			}
+
+			// start round in which PO is not proposer
+			startTestRound(cs1, height, round)
+			ensureNewRound(newRoundCh, height, round)
+
+			err = cs1.SetProposalAndBlock(prop, propBlock, propBlockParts, "some peer")
+			require.NoError(t, err)
+
+			ensurePrevote(voteCh, height, round)
+			if tc.available {
+				validatePrevote(t, cs1, round, vss[0], propBlock.Hash())
+				assert.Zero(t, unavailable.Value())
+			} else {
+				validatePrevote(t, cs1, round, vss[0], nil)
+				assert.EqualValues(t, 1, unavailable.Value())
+			}
+		})
+	}
+}
+
+// What we want:
+// P0 prevotes nil for a proposal block whose data is unavailable until the
+// sampling times out. The same block is proposed again in the next round, by
+// when its data became available. P0 samples its data again and prevotes it.
+func TestStatePrevoteResampledAvailability(t *testing.T) {
+	cs1, vss := randState(4)
+	vs2, vs3, vs4 := vss[1], vss[2], vss[3]
+	height, round := cs1.Height, int32(1)
+
+	csConfig := *cs1.config
+	csConfig.SampleAvailability = true
+	cs1.config = &csConfig
+	unavailable := generic.NewCounter("unavailable_proposals")
+	cs1.metrics.UnavailableProposals = unavailable
+
+	incrementRound(vs2, vs3, vs4)
+
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	pv1, err := cs1.privValidator.GetPubKey()
+	require.NoError(t, err)
+	addr := pv1.Address()
+	voteCh := subscribeToVoter(cs1, addr)
+
+	prop, propBlock := decideProposal(cs1, vs2, vs2.Height, vs2.Round)
+	propBlockParts := propBlock.MakePartSet(types.BlockPartSizeBytes)
+
+	// start round in which PO is not proposer
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+
+	err = cs1.SetProposalAndBlock(prop, propBlock, propBlockParts, "some peer")
+	require.NoError(t, err)
+
+	// the data is not put to IPFS, so sampling times out
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vss[0], nil)
+	assert.EqualValues(t, 1, unavailable.Value())
+
+	codec, err := types.Codec(cs1.state.ConsensusParams.DataAvailability.Codec)
+	require.NoError(t, err)
+	err = ipld.PutBlock(context.Background(), cs1.dag, propBlock, codec, ipld.NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+
+	// the others precommit nil too, moving on to the next round
+	signAddVotes(cs1, tmproto.PrecommitType, nil, types.PartSetHeader{}, vs2, vs3, vs4)
+	round++
+	ensureNewRound(newRoundCh, height, round)
+	incrementRound(vs2, vs3, vs4)
+
+	// the proposer of the next round proposes the same block
+	propBlockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
+	prop = types.NewProposal(height, round, -1, propBlockID, &propBlock.DataAvailabilityHeader)
+	p, err := prop.ToProto()
+	require.NoError(t, err)
+	require.NoError(t, vs3.SignProposal(cs1.state.ChainID, p))
+	prop.Signature = p.Signature
+
+	err = cs1.SetProposalAndBlock(prop, propBlock, propBlockParts, "some peer")
+	require.NoError(t, err)
+
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vss[0], propBlock.Hash())
+	assert.EqualValues(t, 1, unavailable.Value())
+}
+
 //----------------------------------------------------------------------------------------------------
 // FullRoundSuite
 
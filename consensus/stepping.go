@@ -0,0 +1,47 @@
+package consensus
+
+import (
+	"github.com/lazyledger/lazyledger-core/p2p"
+)
+
+// The following methods drive a State which is not started in place of its
+// receive routine, one message or timeout at a time, e.g. to run validators
+// deterministically on virtual time, see the consensus/sim package. The
+// timeouts are scheduled on the TimeoutTicker set via SetTimeoutTicker. The
+// messages and timeouts are not written to the WAL.
+
+// ScheduleRound0 schedules entering the first round of the current height,
+// like Start does.
+func (cs *State) ScheduleRound0() {
+	cs.scheduleRound0(cs.GetRoundState())
+}
+
+// HandleMessage handles a proposal, block part, block header or vote received
+// from the given peer, or sent by the State to itself if the peer ID is empty,
+// see NextInternalMessage.
+func (cs *State) HandleMessage(msg Message, peerID p2p.ID) {
+	cs.handleMsg(msgInfo{msg, peerID})
+	// the statistics are read by the reactor, which is not running
+	select {
+	case <-cs.statsMsgQueue:
+	default:
+	}
+}
+
+// HandleTimeout handles a timeout scheduled on the TimeoutTicker once its
+// duration passed.
+func (cs *State) HandleTimeout(ti TimeoutInfo) {
+	cs.handleTimeout(ti, *cs.GetRoundState())
+}
+
+// NextInternalMessage returns the next proposal, block part, block header or
+// vote the State sent to itself, which is yet to be handled via HandleMessage
+// and sent to the peers. It returns false if there is none.
+func (cs *State) NextInternalMessage() (Message, bool) {
+	select {
+	case mi := <-cs.internalMsgQueue:
+		return mi.Msg, true
+	default:
+		return nil, false
+	}
+}
@@ -76,9 +76,13 @@ type RoundState struct {
 	Proposal           *types.Proposal     `json:"proposal"`
 	ProposalBlock      *types.Block        `json:"proposal_block"`
 	ProposalBlockParts *types.PartSet      `json:"proposal_block_parts"`
-	LockedRound        int32               `json:"locked_round"`
-	LockedBlock        *types.Block        `json:"locked_block"`
-	LockedBlockParts   *types.PartSet      `json:"locked_block_parts"`
+	// Header and last commit of a proposal block to retrieve by its
+	// DataAvailabilityHeader. nil if the block is received in parts.
+	ProposalBlockHeader *types.Header  `json:"proposal_block_header"`
+	ProposalLastCommit  *types.Commit  `json:"proposal_last_commit"`
+	LockedRound         int32          `json:"locked_round"`
+	LockedBlock         *types.Block   `json:"locked_block"`
+	LockedBlockParts    *types.PartSet `json:"locked_block_parts"`
 
 	// Last known round with POL for non-nil valid block.
 	ValidRound int32        `json:"valid_round"`
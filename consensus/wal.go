@@ -38,7 +38,7 @@ type TimedWALMessage struct {
 }
 
 // EndHeightMessage marks the end of the given height inside WAL.
-// @internal used by scripts/wal2json util.
+// @internal used by the `tendermint wal to-json` command.
 type EndHeightMessage struct {
 	Height int64 `json:"height"`
 }
@@ -47,7 +47,7 @@ type WALMessage interface{}
 
 func init() {
 	tmjson.RegisterType(msgInfo{}, "tendermint/wal/MsgInfo")
-	tmjson.RegisterType(timeoutInfo{}, "tendermint/wal/TimeoutInfo")
+	tmjson.RegisterType(TimeoutInfo{}, "tendermint/wal/TimeoutInfo")
 	tmjson.RegisterType(EndHeightMessage{}, "tendermint/wal/EndHeightMessage")
 }
 
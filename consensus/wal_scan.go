@@ -0,0 +1,236 @@
+package consensus
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+
+	auto "github.com/lazyledger/lazyledger-core/libs/autofile"
+	"github.com/lazyledger/lazyledger-core/types"
+)
+
+// WALPosition is the position of a message in a file of a WAL group.
+type WALPosition struct {
+	Path   string
+	Offset int64
+}
+
+func (pos WALPosition) String() string {
+	return fmt.Sprintf("%s:%d", pos.Path, pos.Offset)
+}
+
+// WALCorruption is the position of a corrupted message in a WAL group. The
+// rest of the file can not be decoded, as the following messages can not be
+// located anymore.
+type WALCorruption struct {
+	WALPosition
+	Err error
+}
+
+// WALHeight summarizes the messages written to a WAL group for a height.
+type WALHeight struct {
+	// Height is 0 if no EndHeightMessage has been read at all.
+	Height int64
+	// Start is the position of the first message of the height.
+	Start WALPosition
+	// Messages is the number of messages by type, see WALMessageType.
+	Messages map[string]int
+	// Ended is true if the EndHeightMessage of the height has been read.
+	Ended bool
+}
+
+// ScanWAL decodes the messages of the WAL group with the given head path,
+// starting with the oldest file, and calls fn with each message and its
+// position. fn may be nil. Decoding a file stops at the first corrupted
+// message and continues with the next file. The corruptions are returned in
+// the order they are encountered.
+func ScanWAL(walFile string, fn func(WALPosition, *TimedWALMessage) error) ([]WALCorruption, error) {
+	paths, err := walFilePaths(walFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var corruptions []WALCorruption
+	for _, path := range paths {
+		corruption, err := scanWALFile(path, fn)
+		if err != nil {
+			return nil, err
+		}
+		if corruption != nil {
+			corruptions = append(corruptions, *corruption)
+		}
+	}
+	return corruptions, nil
+}
+
+// InspectWAL summarizes the messages of the WAL group with the given head path
+// by height. Messages are assigned to the height of the EndHeightMessage
+// following them.
+func InspectWAL(walFile string) ([]WALHeight, []WALCorruption, error) {
+	var (
+		heights []WALHeight
+		current *WALHeight
+	)
+	corruptions, err := ScanWAL(walFile, func(pos WALPosition, msg *TimedWALMessage) error {
+		if current == nil {
+			var height int64
+			if len(heights) > 0 {
+				height = heights[len(heights)-1].Height + 1
+			}
+			current = &WALHeight{Height: height, Start: pos, Messages: make(map[string]int)}
+		}
+		current.Messages[WALMessageType(msg.Msg)]++
+
+		if m, ok := msg.Msg.(EndHeightMessage); ok {
+			current.Height = m.Height
+			current.Ended = true
+			heights = append(heights, *current)
+			current = nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if current != nil {
+		heights = append(heights, *current)
+	}
+	return heights, corruptions, nil
+}
+
+// TruncateWAL removes the corrupted tail which a crash while writing leaves
+// behind from the WAL group with the given head path. The head file is
+// truncated at its first corrupted message, after the removed bytes have been
+// copied to backup, if given. It returns the removed corruption or nil if the
+// WAL is not corrupted.
+//
+// Corruptions before the tail are not repaired, as the messages following
+// them would be lost, and an error is returned instead.
+func TruncateWAL(walFile string, backup io.Writer) (*WALCorruption, error) {
+	paths, err := walFilePaths(walFile)
+	if err != nil {
+		return nil, err
+	}
+	corruptions, err := ScanWAL(walFile, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(corruptions) == 0 {
+		return nil, nil
+	}
+	tail := corruptions[len(corruptions)-1]
+	if len(corruptions) > 1 || tail.Path != paths[len(paths)-1] {
+		return nil, fmt.Errorf("WAL is corrupted before its tail at %v: %w",
+			corruptions[0].WALPosition, corruptions[0].Err)
+	}
+
+	f, err := os.OpenFile(tail.Path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if backup != nil {
+		if _, err := f.Seek(tail.Offset, io.SeekStart); err != nil {
+			return nil, err
+		}
+		if _, err := io.Copy(backup, f); err != nil {
+			return nil, fmt.Errorf("failed to back up corrupted tail: %w", err)
+		}
+	}
+	if err := f.Truncate(tail.Offset); err != nil {
+		return nil, err
+	}
+	if err := f.Sync(); err != nil {
+		return nil, err
+	}
+	return &tail, nil
+}
+
+// WALMessageType returns the name of the type of the given WAL message. For
+// messages received from peers or ourselves, it is the type of the consensus
+// message, e.g. VoteMessage.
+func WALMessageType(msg WALMessage) string {
+	switch msg := msg.(type) {
+	case types.EventDataRoundState:
+		return "EventDataRoundState"
+	case msgInfo:
+		t := reflect.TypeOf(msg.Msg)
+		if t == nil {
+			return "MsgInfo"
+		}
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		return t.Name()
+	case TimeoutInfo:
+		return "TimeoutInfo"
+	case EndHeightMessage:
+		return "EndHeightMessage"
+	default:
+		return fmt.Sprintf("%T", msg)
+	}
+}
+
+// walFilePaths returns the paths of the files of the WAL group with the given
+// head path, starting with the oldest one.
+func walFilePaths(walFile string) ([]string, error) {
+	// opening the group creates the head, which must not happen by accident
+	if _, err := os.Stat(walFile); err != nil {
+		return nil, err
+	}
+	group, err := auto.OpenGroup(walFile)
+	if err != nil {
+		return nil, err
+	}
+	defer group.Close()
+
+	var paths []string
+	for index := group.MinIndex(); index <= group.MaxIndex(); index++ {
+		paths = append(paths, group.FilePath(index))
+	}
+	return paths, nil
+}
+
+func scanWALFile(path string, fn func(WALPosition, *TimedWALMessage) error) (*WALCorruption, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rd := &offsetReader{rd: bufio.NewReader(f)}
+	dec := NewWALDecoder(rd)
+	for {
+		pos := WALPosition{Path: path, Offset: rd.offset}
+		msg, err := dec.Decode()
+		if err == io.EOF {
+			return nil, nil
+		}
+		if IsDataCorruptionError(err) {
+			return &WALCorruption{WALPosition: pos, Err: err}, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if fn == nil {
+			continue
+		}
+		if err := fn(pos, msg); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// offsetReader counts the bytes read. Like auto.GroupReader, it fills the
+// given buffer completely unless the end of the input is reached.
+type offsetReader struct {
+	rd     io.Reader
+	offset int64
+}
+
+func (r *offsetReader) Read(p []byte) (int, error) {
+	n, err := io.ReadFull(r.rd, p)
+	r.offset += int64(n)
+	return n, err
+}
@@ -0,0 +1,126 @@
+package consensus
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/lazyledger/lazyledger-core/consensus/types"
+	tmtypes "github.com/lazyledger/lazyledger-core/types"
+)
+
+func TestInspectWAL(t *testing.T) {
+	walFile := filepath.Join(t.TempDir(), "wal")
+	// the rotated file holds the heights up to 1, the head the following ones
+	writeWALFile(t, walFile+".000",
+		EndHeightMessage{0},
+		tmtypes.EventDataRoundState{Height: 1, Step: cstypes.RoundStepPropose.String()},
+		TimeoutInfo{Duration: time.Second, Height: 1, Step: cstypes.RoundStepPropose},
+		EndHeightMessage{1},
+	)
+	writeWALFile(t, walFile,
+		tmtypes.EventDataRoundState{Height: 2, Step: cstypes.RoundStepPropose.String()},
+		msgInfo{Msg: &HasVoteMessage{Height: 2, Type: 1, Index: 0}},
+		EndHeightMessage{2},
+		tmtypes.EventDataRoundState{Height: 3, Step: cstypes.RoundStepPropose.String()},
+	)
+
+	heights, corruptions, err := InspectWAL(walFile)
+	require.NoError(t, err)
+	assert.Empty(t, corruptions)
+	require.Len(t, heights, 4)
+
+	assert.Equal(t, WALHeight{
+		Height:   0,
+		Start:    WALPosition{walFile + ".000", 0},
+		Messages: map[string]int{"EndHeightMessage": 1},
+		Ended:    true,
+	}, heights[0])
+	assert.Equal(t, int64(1), heights[1].Height)
+	assert.Equal(t, map[string]int{"EventDataRoundState": 1, "TimeoutInfo": 1, "EndHeightMessage": 1},
+		heights[1].Messages)
+	assert.Equal(t, WALHeight{
+		Height:   2,
+		Start:    WALPosition{walFile, 0},
+		Messages: map[string]int{"EventDataRoundState": 1, "HasVoteMessage": 1, "EndHeightMessage": 1},
+		Ended:    true,
+	}, heights[2])
+	// the last height has not ended yet
+	assert.Equal(t, int64(3), heights[3].Height)
+	assert.False(t, heights[3].Ended)
+}
+
+func TestTruncateWAL(t *testing.T) {
+	walFile := filepath.Join(t.TempDir(), "wal")
+	writeWALFile(t, walFile, EndHeightMessage{0}, EndHeightMessage{1})
+	valid, err := ioutil.ReadFile(walFile)
+	require.NoError(t, err)
+
+	// nothing is truncated from a valid WAL
+	corruption, err := TruncateWAL(walFile, nil)
+	require.NoError(t, err)
+	assert.Nil(t, corruption)
+
+	// a message written partially by a crash is truncated
+	tail := encodeWAL(t, EndHeightMessage{2})
+	tail = tail[:len(tail)-1]
+	appendFile(t, walFile, tail)
+
+	corruptions, err := ScanWAL(walFile, nil)
+	require.NoError(t, err)
+	require.Len(t, corruptions, 1)
+	assert.Equal(t, WALPosition{walFile, int64(len(valid))}, corruptions[0].WALPosition)
+	assert.True(t, IsDataCorruptionError(corruptions[0].Err))
+
+	var backup bytes.Buffer
+	corruption, err = TruncateWAL(walFile, &backup)
+	require.NoError(t, err)
+	require.NotNil(t, corruption)
+	assert.Equal(t, corruptions[0].WALPosition, corruption.WALPosition)
+	assert.Equal(t, tail, backup.Bytes())
+	truncated, err := ioutil.ReadFile(walFile)
+	require.NoError(t, err)
+	assert.Equal(t, valid, truncated)
+
+	// corruptions before the tail are not truncated
+	writeWALFile(t, walFile+".000", EndHeightMessage{0})
+	appendFile(t, walFile+".000", tail)
+	_, err = TruncateWAL(walFile, nil)
+	assert.Error(t, err)
+}
+
+func TestScanWALMissing(t *testing.T) {
+	walFile := filepath.Join(t.TempDir(), "wal")
+	_, err := ScanWAL(walFile, nil)
+	assert.True(t, os.IsNotExist(err))
+	// the head is not created by scanning
+	_, err = os.Stat(walFile)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func encodeWAL(t *testing.T, msgs ...WALMessage) []byte {
+	var b bytes.Buffer
+	enc := NewWALEncoder(&b)
+	for _, msg := range msgs {
+		require.NoError(t, enc.Encode(&TimedWALMessage{fixedTime, msg}))
+	}
+	return b.Bytes()
+}
+
+func writeWALFile(t *testing.T, path string, msgs ...WALMessage) {
+	require.NoError(t, ioutil.WriteFile(path, encodeWAL(t, msgs...), 0600))
+}
+
+func appendFile(t *testing.T, path string, data []byte) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
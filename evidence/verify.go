@@ -7,8 +7,13 @@ import (
 	"sort"
 	"time"
 
+	"github.com/lazyledger/nmt"
+	"github.com/lazyledger/nmt/namespace"
+	"github.com/lazyledger/rsmt2d"
+
 	"github.com/lazyledger/lazyledger-core/light"
 	"github.com/lazyledger/lazyledger-core/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
 )
 
 // verify verifies the evidence fully by checking:
@@ -109,6 +114,15 @@ func (evpool *Pool) verify(evidence types.Evidence) error {
 		}
 
 		return nil
+
+	case *types.BadEncodingFraudProof:
+		// the erasure codec is fixed at genesis
+		codec, err := types.Codec(state.ConsensusParams.DataAvailability.Codec)
+		if err != nil {
+			return err
+		}
+		return VerifyBadEncoding(ev, &blockMeta.DAHeader, codec)
+
 	default:
 		return fmt.Errorf("unrecognized evidence type: %T", evidence)
 	}
@@ -224,6 +238,80 @@ func VerifyDuplicateVote(e *types.DuplicateVoteEvidence, chainID string, valSet
 	return nil
 }
 
+// VerifyBadEncoding verifies BadEncodingFraudProof against the DataAvailabilityHeader of the block it
+// refers to. This involves the following checks:
+//      - the row or column and all shares are within the bounds of the extended data square
+//      - there are enough shares to reconstruct the row or column
+//      - every share is included in the root of its orthogonal column or row
+//      - the row or column reconstructed from the shares does not match the committed root
+func VerifyBadEncoding(e *types.BadEncodingFraudProof, dah *types.DataAvailabilityHeader, codec rsmt2d.Codec) error {
+	width := uint32(len(dah.RowsRoots))
+	if width == 0 || uint32(len(dah.ColumnRoots)) != width {
+		return fmt.Errorf("invalid data availability header with %d row and %d column roots",
+			len(dah.RowsRoots), len(dah.ColumnRoots))
+	}
+	if e.Index >= width {
+		return fmt.Errorf("index %d is out of range of the extended square width %d", e.Index, width)
+	}
+
+	squareSize := width / 2
+	if uint32(len(e.Shares)) < squareSize {
+		return fmt.Errorf("not enough shares to reconstruct the data, got: %d, want: %d", len(e.Shares), squareSize)
+	}
+
+	root, orthogonalRoots := dah.ColumnRoots[e.Index], dah.RowsRoots
+	if e.IsRow {
+		root, orthogonalRoots = dah.RowsRoots[e.Index], dah.ColumnRoots
+	}
+
+	// verify the shares against the orthogonal roots
+	shares := make([][]byte, width)
+	for _, sp := range e.Shares {
+		if sp.Index >= width {
+			return fmt.Errorf("share index %d is out of range of the extended square width %d", sp.Index, width)
+		}
+		nID := leafNamespace(sp.Share, e.Index, sp.Index, squareSize)
+		if !sp.Proof.ToNMT().VerifyInclusion(consts.NewBaseHashFunc(), nID, sp.Share, orthogonalRoots[sp.Index]) {
+			return fmt.Errorf("invalid inclusion proof for share %d", sp.Index)
+		}
+		shares[sp.Index] = sp.Share
+	}
+
+	// reconstruct the row or column from the shares
+	decoded, err := codec.Decode(shares)
+	if err != nil {
+		return fmt.Errorf("failure to decode shares: %w", err)
+	}
+	parity, err := codec.Encode(decoded[:squareSize])
+	if err != nil {
+		return fmt.Errorf("failure to encode shares: %w", err)
+	}
+	extended := append(append(make([][]byte, 0, width), decoded[:squareSize]...), parity...)
+
+	// shares committed to by the orthogonal roots that are not part of a valid
+	// extension already prove the bad encoding
+	for _, sp := range e.Shares {
+		if !bytes.Equal(sp.Share, extended[sp.Index]) {
+			return nil
+		}
+	}
+
+	// otherwise the recomputed root has to differ from the committed one
+	tree := nmt.New(consts.NewBaseHashFunc, nmt.NamespaceIDSize(consts.NamespaceSize))
+	for i, share := range extended {
+		nID := leafNamespace(share, e.Index, uint32(i), squareSize)
+		if err := tree.Push(append(append(make([]byte, 0, len(nID)+len(share)), nID...), share...)); err != nil {
+			// the committed root could not have been computed from this data
+			return nil
+		}
+	}
+	if bytes.Equal(tree.Root().Bytes(), root.Bytes()) {
+		return errors.New("reconstructed data matches the committed root")
+	}
+
+	return nil
+}
+
 func getSignedHeader(blockStore BlockStore, height int64) (*types.SignedHeader, error) {
 	blockMeta := blockStore.LoadBlockMeta(height)
 	if blockMeta == nil {
@@ -250,3 +338,16 @@ func isInvalidHeader(trusted, conflicting *types.Header) bool {
 		!bytes.Equal(trusted.AppHash, conflicting.AppHash) ||
 		!bytes.Equal(trusted.LastResultsHash, conflicting.LastResultsHash)
 }
+
+// leafNamespace returns the namespace of the share at the given position of a
+// row or column in the extended data square. Shares outside of the original
+// data square are namespaced with the parity shares namespace.
+func leafNamespace(share []byte, axisIdx, shareIdx, squareSize uint32) namespace.ID {
+	nID := make(namespace.ID, consts.NamespaceSize)
+	if axisIdx >= squareSize || shareIdx >= squareSize {
+		copy(nID, consts.ParitySharesNamespaceID)
+	} else {
+		copy(nID, share[:consts.NamespaceSize])
+	}
+	return nID
+}
@@ -1,9 +1,12 @@
 package evidence_test
 
 import (
+	"crypto/sha256"
 	"testing"
 	"time"
 
+	"github.com/lazyledger/nmt"
+	"github.com/lazyledger/rsmt2d"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -13,11 +16,14 @@ import (
 	"github.com/lazyledger/lazyledger-core/evidence/mocks"
 	"github.com/lazyledger/lazyledger-core/libs/db/memdb"
 	"github.com/lazyledger/lazyledger-core/libs/log"
+	tmrand "github.com/lazyledger/lazyledger-core/libs/rand"
+	"github.com/lazyledger/lazyledger-core/p2p/ipld/wrapper"
 	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
 	tmversion "github.com/lazyledger/lazyledger-core/proto/tendermint/version"
 	sm "github.com/lazyledger/lazyledger-core/state"
 	smmocks "github.com/lazyledger/lazyledger-core/state/mocks"
 	"github.com/lazyledger/lazyledger-core/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
 	"github.com/lazyledger/lazyledger-core/version"
 )
 
@@ -444,3 +450,100 @@ func makeBlockID(hash []byte, partSetSize uint32, partSetHash []byte) types.Bloc
 		},
 	}
 }
+
+func TestVerifyBadEncoding(t *testing.T) {
+	const squareSize = 2
+
+	shares := make([][]byte, squareSize*squareSize)
+	for i := range shares {
+		nID := []byte{1, 1, 1, 1, 1, 1, 1, byte(i)}
+		shares[i] = append(nID, tmrand.Bytes(consts.ShareSize-consts.NamespaceSize)...)
+	}
+	tree := wrapper.NewErasuredNamespacedMerkleTree(squareSize)
+	eds, err := rsmt2d.ComputeExtendedDataSquare(shares, rsmt2d.NewRSGF8Codec(), tree.Constructor)
+	require.NoError(t, err)
+
+	square := make([][][]byte, eds.Width())
+	for i := range square {
+		square[i] = eds.Row(uint(i))
+	}
+	honestDAH, honestCols := makeSquareCommitment(t, square)
+
+	// commit to a square with a corrupted parity share in the first row
+	square[0][eds.Width()-1] = make([]byte, consts.ShareSize)
+	byzantineDAH, byzantineCols := makeSquareCommitment(t, square)
+
+	// proves the first row with the shares of the first columns
+	makeProof := func(cols []*nmt.NamespacedMerkleTree, indices ...uint32) *types.BadEncodingFraudProof {
+		befp := &types.BadEncodingFraudProof{BlockHeight: 1, IsRow: true, Index: 0, Timestamp: defaultEvidenceTime}
+		for _, idx := range indices {
+			proof, err := cols[idx].Prove(0)
+			require.NoError(t, err)
+			befp.Shares = append(befp.Shares, types.ShareProof{
+				Index: idx,
+				Share: square[0][idx],
+				Proof: types.NewNMTProof(proof),
+			})
+		}
+		return befp
+	}
+
+	invalidShare := makeProof(byzantineCols, 0, 1)
+	invalidShare.Shares[1].Share = square[1][1]
+
+	testCases := []struct {
+		name      string
+		befp      *types.BadEncodingFraudProof
+		dah       *types.DataAvailabilityHeader
+		expectErr bool
+	}{
+		{"bad encoding", makeProof(byzantineCols, 0, 1), byzantineDAH, false},
+		{"correct encoding", makeProof(honestCols, 0, 1), honestDAH, true},
+		{"not enough shares", makeProof(byzantineCols, 0), byzantineDAH, true},
+		{"invalid share proof", invalidShare, byzantineDAH, true},
+		{"index out of range", makeProof(byzantineCols, 0, 1), &types.DataAvailabilityHeader{}, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := evidence.VerifyBadEncoding(tc.befp, tc.dah, rsmt2d.NewRSGF8Codec())
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// makeSquareCommitment computes the row and column roots of the extended data
+// square and returns them together with the column trees.
+func makeSquareCommitment(
+	t *testing.T,
+	square [][][]byte,
+) (*types.DataAvailabilityHeader, []*nmt.NamespacedMerkleTree) {
+	width := len(square)
+	newTree := func(axis func(i int) []byte, axisIdx int) *nmt.NamespacedMerkleTree {
+		tree := nmt.New(sha256.New, nmt.NamespaceIDSize(consts.NamespaceSize))
+		for i := 0; i < width; i++ {
+			share := axis(i)
+			nID := consts.ParitySharesNamespaceID
+			if axisIdx < width/2 && i < width/2 {
+				nID = share[:consts.NamespaceSize]
+			}
+			require.NoError(t, tree.Push(append(append(make([]byte, 0), nID...), share...)))
+		}
+		return tree
+	}
+
+	dah := &types.DataAvailabilityHeader{}
+	cols := make([]*nmt.NamespacedMerkleTree, width)
+	for r := 0; r < width; r++ {
+		dah.RowsRoots = append(dah.RowsRoots, newTree(func(i int) []byte { return square[r][i] }, r).Root())
+	}
+	for c := 0; c < width; c++ {
+		cols[c] = newTree(func(i int) []byte { return square[i][c] }, c)
+		dah.ColumnRoots = append(dah.ColumnRoots, cols[c].Root())
+	}
+	return dah, cols
+}
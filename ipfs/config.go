@@ -1,6 +1,10 @@
 package ipfs
 
-import "path/filepath"
+import (
+	"errors"
+	"path/filepath"
+	"time"
+)
 
 // Config defines a subset of the IPFS config that will be passed to the IPFS init and IPFS node (as a service)
 // spun up by the tendermint node.
@@ -11,6 +15,34 @@ type Config struct {
 	// The default is ~/.tendermint/ipfs.
 	RepoPath string `mapstructure:"repo-path"`
 	ServeAPI bool   `mapstructure:"serve-api"`
+	// RetainBlocks is the minimum number of recent heights whose block data is
+	// kept in the IPFS repo when blocks are pruned. It allows serving samples
+	// for longer than the blocks are retained. 0 prunes the block data
+	// together with the blocks.
+	RetainBlocks int64 `mapstructure:"retain-blocks"`
+	// DHTClient runs the DHT in client mode, i.e. only fetching records. It
+	// is meant for nodes which only sample and do not serve block data.
+	DHTClient bool `mapstructure:"dht-client"`
+	// RemoteAPI is the address of the HTTP API of an external IPFS daemon,
+	// e.g. /ip4/127.0.0.1/tcp/5001. If set, the daemon is used instead of an
+	// embedded IPFS node and RepoPath, ServeAPI and DHTClient are ignored.
+	// The daemon must have the NMT plugin loaded.
+	RemoteAPI string `mapstructure:"remote-api"`
+	// PublishQueueSize is the number of proposal blocks which can be queued
+	// or being put to IPFS at once. Proposals are not published while the
+	// queue is full.
+	PublishQueueSize int `mapstructure:"publish-queue-size"`
+	// ReprovideInterval is the interval in which the block data of all
+	// retained heights is announced to the DHT again, so that it remains
+	// discoverable after the provider records expire. 0 disables reproviding.
+	ReprovideInterval time.Duration `mapstructure:"reprovide-interval"`
+	// SamplingConfidence is the confidence with which data availability
+	// sampling detects that block data is unavailable. It determines the
+	// number of samples taken depending on the size of the block data.
+	SamplingConfidence float64 `mapstructure:"sampling-confidence"`
+	// SamplingTimeout is the time within which all samples have to be
+	// retrieved for block data to be deemed available.
+	SamplingTimeout time.Duration `mapstructure:"sampling-timeout"`
 }
 
 // DefaultConfig returns a default config different from the default IPFS config.
@@ -18,9 +50,39 @@ type Config struct {
 // locally for testing purposes.
 func DefaultConfig() *Config {
 	return &Config{
-		RepoPath: "ipfs",
-		ServeAPI: false,
+		RepoPath:     "ipfs",
+		ServeAPI:     false,
+		RetainBlocks: 0,
+		DHTClient:    false,
+		RemoteAPI:    "",
+
+		PublishQueueSize:  16,
+		ReprovideInterval: 12 * time.Hour,
+
+		SamplingConfidence: 0.9999,
+		SamplingTimeout:    10 * time.Minute,
+	}
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *Config) ValidateBasic() error {
+	if cfg.RetainBlocks < 0 {
+		return errors.New("retain-blocks can't be negative")
+	}
+	if cfg.PublishQueueSize <= 0 {
+		return errors.New("publish-queue-size must be positive")
+	}
+	if cfg.ReprovideInterval < 0 {
+		return errors.New("reprovide-interval can't be negative")
+	}
+	if cfg.SamplingConfidence <= 0 || cfg.SamplingConfidence > 1 {
+		return errors.New("sampling-confidence must be within (0, 1]")
+	}
+	if cfg.SamplingTimeout <= 0 {
+		return errors.New("sampling-timeout must be positive")
 	}
+	return nil
 }
 
 func (cfg *Config) Path() string {
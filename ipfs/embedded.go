@@ -23,7 +23,7 @@ import (
 // Embedded is the provider that embeds IPFS node within the same process.
 // It also returns closable for graceful node shutdown.
 func Embedded(init bool, cfg *Config, logger log.Logger) NodeProvider {
-	return func() (*core.IpfsNode, error) {
+	return func() (*Node, error) {
 		path := cfg.Path()
 		defer os.Setenv(ipfscfg.EnvDir, path)
 
@@ -51,9 +51,11 @@ func Embedded(init bool, cfg *Config, logger log.Logger) NodeProvider {
 			Online: true,
 			// This option sets the node to be a full DHT node (both fetching and storing DHT Records)
 			Routing: libp2p.DHTOption,
+			Repo:    repo,
+		}
+		if cfg.DHTClient {
 			// This option sets the node to be a client DHT node (only fetching records)
-			// Routing: libp2p.DHTClientOption,
-			Repo: repo,
+			nodeOptions.Routing = libp2p.DHTClientOption
 		}
 		// Internally, ipfs decorates the context with a
 		// context.WithCancel. Which is then used for lifecycle management.
@@ -78,7 +80,7 @@ func Embedded(init bool, cfg *Config, logger log.Logger) NodeProvider {
 		}
 
 		logger.Info("Successfully created embedded IPFS node", "ipfs-repo", path)
-		return node, nil
+		return newNode(node), nil
 	}
 }
 
@@ -17,7 +17,7 @@ import (
 
 // Mock provides simple mock IPFS API useful for testing
 func Mock() NodeProvider {
-	return func() (*core.IpfsNode, error) {
+	return func() (*Node, error) {
 		plugin.EnableNMT()
 
 		nd, err := MockNode()
@@ -25,7 +25,7 @@ func Mock() NodeProvider {
 			return nil, err
 		}
 
-		return nd, nil
+		return newNode(nd), nil
 	}
 }
 
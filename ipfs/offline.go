@@ -0,0 +1,44 @@
+package ipfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	ipfscfg "github.com/ipfs/go-ipfs-config"
+	"github.com/ipfs/go-ipfs/core"
+	"github.com/ipfs/go-ipfs/repo/fsrepo"
+)
+
+// Offline is the provider of an IPFS node which only accesses the local repo
+// and does not connect to the network. It is meant for tools operating on the
+// repo of a stopped node, as the repo can only be opened by a single node.
+func Offline(cfg *Config) NodeProvider {
+	return func() (*Node, error) {
+		path := cfg.Path()
+		defer os.Setenv(ipfscfg.EnvDir, path)
+
+		if err := plugins(path); err != nil {
+			return nil, err
+		}
+		repo, err := fsrepo.Open(path)
+		if err != nil {
+			var nrerr fsrepo.NoRepoError
+			if errors.As(err, &nrerr) {
+				return nil, fmt.Errorf("no IPFS repo found in %s", nrerr.Path)
+			}
+			return nil, err
+		}
+
+		node, err := core.NewNode(context.Background(), &core.BuildCfg{
+			Online: false,
+			Repo:   repo,
+		})
+		if err != nil {
+			_ = repo.Close()
+			return nil, err
+		}
+		return newNode(node), nil
+	}
+}
@@ -405,3 +405,19 @@ func MustCidFromNamespacedSha256(hash []byte) cid.Cid {
 	}
 	return cid
 }
+
+// NamespacedSha256FromCID derives the namespaced hash of an NMT node from the
+// given CID. It is the inverse of CidFromNamespacedSha256.
+func NamespacedSha256FromCID(id cid.Cid) ([]byte, error) {
+	decoded, err := mh.Decode(id.Hash())
+	if err != nil {
+		return nil, err
+	}
+	if decoded.Code != Sha256Namespace8Flagged {
+		return nil, fmt.Errorf("unexpected multihash code, got: %x, want: %x", decoded.Code, Sha256Namespace8Flagged)
+	}
+	if got, want := len(decoded.Digest), nmtHashSize; got != want {
+		return nil, fmt.Errorf("invalid namespaced hash length, got: %v, want: %v", got, want)
+	}
+	return decoded.Digest, nil
+}
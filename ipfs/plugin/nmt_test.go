@@ -247,3 +247,23 @@ func generateRandNamespacedRawData(total int, nidSize int, leafSize int) [][]byt
 func sortByteArrays(src [][]byte) {
 	sort.Slice(src, func(i, j int) bool { return bytes.Compare(src[i], src[j]) < 0 })
 }
+
+func TestNamespacedSha256FromCID(t *testing.T) {
+	data := generateRandNamespacedRawData(4, namespaceSize, shareSize)
+	n := nmt.New(sha256.New)
+	for _, share := range data {
+		if err := n.Push(share); err != nil {
+			t.Fatalf("nmt.Push() unexpected error = %v", err)
+		}
+	}
+
+	root := n.Root().Bytes()
+	id := MustCidFromNamespacedSha256(root)
+	got, err := NamespacedSha256FromCID(id)
+	if err != nil {
+		t.Fatalf("NamespacedSha256FromCID() unexpected error = %v", err)
+	}
+	if !bytes.Equal(got, root) {
+		t.Errorf("NamespacedSha256FromCID() got: %x, want: %x", got, root)
+	}
+}
@@ -1,8 +1,42 @@
 package ipfs
 
 import (
+	"io"
+
+	blockstore "github.com/ipfs/go-ipfs-blockstore"
 	"github.com/ipfs/go-ipfs/core"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/libp2p/go-libp2p-core/routing"
 )
 
+// Node is the IPFS functionality used by a LazyLedger node. It is either
+// backed by an IPFS node embedded within the same process or by an external
+// IPFS daemon.
+type Node struct {
+	// Blockstore only accesses the blocks stored by the IPFS node.
+	Blockstore blockstore.Blockstore
+	// DAG retrieves the nodes which are not stored locally from the network.
+	DAG ipld.DAGService
+	// Routing announces the stored blocks to the network.
+	Routing routing.ContentRouting
+
+	closer io.Closer
+}
+
+// Close shuts the IPFS node down or releases the connection to it.
+func (n *Node) Close() error {
+	return n.closer.Close()
+}
+
 // NodeProvider initializes and returns an IPFS node
-type NodeProvider func() (*core.IpfsNode, error)
+type NodeProvider func() (*Node, error)
+
+// newNode returns the Node backed by the given IPFS node.
+func newNode(nd *core.IpfsNode) *Node {
+	return &Node{
+		Blockstore: nd.Blockstore,
+		DAG:        nd.DAG,
+		Routing:    nd.Routing,
+		closer:     nd,
+	}
+}
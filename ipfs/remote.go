@@ -0,0 +1,330 @@
+package ipfs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-blockservice"
+	"github.com/ipfs/go-cid"
+	shell "github.com/ipfs/go-ipfs-api"
+	blockstore "github.com/ipfs/go-ipfs-blockstore"
+	exchange "github.com/ipfs/go-ipfs-exchange-interface"
+	"github.com/ipfs/go-merkledag"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/routing"
+	mh "github.com/multiformats/go-multihash"
+
+	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
+	"github.com/lazyledger/lazyledger-core/libs/log"
+)
+
+// Remote is the provider of an IPFS node backed by the HTTP API of an
+// external IPFS daemon at cfg.RemoteAPI. This allows sharing a single daemon
+// between multiple services and restarting them independently.
+//
+// Blocks missing in the daemon's repo are retrieved from the network by the
+// daemon, which also stores them. The daemon must have the NMT plugin loaded.
+func Remote(cfg *Config, logger log.Logger) NodeProvider {
+	return func() (*Node, error) {
+		plugin.EnableNMT()
+
+		sh := shell.NewShell(cfg.RemoteAPI)
+		// fail early if the daemon is not reachable
+		version, _, err := sh.Version()
+		if err != nil {
+			return nil, fmt.Errorf("could not reach IPFS daemon at %s: %w", cfg.RemoteAPI, err)
+		}
+
+		bs := &remoteBlockstore{sh: sh}
+		bserv := blockservice.New(bs, &remoteExchange{bs: bs})
+
+		logger.Info("Successfully connected to remote IPFS node", "api", cfg.RemoteAPI, "version", version)
+		return &Node{
+			Blockstore: bs,
+			DAG:        merkledag.NewDAGService(bserv),
+			Routing:    &remoteRouting{sh: sh},
+			closer:     bserv,
+		}, nil
+	}
+}
+
+// remoteBlockstore is a blockstore.Blockstore accessing the repo of an IPFS
+// daemon via its HTTP API. Other than the exchange, it never retrieves blocks
+// from the network.
+type remoteBlockstore struct {
+	sh *shell.Shell
+}
+
+var _ blockstore.Blockstore = (*remoteBlockstore)(nil)
+
+func (bs *remoteBlockstore) DeleteBlock(id cid.Cid) error {
+	var out struct {
+		Hash  string
+		Error string
+	}
+	err := bs.sh.Request("block/rm", id.String()).Exec(context.Background(), &out)
+	if err != nil {
+		return err
+	}
+	if out.Error != "" {
+		if isNotFound(out.Error) {
+			return blockstore.ErrNotFound
+		}
+		return errors.New(out.Error)
+	}
+	return nil
+}
+
+func (bs *remoteBlockstore) Has(id cid.Cid) (bool, error) {
+	_, err := bs.GetSize(id)
+	if errors.Is(err, blockstore.ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (bs *remoteBlockstore) Get(id cid.Cid) (blocks.Block, error) {
+	return bs.get(context.Background(), id, true)
+}
+
+func (bs *remoteBlockstore) GetSize(id cid.Cid) (int, error) {
+	var out struct {
+		Key  string
+		Size int
+	}
+	err := bs.sh.Request("block/stat", id.String()).
+		Option("offline", true).
+		Exec(context.Background(), &out)
+	if err != nil {
+		return -1, asNotFound(err)
+	}
+	return out.Size, nil
+}
+
+func (bs *remoteBlockstore) Put(block blocks.Block) error {
+	id := block.Cid()
+	prefix := id.Prefix()
+	format, ok := cid.CodecToStr[prefix.Codec]
+	if !ok {
+		return fmt.Errorf("unknown codec of block %s: %x", id, prefix.Codec)
+	}
+	if prefix.Version == 0 {
+		format = "v0"
+	}
+
+	key, err := bs.sh.BlockPut(block.RawData(), format, mh.Codes[prefix.MhType], prefix.MhLength)
+	if err != nil {
+		return err
+	}
+	if key != id.String() {
+		return fmt.Errorf("IPFS daemon stored block %s as %s", id, key)
+	}
+	return nil
+}
+
+func (bs *remoteBlockstore) PutMany(blks []blocks.Block) error {
+	for _, block := range blks {
+		if err := bs.Put(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bs *remoteBlockstore) AllKeysChan(ctx context.Context) (<-chan cid.Cid, error) {
+	resp, err := bs.sh.Request("refs/local").Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		resp.Close()
+		return nil, resp.Error
+	}
+
+	out := make(chan cid.Cid)
+	go func() {
+		defer close(out)
+		defer resp.Close()
+
+		dec := json.NewDecoder(resp.Output)
+		for {
+			var ref struct {
+				Ref string
+				Err string
+			}
+			if err := dec.Decode(&ref); err != nil {
+				return
+			}
+			id, err := cid.Decode(ref.Ref)
+			if ref.Err != "" || err != nil {
+				continue
+			}
+			select {
+			case out <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// HashOnRead is a no-op as the data of all the retrieved blocks is checked
+// against their CIDs.
+func (bs *remoteBlockstore) HashOnRead(enabled bool) {}
+
+// get retrieves a block from the daemon. If offline is set, only the
+// daemon's repo is accessed.
+func (bs *remoteBlockstore) get(ctx context.Context, id cid.Cid, offline bool) (blocks.Block, error) {
+	req := bs.sh.Request("block/get", id.String())
+	if offline {
+		req.Option("offline", true)
+	}
+	resp, err := req.Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Close()
+	if resp.Error != nil {
+		return nil, asNotFound(resp.Error)
+	}
+
+	data, err := ioutil.ReadAll(resp.Output)
+	if err != nil {
+		return nil, err
+	}
+	sum, err := id.Prefix().Sum(data)
+	if err != nil {
+		return nil, err
+	}
+	if !sum.Equals(id) {
+		return nil, fmt.Errorf("data of block %s received from IPFS daemon does not match its CID", id)
+	}
+	return blocks.NewBlockWithCid(data, id)
+}
+
+// remoteExchange is an exchange.Interface retrieving blocks from the network
+// via an IPFS daemon.
+type remoteExchange struct {
+	bs *remoteBlockstore
+}
+
+var _ exchange.Interface = (*remoteExchange)(nil)
+
+func (e *remoteExchange) GetBlock(ctx context.Context, id cid.Cid) (blocks.Block, error) {
+	return e.bs.get(ctx, id, false)
+}
+
+func (e *remoteExchange) GetBlocks(ctx context.Context, ids []cid.Cid) (<-chan blocks.Block, error) {
+	out := make(chan blocks.Block)
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id cid.Cid) {
+			defer wg.Done()
+			block, err := e.GetBlock(ctx, id)
+			if err != nil {
+				return
+			}
+			select {
+			case out <- block:
+			case <-ctx.Done():
+			}
+		}(id)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out, nil
+}
+
+// HasBlock is a no-op as the daemon serves the blocks of its repo itself.
+func (e *remoteExchange) HasBlock(blocks.Block) error {
+	return nil
+}
+
+func (e *remoteExchange) IsOnline() bool {
+	return true
+}
+
+func (e *remoteExchange) Close() error {
+	return nil
+}
+
+// remoteRouting is a routing.ContentRouting using the DHT of an IPFS daemon.
+type remoteRouting struct {
+	sh *shell.Shell
+}
+
+var _ routing.ContentRouting = (*remoteRouting)(nil)
+
+func (r *remoteRouting) Provide(ctx context.Context, id cid.Cid, announce bool) error {
+	if !announce {
+		return nil
+	}
+	return r.sh.Request("dht/provide", id.String()).Exec(ctx, nil)
+}
+
+func (r *remoteRouting) FindProvidersAsync(ctx context.Context, id cid.Cid, count int) <-chan peer.AddrInfo {
+	out := make(chan peer.AddrInfo)
+	go func() {
+		defer close(out)
+
+		resp, err := r.sh.Request("dht/findprovs", id.String()).
+			Option("num-providers", count).
+			Send(ctx)
+		if err != nil {
+			return
+		}
+		defer resp.Close()
+		if resp.Error != nil {
+			return
+		}
+
+		dec := json.NewDecoder(resp.Output)
+		for {
+			var event struct {
+				Type      routing.QueryEventType
+				Responses []*peer.AddrInfo
+			}
+			if err := dec.Decode(&event); err != nil {
+				return
+			}
+			if event.Type != routing.Provider {
+				continue
+			}
+			for _, info := range event.Responses {
+				if info == nil {
+					continue
+				}
+				select {
+				case out <- *info:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// asNotFound translates the errors of the daemon about missing blocks into
+// blockstore.ErrNotFound.
+func asNotFound(err error) error {
+	var shErr *shell.Error
+	if errors.As(err, &shErr) && isNotFound(shErr.Message) {
+		return blockstore.ErrNotFound
+	}
+	return err
+}
+
+func isNotFound(msg string) bool {
+	return strings.Contains(msg, "not found")
+}
@@ -0,0 +1,199 @@
+package ipfs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	blockstore "github.com/ipfs/go-ipfs-blockstore"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/lazyledger/nmt"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
+	"github.com/lazyledger/lazyledger-core/libs/log"
+)
+
+func TestRemote(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	daemon := newFakeDaemon()
+	srv := httptest.NewServer(daemon)
+	defer srv.Close()
+
+	cfg := DefaultConfig()
+	cfg.RemoteAPI = srv.Listener.Addr().String()
+	node, err := Remote(cfg, log.TestingLogger())()
+	require.NoError(t, err)
+	defer node.Close()
+
+	share := append(bytes.Repeat([]byte{1}, 8), []byte("share")...)
+	id := plugin.MustCidFromNamespacedSha256(nmt.Sha256Namespace8FlaggedLeaf(share))
+	leaf := plugin.NewNMTLeafNode(id, share)
+
+	t.Run("missing node", func(t *testing.T) {
+		has, err := node.Blockstore.Has(id)
+		require.NoError(t, err)
+		assert.False(t, has)
+
+		_, err = node.Blockstore.Get(id)
+		assert.ErrorIs(t, err, blockstore.ErrNotFound)
+		_, err = node.DAG.Get(ctx, id)
+		assert.ErrorIs(t, err, ipld.ErrNotFound)
+	})
+
+	t.Run("add and get node", func(t *testing.T) {
+		require.NoError(t, node.DAG.Add(ctx, leaf))
+
+		has, err := node.Blockstore.Has(id)
+		require.NoError(t, err)
+		assert.True(t, has)
+
+		nd, err := node.DAG.Get(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, leaf.RawData(), nd.RawData())
+
+		size, err := node.Blockstore.GetSize(id)
+		require.NoError(t, err)
+		assert.Equal(t, len(leaf.RawData()), size)
+	})
+
+	t.Run("provide", func(t *testing.T) {
+		require.NoError(t, node.Routing.Provide(ctx, id, true))
+		assert.Equal(t, []string{id.String()}, daemon.provided)
+	})
+
+	t.Run("remove node", func(t *testing.T) {
+		require.NoError(t, node.DAG.Remove(ctx, id))
+		_, err := node.Blockstore.Get(id)
+		assert.ErrorIs(t, err, blockstore.ErrNotFound)
+		assert.ErrorIs(t, node.Blockstore.DeleteBlock(id), blockstore.ErrNotFound)
+	})
+
+	t.Run("corrupted data", func(t *testing.T) {
+		daemon.put(id, []byte("corrupted"))
+		_, err := node.Blockstore.Get(id)
+		assert.Error(t, err)
+	})
+}
+
+func TestRemoteUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	cfg := DefaultConfig()
+	cfg.RemoteAPI = srv.Listener.Addr().String()
+	_, err := Remote(cfg, log.TestingLogger())()
+	assert.Error(t, err)
+}
+
+// fakeDaemon is a stand-in for the HTTP API of an IPFS daemon serving the
+// commands used by Remote from memory.
+type fakeDaemon struct {
+	mtx      sync.Mutex
+	blocks   map[string][]byte
+	provided []string
+}
+
+func newFakeDaemon() *fakeDaemon {
+	return &fakeDaemon{blocks: make(map[string][]byte)}
+}
+
+func (d *fakeDaemon) put(id cid.Cid, data []byte) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	d.blocks[id.String()] = data
+}
+
+func (d *fakeDaemon) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	arg := r.URL.Query().Get("arg")
+	switch strings.TrimPrefix(r.URL.Path, "/api/v0/") {
+	case "version":
+		writeJSON(w, map[string]string{"Version": "0.8.0"})
+	case "block/put":
+		d.blockPut(w, r)
+	case "block/get":
+		data, ok := d.blocks[arg]
+		if !ok {
+			writeError(w, "block was not found locally (offline): ipld: could not find "+arg)
+			return
+		}
+		_, _ = w.Write(data)
+	case "block/stat":
+		data, ok := d.blocks[arg]
+		if !ok {
+			writeError(w, "block was not found locally (offline): ipld: could not find "+arg)
+			return
+		}
+		writeJSON(w, map[string]interface{}{"Key": arg, "Size": len(data)})
+	case "block/rm":
+		if _, ok := d.blocks[arg]; !ok {
+			writeJSON(w, map[string]string{"Hash": arg, "Error": "blockstore: block not found"})
+			return
+		}
+		delete(d.blocks, arg)
+		writeJSON(w, map[string]string{"Hash": arg})
+	case "dht/provide":
+		d.provided = append(d.provided, arg)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (d *fakeDaemon) blockPut(w http.ResponseWriter, r *http.Request) {
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, err.Error())
+		return
+	}
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		writeError(w, err.Error())
+		return
+	}
+
+	q := r.URL.Query()
+	mhLen, _ := strconv.Atoi(q.Get("mhlen"))
+	prefix := cid.Prefix{
+		Version:  1,
+		Codec:    cid.Codecs[q.Get("format")],
+		MhType:   mh.Names[q.Get("mhtype")],
+		MhLength: mhLen,
+	}
+	if q.Get("format") == "v0" {
+		prefix.Version, prefix.Codec = 0, cid.DagProtobuf
+	}
+	id, err := prefix.Sum(data)
+	if err != nil {
+		writeError(w, err.Error())
+		return
+	}
+	d.blocks[id.String()] = data
+	writeJSON(w, map[string]interface{}{"Key": id.String(), "Size": len(data)})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusInternalServerError)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"Message": msg, "Code": 0, "Type": "error"})
+}
@@ -289,3 +289,21 @@ func TestMaxIndex(t *testing.T) {
 	// Cleanup
 	destroyTestGroup(t, g)
 }
+
+func TestFilePath(t *testing.T) {
+	g := createTestGroupWithHeadSizeLimit(t, 0)
+
+	assert.Equal(t, g.Head.Path, g.FilePath(0), "FilePath should point to the head at the beginning")
+
+	err := g.WriteLine("Line 1")
+	require.NoError(t, err)
+	err = g.FlushAndSync()
+	require.NoError(t, err)
+	g.RotateFile()
+
+	assert.Equal(t, g.Head.Path+".000", g.FilePath(0), "FilePath should point to the rotated file")
+	assert.Equal(t, g.Head.Path, g.FilePath(1), "FilePath should point to the head")
+
+	// Cleanup
+	destroyTestGroup(t, g)
+}
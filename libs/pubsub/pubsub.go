@@ -97,7 +97,7 @@ type Server struct {
 	// check if we have subscription before
 	// subscribing or unsubscribing
 	mtx           tmsync.RWMutex
-	subscriptions map[string]map[string]struct{} // subscriber -> query (string) -> empty struct
+	subscriptions map[string]map[string]Query // subscriber -> query (string) -> query
 }
 
 // Option sets a parameter for the server.
@@ -108,7 +108,7 @@ type Option func(*Server)
 // provided, the resulting server's queue is unbuffered.
 func NewServer(options ...Option) *Server {
 	s := &Server{
-		subscriptions: make(map[string]map[string]struct{}),
+		subscriptions: make(map[string]map[string]Query),
 	}
 	s.BaseService = *service.NewBaseService(nil, "PubSub", s)
 
@@ -186,9 +186,9 @@ func (s *Server) subscribe(ctx context.Context, clientID string, query Query, ou
 	case s.cmds <- cmd{op: sub, clientID: clientID, query: query, subscription: subscription}:
 		s.mtx.Lock()
 		if _, ok = s.subscriptions[clientID]; !ok {
-			s.subscriptions[clientID] = make(map[string]struct{})
+			s.subscriptions[clientID] = make(map[string]Query)
 		}
-		s.subscriptions[clientID][query.String()] = struct{}{}
+		s.subscriptions[clientID][query.String()] = query
 		s.mtx.Unlock()
 		return subscription, nil
 	case <-ctx.Done():
@@ -265,6 +265,22 @@ func (s *Server) NumClientSubscriptions(clientID string) int {
 	return len(s.subscriptions[clientID])
 }
 
+// HasSubscribers returns whether a message published with the given events
+// would be sent to any client, e.g. to avoid building messages nobody
+// subscribed to. Queries which fail to match the events are assumed to match.
+func (s *Server) HasSubscribers(events map[string][]string) bool {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	for _, clientSubscriptions := range s.subscriptions {
+		for _, q := range clientSubscriptions {
+			if match, err := q.Matches(events); err != nil || match {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Publish publishes the given message. An error will be returned to the caller
 // if the context is canceled.
 func (s *Server) Publish(ctx context.Context, msg interface{}) error {
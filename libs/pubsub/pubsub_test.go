@@ -301,6 +301,33 @@ func TestUnsubscribe(t *testing.T) {
 	assertCancelled(t, subscription, pubsub.ErrUnsubscribed)
 }
 
+func TestHasSubscribers(t *testing.T) {
+	s := pubsub.NewServer()
+	s.SetLogger(log.TestingLogger())
+	err := s.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := s.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	newBlock := map[string][]string{"tm.events.type": {"NewBlock"}}
+	newTx := map[string][]string{"tm.events.type": {"NewTx"}}
+	assert.False(t, s.HasSubscribers(newBlock))
+
+	ctx := context.Background()
+	q := query.MustParse("tm.events.type='NewBlock'")
+	_, err = s.Subscribe(ctx, clientID, q)
+	require.NoError(t, err)
+	assert.True(t, s.HasSubscribers(newBlock))
+	assert.False(t, s.HasSubscribers(newTx))
+
+	err = s.Unsubscribe(ctx, clientID, q)
+	require.NoError(t, err)
+	assert.False(t, s.HasSubscribers(newBlock))
+}
+
 func TestClientUnsubscribesTwice(t *testing.T) {
 	s := pubsub.NewServer()
 	s.SetLogger(log.TestingLogger())
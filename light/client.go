@@ -70,15 +70,31 @@ func SkippingVerification(trustLevel tmmath.Fraction) Option {
 	}
 }
 
-func DataAvailabilitySampling(numSamples uint32, dag format.DAGService) Option {
+// DataAvailabilitySampling option configures the client to verify that the
+// block data of each header is available via data availability sampling.
+//
+// confidence - the confidence with which sampling detects unavailable block
+// data, which determines the number of samples, see ipld.NumSamples.
+//
+// timeout - the time within which all samples have to be retrieved.
+func DataAvailabilitySampling(confidence float64, timeout time.Duration, dag format.DAGService) Option {
 	return func(c *Client) {
 		c.verificationMode = dataAvailabilitySampling
-		c.numSamples = numSamples
+		c.samplingConfidence = confidence
+		c.samplingTimeout = timeout
 		c.dag = dag
 		c.sessionDAG = merkledag.NewSession(context.TODO(), dag)
 	}
 }
 
+// IPLDMetrics option sets the metrics reported during data availability
+// sampling.
+func IPLDMetrics(metrics *ipld.Metrics) Option {
+	return func(c *Client) {
+		c.ipldMetrics = metrics
+	}
+}
+
 // PruningSize option sets the maximum amount of light blocks that the light
 // client stores. When Prune() is run, all light blocks that are earlier than
 // the h amount of light blocks will be removed from the store.
@@ -131,10 +147,13 @@ type Client struct {
 	trustingPeriod   time.Duration // see TrustOptions.Period
 	verificationMode mode
 	trustLevel       tmmath.Fraction
-	numSamples       uint32
 	maxRetryAttempts uint16 // see MaxRetryAttempts option
 	maxClockDrift    time.Duration
 
+	// See DataAvailabilitySampling option
+	samplingConfidence float64
+	samplingTimeout    time.Duration
+
 	// Mutex for locking during changes of the light clients providers
 	providerMutex tmsync.Mutex
 	// Primary provider of new headers.
@@ -156,8 +175,9 @@ type Client struct {
 
 	logger log.Logger
 
-	dag        format.DAGService
-	sessionDAG format.NodeGetter
+	dag         format.DAGService
+	sessionDAG  format.NodeGetter
+	ipldMetrics *ipld.Metrics
 }
 
 // NewClient returns a new light client. It returns an error if it fails to
@@ -230,6 +250,7 @@ func NewClientFromTrustedStore(
 		confirmationFn:   func(action string) bool { return true },
 		quit:             make(chan struct{}),
 		logger:           log.NewNopLogger(),
+		ipldMetrics:      ipld.NopMetrics(),
 	}
 
 	for _, o := range options {
@@ -247,9 +268,12 @@ func NewClientFromTrustedStore(
 	}
 
 	if c.verificationMode == dataAvailabilitySampling {
-		if err := ValidateNumSamples(c.numSamples); err != nil {
+		if err := ValidateSamplingConfidence(c.samplingConfidence); err != nil {
 			return nil, err
 		}
+		if c.samplingTimeout <= 0 {
+			return nil, errors.New("sampling timeout must be positive")
+		}
 	}
 
 	if err := c.restoreTrustedLightBlock(); err != nil {
@@ -689,26 +713,27 @@ func (c *Client) verifySequential(
 			// TODO: decide how to handle this case:
 			// https://github.com/lazyledger/lazyledger-core/issues/319
 			numRows := len(interimBlock.DataAvailabilityHeader.RowsRoots)
-			numSamples := min(c.numSamples, uint32(numRows*numRows))
 			c.logger.Info("Starting Data Availability sampling",
 				"height", height,
-				"numSamples", numSamples,
+				"numSamples", ipld.NumSamples(uint32(numRows), c.samplingConfidence),
 				"squareWidth", numRows)
 
-			err = ipld.ValidateAvailability(
+			confidence, err := ipld.ValidateAvailability(
 				ctx,
 				c.dag,
 				interimBlock.DataAvailabilityHeader,
-				numSamples,
+				c.samplingConfidence,
+				c.samplingTimeout,
 				func(data namespace.PrefixedData8) {}, // noop
+				c.ipldMetrics,
 			)
 			if err != nil {
-				return fmt.Errorf("data availability sampling failed; ipld.ValidateAvailability: %w", err)
+				return ErrDataUnavailable{Height: height, Reason: err}
 			}
 			elapsed := time.Since(start)
 			c.logger.Info("Successfully finished DAS sampling",
 				"height", height,
-				"numSamples", numSamples,
+				"confidence", confidence,
 				"elapsed time", elapsed)
 		}
 
@@ -727,13 +752,6 @@ func (c *Client) verifySequential(
 	return c.detectDivergence(ctx, trace, now)
 }
 
-func min(a, b uint32) int {
-	if a < b {
-		return int(a)
-	}
-	return int(b)
-}
-
 // see VerifyHeader
 //
 // verifySkipping finds the middle light block between a trusted and new light block,
@@ -885,6 +903,15 @@ func (c *Client) ChainID() string {
 	return c.chainID
 }
 
+// SamplingConfidence returns the confidence with which data availability
+// sampling detects unavailable block data, see DataAvailabilitySampling. It is
+// zero if the client does not sample.
+//
+// Safe for concurrent use by multiple goroutines.
+func (c *Client) SamplingConfidence() float64 {
+	return c.samplingConfidence
+}
+
 // Primary returns the primary provider.
 //
 // NOTE: provider may be not safe for concurrent access.
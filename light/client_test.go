@@ -2,10 +2,14 @@ package light_test
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/go-kit/kit/metrics/generic"
+	format "github.com/ipfs/go-ipld-format"
+	mdutils "github.com/ipfs/go-merkledag/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -15,6 +19,7 @@ import (
 	"github.com/lazyledger/lazyledger-core/light/provider"
 	mockp "github.com/lazyledger/lazyledger-core/light/provider/mock"
 	dbs "github.com/lazyledger/lazyledger-core/light/store/db"
+	"github.com/lazyledger/lazyledger-core/p2p/ipld"
 	"github.com/lazyledger/lazyledger-core/types"
 )
 
@@ -252,6 +257,72 @@ func TestClient_SequentialVerification(t *testing.T) {
 	}
 }
 
+// dasProvider serves the given DataAvailabilityHeader with every light block.
+type dasProvider struct {
+	*mockp.Mock
+	dah *types.DataAvailabilityHeader
+}
+
+func (p dasProvider) DASLightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	lb, err := p.LightBlock(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	lb.DataAvailabilityHeader = p.dah
+	return lb, nil
+}
+
+func TestClient_DataAvailabilitySamplingMetrics(t *testing.T) {
+	block := types.MakeBlock(1, types.Txs{types.Tx("foo"), types.Tx("bar")}, nil, nil, types.Messages{}, nil)
+	available := mdutils.Mock()
+	err := ipld.PutBlock(ctx, available, block, types.DefaultCodec(), ipld.NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		dag         format.DAGService
+		unavailable bool
+	}{
+		{"available", available, false},
+		{"unavailable", mdutils.Mock(), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			latency := generic.NewHistogram("sample_latency_seconds", 10)
+			failures := generic.NewCounter("sample_failures")
+			metrics := ipld.NopMetrics()
+			metrics.SampleLatencySeconds = latency
+			metrics.SampleFailures = failures
+
+			c, err := light.NewClient(
+				ctx,
+				chainID,
+				trustOptions,
+				dasProvider{Mock: mockp.New(chainID, headerSet, valSet), dah: &block.DataAvailabilityHeader},
+				[]provider.Provider{fullNode},
+				dbs.New(memdb.NewDB(), chainID),
+				light.DataAvailabilitySampling(0.99, 5*time.Second, tc.dag),
+				light.IPLDMetrics(metrics),
+				light.Logger(log.TestingLogger()),
+			)
+			require.NoError(t, err)
+
+			_, err = c.VerifyLightBlockAtHeight(ctx, 2, bTime.Add(3*time.Hour))
+			if tc.unavailable {
+				var errUnavailable light.ErrDataUnavailable
+				assert.True(t, errors.As(err, &errUnavailable), err)
+				assert.NotZero(t, failures.Value())
+			} else {
+				require.NoError(t, err)
+				assert.Zero(t, failures.Value())
+				assert.NotZero(t, latency.Quantile(0.5))
+			}
+		})
+	}
+}
+
 func TestClient_SkippingVerification(t *testing.T) {
 	// required for 2nd test case
 	newKeys := genPrivKeys(4)
@@ -0,0 +1,179 @@
+package das
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lazyledger/lazyledger-core/libs/log"
+	"github.com/lazyledger/lazyledger-core/libs/service"
+	tmsync "github.com/lazyledger/lazyledger-core/libs/sync"
+	"github.com/lazyledger/lazyledger-core/light"
+	"github.com/lazyledger/lazyledger-core/p2p/ipld"
+)
+
+// Status describes the progress of a Daemon.
+type Status struct {
+	ChainID string `json:"chain_id"`
+	// LatestHeight is the latest height known to the primary.
+	LatestHeight int64 `json:"latest_height"`
+	// TrustedHeight is the latest height whose header was verified and whose
+	// data was deemed available.
+	TrustedHeight int64 `json:"trusted_height"`
+	// LatestResult is the result of sampling the latest sampled height,
+	// which is above TrustedHeight if its data was deemed unavailable.
+	LatestResult *Result `json:"latest_result"`
+}
+
+// Daemon continuously verifies the new headers of the primary using a light
+// client in data availability sampling mode and persists the result of
+// sampling each height.
+//
+// As headers are verified sequentially, the daemon does not advance past a
+// height whose data is unavailable. Sampling that height is retried on every
+// tick until it succeeds.
+type Daemon struct {
+	service.BaseService
+
+	client   *light.Client
+	store    *Store
+	interval time.Duration
+
+	mtx          tmsync.RWMutex
+	latestHeight int64
+}
+
+// NewDaemon returns a Daemon sampling new headers every interval. The client
+// must be configured with light.DataAvailabilitySampling and optionally
+// light.IPLDMetrics.
+func NewDaemon(client *light.Client, store *Store, interval time.Duration, logger log.Logger) *Daemon {
+	d := &Daemon{
+		client:   client,
+		store:    store,
+		interval: interval,
+	}
+	d.BaseService = *service.NewBaseService(logger, "DASDaemon", d)
+	return d
+}
+
+// OnStart implements service.Service.
+func (d *Daemon) OnStart() error {
+	go d.sampleRoutine()
+	return nil
+}
+
+// Status returns the current Status of the daemon.
+func (d *Daemon) Status() (*Status, error) {
+	trustedHeight, err := d.client.LastTrustedHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := d.store.LatestResult()
+	if err != nil && !errors.Is(err, ErrResultNotFound) {
+		return nil, err
+	}
+
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+	return &Status{
+		ChainID:       d.client.ChainID(),
+		LatestHeight:  d.latestHeight,
+		TrustedHeight: trustedHeight,
+		LatestResult:  result,
+	}, nil
+}
+
+// Result returns the Result of sampling the given height. It returns
+// ErrResultNotFound if the height was not sampled yet.
+func (d *Daemon) Result(height int64) (*Result, error) {
+	return d.store.Result(height)
+}
+
+func (d *Daemon) sampleRoutine() {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.sampleNewHeights()
+
+		select {
+		case <-ticker.C:
+		case <-d.Quit():
+			return
+		}
+	}
+}
+
+// sampleNewHeights verifies and samples all heights between the latest
+// trusted height and the latest height of the primary one by one, so that
+// every result can be attributed to a single height.
+func (d *Daemon) sampleNewHeights() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-d.Quit():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	latest, err := d.client.Primary().LightBlock(ctx, 0)
+	if err != nil {
+		d.Logger.Error("Failed to fetch the latest light block", "err", err)
+		return
+	}
+	d.mtx.Lock()
+	d.latestHeight = latest.Height
+	d.mtx.Unlock()
+
+	trustedHeight, err := d.client.LastTrustedHeight()
+	if err != nil {
+		d.Logger.Error("Failed to get the latest trusted height", "err", err)
+		return
+	}
+
+	for height := trustedHeight + 1; height <= latest.Height; height++ {
+		if !d.IsRunning() {
+			return
+		}
+
+		start := time.Now()
+		lb, err := d.client.VerifyLightBlockAtHeight(ctx, height, start)
+		result := &Result{
+			Height:    height,
+			Available: err == nil,
+			Time:      start,
+			Duration:  time.Since(start),
+		}
+
+		var errUnavailable light.ErrDataUnavailable
+		switch {
+		case err == nil:
+			// all samples were retrieved, thus the achieved confidence only
+			// depends on the size of the square
+			squareWidth := uint32(len(lb.DataAvailabilityHeader.RowsRoots))
+			result.Confidence = ipld.Confidence(squareWidth,
+				ipld.NumSamples(squareWidth, d.client.SamplingConfidence()))
+		case errors.As(err, &errUnavailable):
+			result.Error = errUnavailable.Reason.Error()
+		default:
+			// the header could not be verified, thus there is nothing to
+			// tell about the availability of its data
+			d.Logger.Error("Failed to verify header", "height", height, "err", err)
+			return
+		}
+
+		if err := d.store.SaveResult(result); err != nil {
+			d.Logger.Error("Failed to save sampling result", "height", height, "err", err)
+			return
+		}
+		if !result.Available {
+			d.Logger.Error("Block data is not available", "height", height, "err", result.Error)
+			return
+		}
+		d.Logger.Info("Block data is available", "height", height, "confidence", result.Confidence,
+			"elapsed", result.Duration)
+	}
+}
@@ -0,0 +1,11 @@
+/*
+Package das implements a long-running data availability sampling light node.
+
+A Daemon drives a light client configured with data availability sampling: it
+verifies every new header of the primary and samples the block data it commits
+to from IPFS. The outcome for each height is persisted in a Store and served
+over RPC by the das_status and das_available endpoints. The latency and
+failures of the individual samples are reported by the light client, see
+light.IPLDMetrics.
+*/
+package das
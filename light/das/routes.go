@@ -0,0 +1,61 @@
+package das
+
+import (
+	"errors"
+	"fmt"
+
+	rpcserver "github.com/lazyledger/lazyledger-core/rpc/jsonrpc/server"
+	rpctypes "github.com/lazyledger/lazyledger-core/rpc/jsonrpc/types"
+)
+
+// ResultAvailable is the response of the das_available endpoint.
+type ResultAvailable struct {
+	Height int64 `json:"height"`
+	// Sampled is false if the height was not sampled yet, in which case
+	// Available is always false.
+	Sampled   bool    `json:"sampled"`
+	Available bool    `json:"available"`
+	Result    *Result `json:"result,omitempty"`
+}
+
+// RPCRoutes returns the RPC routes serving the status and the sampling
+// results of the Daemon.
+func RPCRoutes(d *Daemon) map[string]*rpcserver.RPCFunc {
+	return map[string]*rpcserver.RPCFunc{
+		"das_status":    rpcserver.NewRPCFunc(makeStatusFunc(d), ""),
+		"das_available": rpcserver.NewRPCFunc(makeAvailableFunc(d), "height"),
+	}
+}
+
+type rpcStatusFunc func(ctx *rpctypes.Context) (*Status, error)
+
+func makeStatusFunc(d *Daemon) rpcStatusFunc {
+	return func(ctx *rpctypes.Context) (*Status, error) {
+		return d.Status()
+	}
+}
+
+type rpcAvailableFunc func(ctx *rpctypes.Context, height int64) (*ResultAvailable, error)
+
+func makeAvailableFunc(d *Daemon) rpcAvailableFunc {
+	return func(ctx *rpctypes.Context, height int64) (*ResultAvailable, error) {
+		if height <= 0 {
+			return nil, fmt.Errorf("height must be greater than 0, but got %d", height)
+		}
+
+		result, err := d.Result(height)
+		switch {
+		case errors.Is(err, ErrResultNotFound):
+			return &ResultAvailable{Height: height}, nil
+		case err != nil:
+			return nil, err
+		}
+
+		return &ResultAvailable{
+			Height:    height,
+			Sampled:   true,
+			Available: result.Available,
+			Result:    result,
+		}, nil
+	}
+}
@@ -0,0 +1,143 @@
+package das
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	dbm "github.com/lazyledger/lazyledger-core/libs/db"
+	lightproto "github.com/lazyledger/lazyledger-core/proto/tendermint/light"
+)
+
+// ErrResultNotFound is returned when the store does not have a sampling
+// result for the requested height.
+var ErrResultNotFound = errors.New("sampling result not found")
+
+// Result is the outcome of data availability sampling the block data
+// committed to by the header at Height.
+type Result struct {
+	Height    int64 `json:"height"`
+	Available bool  `json:"available"`
+	// Time the sampling started at.
+	Time     time.Time     `json:"time"`
+	Duration time.Duration `json:"duration"`
+	// Error is set if the data was deemed unavailable.
+	Error string `json:"error,omitempty"`
+	// Confidence achieved by sampling if the data was deemed available, see
+	// ipld.Confidence.
+	Confidence float64 `json:"confidence,omitempty"`
+}
+
+// ToProto converts the Result into its protobuf representation.
+func (r *Result) ToProto() *lightproto.SamplingResult {
+	return &lightproto.SamplingResult{
+		Height:     r.Height,
+		Available:  r.Available,
+		Time:       r.Time,
+		Duration:   r.Duration,
+		Error:      r.Error,
+		Confidence: r.Confidence,
+	}
+}
+
+// ResultFromProto converts a protobuf SamplingResult into a Result.
+func ResultFromProto(pb *lightproto.SamplingResult) (*Result, error) {
+	if pb == nil {
+		return nil, errors.New("nil sampling result")
+	}
+	if pb.Height <= 0 {
+		return nil, errors.New("negative or zero height")
+	}
+
+	return &Result{
+		Height:     pb.Height,
+		Available:  pb.Available,
+		Time:       pb.Time,
+		Duration:   pb.Duration,
+		Error:      pb.Error,
+		Confidence: pb.Confidence,
+	}, nil
+}
+
+// Store persists the sampling results by height. It can share the database
+// of the light client store.
+type Store struct {
+	db     dbm.DB
+	prefix string
+}
+
+// NewStore returns a Store that wraps any DB (with an optional prefix in case
+// you want to use one DB with many light clients).
+func NewStore(db dbm.DB, prefix string) *Store {
+	return &Store{db: db, prefix: prefix}
+}
+
+// SaveResult persists the Result, overwriting any previous result at the same
+// height.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *Store) SaveResult(r *Result) error {
+	if r.Height <= 0 {
+		panic("negative or zero height")
+	}
+
+	bz, err := r.ToProto().Marshal()
+	if err != nil {
+		return fmt.Errorf("marshalling SamplingResult: %w", err)
+	}
+	return s.db.SetSync(s.resultKey(r.Height), bz)
+}
+
+// Result retrieves the Result at the given height. It returns
+// ErrResultNotFound if the height was not sampled yet.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *Store) Result(height int64) (*Result, error) {
+	if height <= 0 {
+		panic("negative or zero height")
+	}
+
+	bz, err := s.db.Get(s.resultKey(height))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 {
+		return nil, ErrResultNotFound
+	}
+	return unmarshalResult(bz)
+}
+
+// LatestResult retrieves the Result with the highest height. It returns
+// ErrResultNotFound if nothing was sampled yet.
+//
+// Safe for concurrent use by multiple goroutines.
+func (s *Store) LatestResult() (*Result, error) {
+	itr, err := s.db.ReverseIterator(
+		s.resultKey(1),
+		append(s.resultKey(1<<63-1), byte(0x00)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	if itr.Valid() {
+		return unmarshalResult(itr.Value())
+	}
+	if err := itr.Error(); err != nil {
+		return nil, err
+	}
+	return nil, ErrResultNotFound
+}
+
+func (s *Store) resultKey(height int64) []byte {
+	return []byte(fmt.Sprintf("das/%s/%020d", s.prefix, height))
+}
+
+func unmarshalResult(bz []byte) (*Result, error) {
+	var pb lightproto.SamplingResult
+	if err := pb.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("unmarshal error: %w", err)
+	}
+	return ResultFromProto(&pb)
+}
@@ -0,0 +1,68 @@
+package das
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lazyledger/lazyledger-core/libs/db/memdb"
+)
+
+func TestStoreSaveResult(t *testing.T) {
+	store := NewStore(memdb.NewDB(), "TestStoreSaveResult")
+
+	_, err := store.Result(1)
+	assert.Equal(t, ErrResultNotFound, err)
+	_, err = store.LatestResult()
+	assert.Equal(t, ErrResultNotFound, err)
+
+	now := time.Now().UTC().Round(0)
+	unavailable := &Result{
+		Height:   2,
+		Time:     now,
+		Duration: time.Second,
+		Error:    "context deadline exceeded",
+	}
+	require.NoError(t, store.SaveResult(&Result{Height: 1, Available: true, Time: now, Duration: time.Second}))
+	require.NoError(t, store.SaveResult(unavailable))
+
+	r, err := store.Result(2)
+	require.NoError(t, err)
+	assert.Equal(t, unavailable, r)
+
+	r, err = store.LatestResult()
+	require.NoError(t, err)
+	assert.Equal(t, unavailable, r)
+
+	// sampling the height again overwrites the result
+	available := &Result{
+		Height:     2,
+		Available:  true,
+		Time:       now.Add(time.Minute),
+		Duration:   time.Second,
+		Confidence: 0.9999,
+	}
+	require.NoError(t, store.SaveResult(available))
+
+	r, err = store.Result(2)
+	require.NoError(t, err)
+	assert.Equal(t, available, r)
+
+	r, err = store.Result(1)
+	require.NoError(t, err)
+	assert.True(t, r.Available)
+}
+
+func TestStorePrefix(t *testing.T) {
+	db := memdb.NewDB()
+	store1, store2 := NewStore(db, "chain-1"), NewStore(db, "chain-2")
+
+	require.NoError(t, store1.SaveResult(&Result{Height: 10, Available: true}))
+
+	_, err := store2.Result(10)
+	assert.Equal(t, ErrResultNotFound, err)
+	_, err = store2.LatestResult()
+	assert.Equal(t, ErrResultNotFound, err)
+}
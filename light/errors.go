@@ -64,6 +64,22 @@ func (e ErrVerificationFailed) Error() string {
 	return fmt.Sprintf("verify from #%d to #%d failed: %v", e.From, e.To, e.Reason)
 }
 
+// ErrDataUnavailable means data availability sampling of the block data
+// committed to by the header at the given height has failed.
+type ErrDataUnavailable struct {
+	Height int64
+	Reason error
+}
+
+// Unwrap returns underlying reason.
+func (e ErrDataUnavailable) Unwrap() error {
+	return e.Reason
+}
+
+func (e ErrDataUnavailable) Error() string {
+	return fmt.Sprintf("data availability sampling of #%d failed: %v", e.Height, e.Reason)
+}
+
 // ErrLightClientAttack is returned when the light client has detected an attempt
 // to verify a false header and has sent the evidence to either a witness or primary.
 var ErrLightClientAttack = errors.New(`attempted attack detected.
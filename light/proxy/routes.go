@@ -27,6 +27,7 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"block_by_hash":        rpcserver.NewRPCFunc(makeBlockByHashFunc(c), "hash"),
 		"block_results":        rpcserver.NewRPCFunc(makeBlockResultsFunc(c), "height"),
 		"commit":               rpcserver.NewRPCFunc(makeCommitFunc(c), "height"),
+		"namespaced_shares":    rpcserver.NewRPCFunc(makeNamespacedSharesFunc(c), "height,namespace_id"),
 		"tx":                   rpcserver.NewRPCFunc(makeTxFunc(c), "hash,prove"),
 		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by"),
 		"validators":           rpcserver.NewRPCFunc(makeValidatorsFunc(c), "height,page,per_page"),
@@ -123,6 +124,15 @@ func makeCommitFunc(c *lrpc.Client) rpcCommitFunc {
 	}
 }
 
+type rpcNamespacedSharesFunc func(ctx *rpctypes.Context, height *int64,
+	namespaceID []byte) (*ctypes.ResultNamespacedShares, error)
+
+func makeNamespacedSharesFunc(c *lrpc.Client) rpcNamespacedSharesFunc {
+	return func(ctx *rpctypes.Context, height *int64, namespaceID []byte) (*ctypes.ResultNamespacedShares, error) {
+		return c.NamespacedShares(ctx.Context(), height, namespaceID)
+	}
+}
+
 type rpcTxFunc func(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
 
 func makeTxFunc(c *lrpc.Client) rpcTxFunc {
@@ -18,6 +18,7 @@ import (
 	ctypes "github.com/lazyledger/lazyledger-core/rpc/core/types"
 	rpctypes "github.com/lazyledger/lazyledger-core/rpc/jsonrpc/types"
 	"github.com/lazyledger/lazyledger-core/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
 )
 
 var errNegOrZeroHeight = errors.New("negative or zero height")
@@ -435,6 +436,49 @@ func (c *Client) DataAvailabilityHeader(
 	}, nil
 }
 
+// NamespacedShares calls rpcclient#NamespacedShares and then verifies the
+// returned shares against the DataAvailabilityHeader of the trusted light
+// block. Messages are re-parsed from the verified shares.
+func (c *Client) NamespacedShares(
+	ctx context.Context,
+	height *int64,
+	namespaceID []byte,
+) (*ctypes.ResultNamespacedShares, error) {
+	res, err := c.next.NamespacedShares(ctx, height, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := c.updateLightClientIfNeededTo(ctx, res.Height)
+	if err != nil {
+		return nil, err
+	}
+	if l.DataAvailabilityHeader == nil {
+		return nil, fmt.Errorf("light block at height %d has no DataAvailabilityHeader", res.Height)
+	}
+
+	if err := l.DataAvailabilityHeader.VerifyNamespace(namespaceID, res.Rows); err != nil {
+		return nil, fmt.Errorf("failed to verify shares of namespace %X: %w", namespaceID, err)
+	}
+
+	var shares [][]byte
+	for _, row := range res.Rows {
+		for _, share := range row.Shares {
+			shares = append(shares, share)
+		}
+	}
+	msgs := types.MessagesEmpty
+	if bytes.Compare(namespaceID, consts.MaxReservedNamespace) > 0 {
+		msgs, err = types.ParseMessages(shares)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res.Messages = msgs.MessagesList
+
+	return res, nil
+}
+
 // Tx calls rpcclient#Tx method and then verifies the proof if such was
 // requested.
 func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
@@ -458,9 +502,34 @@ func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.Resul
 	return res, res.Proof.Validate(l.DataHash)
 }
 
+// TxSearch calls rpcclient#TxSearch method and then verifies the proofs if
+// such were requested.
 func (c *Client) TxSearch(ctx context.Context, query string, prove bool, page, perPage *int, orderBy string) (
 	*ctypes.ResultTxSearch, error) {
-	return c.next.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	res, err := c.next.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	if err != nil || !prove {
+		return res, err
+	}
+
+	for _, tx := range res.Txs {
+		// Validate tx.
+		if tx.Height <= 0 {
+			return nil, errNegOrZeroHeight
+		}
+
+		// Update the light client if we're behind.
+		l, err := c.updateLightClientIfNeededTo(ctx, tx.Height)
+		if err != nil {
+			return nil, err
+		}
+
+		// Validate the proof.
+		if err := tx.Proof.Validate(l.DataHash); err != nil {
+			return nil, fmt.Errorf("invalid proof of tx %X: %w", tx.Hash, err)
+		}
+	}
+
+	return res, nil
 }
 
 // Validators fetches and verifies validators.
@@ -177,9 +177,11 @@ func ValidateTrustLevel(lvl tmmath.Fraction) error {
 	return nil
 }
 
-func ValidateNumSamples(numSamples uint32) error {
-	if numSamples == 0 {
-		return fmt.Errorf("number of samples must be > 0")
+// ValidateSamplingConfidence checks that the confidence of data availability
+// sampling is within (0, 1].
+func ValidateSamplingConfidence(confidence float64) error {
+	if confidence <= 0 || confidence > 1 {
+		return fmt.Errorf("sampling confidence must be within (0, 1], given %v", confidence)
 	}
 	return nil
 }
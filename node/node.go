@@ -12,8 +12,7 @@ import (
 	"strings"
 	"time"
 
-	ipld "github.com/ipfs/go-ipld-format"
-	"github.com/libp2p/go-libp2p-core/routing"
+	format "github.com/ipfs/go-ipld-format"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
@@ -34,6 +33,7 @@ import (
 	"github.com/lazyledger/lazyledger-core/light"
 	mempl "github.com/lazyledger/lazyledger-core/mempool"
 	"github.com/lazyledger/lazyledger-core/p2p"
+	"github.com/lazyledger/lazyledger-core/p2p/ipld"
 	"github.com/lazyledger/lazyledger-core/p2p/pex"
 	"github.com/lazyledger/lazyledger-core/privval"
 	"github.com/lazyledger/lazyledger-core/proxy"
@@ -115,20 +115,21 @@ func DefaultNewNode(config *cfg.Config, ipfs ipfs.NodeProvider, logger log.Logge
 	)
 }
 
-// MetricsProvider returns a consensus, p2p and mempool Metrics.
-type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics)
+// MetricsProvider returns a consensus, p2p, mempool, state and ipld Metrics.
+type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *ipld.Metrics)
 
 // DefaultMetricsProvider returns Metrics build using Prometheus client library
 // if Prometheus is enabled. Otherwise, it returns no-op Metrics.
 func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
-	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics) {
+	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *ipld.Metrics) {
 		if config.Prometheus {
 			return cs.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				p2p.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				mempl.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID)
+				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				ipld.PrometheusMetrics(config.Namespace, "chain_id", chainID)
 		}
-		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics()
+		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics(), ipld.NopMetrics()
 	}
 }
 
@@ -216,7 +217,10 @@ type Node struct {
 	indexerService    *txindex.IndexerService
 	prometheusSrv     *http.Server
 
+	ipfsDAG   format.DAGService
 	ipfsClose io.Closer
+	provider  *ipld.Provider  // for announcing block data to the DHT
+	publisher *ipld.Publisher // for putting the block data of own proposals
 }
 
 func createAndStartProxyAppConns(clientCreator proxy.ClientCreator, logger log.Logger) (proxy.AppConns, error) {
@@ -378,10 +382,11 @@ func createConsensusReactor(
 	evidencePool *evidence.Pool,
 	privValidator types.PrivValidator,
 	csMetrics *cs.Metrics,
+	ipldMetrics *ipld.Metrics,
 	waitSync bool,
 	eventBus *types.EventBus,
-	dag ipld.DAGService,
-	croute routing.ContentRouting,
+	dag format.DAGService,
+	publisher *ipld.Publisher,
 	consensusLogger log.Logger) (*cs.Reactor, *cs.State) {
 
 	consensusState := cs.NewState(
@@ -391,9 +396,11 @@ func createConsensusReactor(
 		blockStore,
 		mempool,
 		dag,
-		croute,
+		publisher,
 		evidencePool,
 		cs.StateMetrics(csMetrics),
+		cs.StateIPLDMetrics(ipldMetrics),
+		cs.StateSamplingConfidence(config.IPFS.SamplingConfidence),
 	)
 	consensusState.SetLogger(consensusLogger)
 	if privValidator != nil {
@@ -691,6 +698,39 @@ func NewNode(config *cfg.Config,
 	}
 
 	blockStore := store.NewBlockStore(blockStoreDB, ipfsNode.Blockstore, logger)
+	// the erasure codec is fixed at genesis, the square sizes must not exceed
+	// what it can extend
+	if err := types.ValidateDataAvailabilityParams(state.ConsensusParams.DataAvailability); err != nil {
+		return nil, fmt.Errorf("invalid data availability params: %w", err)
+	}
+	codec, err := types.Codec(state.ConsensusParams.DataAvailability.Codec)
+	if err != nil {
+		return nil, err
+	}
+	blockStore.SetCodec(codec)
+	blockStore.SetDARetainBlocks(config.IPFS.RetainBlocks)
+	// the references to the block data are counted apart from the blocks
+	prunerDB, err := dbProvider(&DBContext{"pruner", config})
+	if err != nil {
+		return nil, err
+	}
+	blockStore.SetPrunerDB(prunerDB)
+	// block stores created before the DataAvailabilityHeader was persisted
+	// along with the block metas need to be migrated
+	migrated, err := blockStore.MigrateDAHeaders(func(height int64) (uint32, error) {
+		// params saved before the square sizes were added get the default ones
+		params, err := stateStore.LoadConsensusParams(height)
+		if err != nil {
+			return 0, err
+		}
+		return params.DataAvailability.MinSquareSize, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate block store: %w", err)
+	}
+	if migrated > 0 {
+		logger.Info("Migrated block metas to include the DataAvailabilityHeader", "blocks", migrated)
+	}
 
 	// Create the handshaker, which calls RequestInfo, sets the AppVersion on the state,
 	// and replays any blocks as necessary to sync tendermint with the app.
@@ -715,7 +755,31 @@ func NewNode(config *cfg.Config,
 
 	logNodeStartupInfo(state, pubKey, logger, consensusLogger)
 
-	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider(genDoc.ChainID)
+	csMetrics, p2pMetrics, memplMetrics, smMetrics, ipldMetrics := metricsProvider(genDoc.ChainID)
+	blockStore.SetMetrics(ipldMetrics)
+
+	// Make the provider announcing the block data of retained heights to the DHT
+	providerDB, err := dbProvider(&DBContext{"provider", config})
+	if err != nil {
+		return nil, err
+	}
+	provider := ipld.NewProvider(
+		ipfsNode.Routing,
+		providerDB,
+		ipld.ProviderWithMetrics(ipldMetrics),
+		ipld.ProviderReprovideInterval(config.IPFS.ReprovideInterval),
+	)
+	provider.SetLogger(logger.With("module", "provider"))
+	blockStore.SetProvider(provider)
+
+	// Make the publisher putting the block data of own proposals to IPFS
+	publisher := ipld.NewPublisher(
+		ipfsNode.DAG,
+		ipld.PublisherWithMetrics(ipldMetrics),
+		ipld.PublisherWithProvider(provider),
+		ipld.PublisherQueueSize(config.IPFS.PublishQueueSize),
+	)
+	publisher.SetLogger(logger.With("module", "publisher"))
 
 	// Make MempoolReactor
 	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
@@ -725,6 +789,8 @@ func NewNode(config *cfg.Config,
 	if err != nil {
 		return nil, err
 	}
+	// fraud proofs for badly encoded blocks are gossiped via the evidence reactor
+	blockStore.SetEvidencePool(evidencePool)
 
 	// make block executor for consensus and blockchain reactors to execute blocks
 	blockExec := sm.NewBlockExecutor(
@@ -751,7 +817,7 @@ func NewNode(config *cfg.Config,
 	}
 	consensusReactor, consensusState := createConsensusReactor(
 		config, state, blockExec, blockStore, mempool, evidencePool,
-		privValidator, csMetrics, stateSync || fastSync, eventBus, ipfsNode.DAG, ipfsNode.Routing, consensusLogger,
+		privValidator, csMetrics, ipldMetrics, stateSync || fastSync, eventBus, ipfsNode.DAG, publisher, consensusLogger,
 	)
 
 	// Set up state sync reactor, and schedule a sync if requested.
@@ -852,7 +918,10 @@ func NewNode(config *cfg.Config,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
 		eventBus:         eventBus,
+		ipfsDAG:          ipfsNode.DAG,
 		ipfsClose:        ipfsNode,
+		provider:         provider,
+		publisher:        publisher,
 	}
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
 
@@ -901,6 +970,14 @@ func (n *Node) OnStart() error {
 
 	n.isListening = true
 
+	// Resume providing the block data queued before the last shutdown
+	if err := n.provider.Start(); err != nil {
+		return err
+	}
+	if err := n.publisher.Start(); err != nil {
+		return err
+	}
+
 	if n.config.Mempool.WalEnabled() {
 		err = n.mempool.InitWAL()
 		if err != nil {
@@ -997,6 +1074,13 @@ func (n *Node) OnStop() {
 		}
 	}
 
+	if err := n.publisher.Stop(); err != nil {
+		n.Logger.Error("Error stopping publisher", "err", err)
+	}
+	if err := n.provider.Stop(); err != nil {
+		n.Logger.Error("Error stopping provider", "err", err)
+	}
+
 	if err := n.ipfsClose.Close(); err != nil {
 		n.Logger.Error("ipfsClose.Close()", err)
 	}
@@ -1011,6 +1095,7 @@ func (n *Node) ConfigureRPC() error {
 	rpccore.SetEnvironment(&rpccore.Environment{
 		ProxyAppQuery:   n.proxyApp.Query(),
 		ProxyAppMempool: n.proxyApp.Mempool(),
+		DAG:             n.ipfsDAG,
 
 		StateStore:     n.stateStore,
 		BlockStore:     n.blockStore,
@@ -1018,6 +1103,7 @@ func (n *Node) ConfigureRPC() error {
 		ConsensusState: n.consensusState,
 		P2PPeers:       n.sw,
 		P2PTransport:   n,
+		Publisher:      n.publisher,
 
 		PubKey:           pubKey,
 		GenDoc:           n.genesisDoc,
@@ -0,0 +1,150 @@
+package ipld
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/ipld/go-car"
+
+	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
+	"github.com/lazyledger/lazyledger-core/types"
+)
+
+// ExportSquare writes all the NMT nodes of the rows and columns committed to
+// by the DataAvailabilityHeader to w in the CARv1 format. The row roots
+// followed by the column roots are the roots of the CAR file. Nodes shared
+// between the rows and columns are written only once.
+//
+// All the nodes are retrieved from the given DAG, thus a DAG backed by an
+// offline exchange only exports squares which are stored locally.
+func ExportSquare(ctx context.Context, dag ipld.NodeGetter, dah *types.DataAvailabilityHeader, w io.Writer) error {
+	if err := dah.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid DataAvailabilityHeader: %w", err)
+	}
+
+	hashes := append(dah.RowsRoots.Bytes(), dah.ColumnRoots.Bytes()...)
+	roots := make([]cid.Cid, len(hashes))
+	for i, hash := range hashes {
+		id, err := plugin.CidFromNamespacedSha256(hash)
+		if err != nil {
+			return err
+		}
+		roots[i] = id
+	}
+
+	if err := car.WriteCar(ctx, dag, roots, w); err != nil {
+		return fmt.Errorf("failure to export square: %w", err)
+	}
+	return nil
+}
+
+// ImportSquare reads a square exported by ExportSquare from r and adds its
+// NMT nodes to the DAG. It returns the DataAvailabilityHeader made of the
+// roots of the CAR file. If expected is not nil, the square must match it,
+// which is checked before any node is added.
+//
+// Every node is checked against its CID and the square must be complete, i.e.
+// all the nodes reachable from the roots must be contained. Otherwise, an
+// error is returned and the nodes read so far may have been added.
+func ImportSquare(
+	ctx context.Context,
+	dag ipld.DAGService,
+	r io.Reader,
+	expected *types.DataAvailabilityHeader,
+) (*types.DataAvailabilityHeader, error) {
+	cr, err := car.NewCarReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failure to read CAR header: %w", err)
+	}
+
+	dah, err := dahFromRoots(cr.Header.Roots)
+	if err != nil {
+		return nil, err
+	}
+	if expected != nil && !dah.Equals(expected) {
+		return nil, fmt.Errorf("square %X does not match the expected DataAvailabilityHeader %X",
+			dah.Hash(), expected.Hash())
+	}
+
+	batch := ipld.NewBatch(ctx, dag)
+	// the CIDs of the added nodes and the ones they link to
+	added, linked := cid.NewSet(), cid.NewSet()
+	for {
+		block, err := cr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failure to read CAR block: %w", err)
+		}
+
+		id := block.Cid()
+		if id.Type() != plugin.NmtCodec {
+			return nil, fmt.Errorf("unexpected codec of node %s, got: %x, want: %x", id, id.Type(), plugin.NmtCodec)
+		}
+		sum, err := id.Prefix().Sum(block.RawData())
+		if err != nil {
+			return nil, err
+		}
+		if !sum.Equals(id) {
+			return nil, fmt.Errorf("data of node %s does not match its CID", id)
+		}
+
+		nd, err := ipld.Decode(block)
+		if err != nil {
+			return nil, fmt.Errorf("failure to decode node %s: %w", id, err)
+		}
+		if err := batch.Add(ctx, nd); err != nil {
+			return nil, err
+		}
+		added.Add(id)
+		for _, lnk := range nd.Links() {
+			linked.Add(lnk.Cid)
+		}
+	}
+
+	for _, id := range append(cr.Header.Roots, linked.Keys()...) {
+		if !added.Has(id) {
+			return nil, fmt.Errorf("incomplete square, missing node %s", id)
+		}
+	}
+
+	if err := batch.Commit(); err != nil {
+		return nil, fmt.Errorf("failure to commit square: %w", err)
+	}
+	return dah, nil
+}
+
+// dahFromRoots returns the DataAvailabilityHeader of the row roots followed by
+// the column roots of an exported square.
+func dahFromRoots(roots []cid.Cid) (*types.DataAvailabilityHeader, error) {
+	hashes := make([][]byte, len(roots))
+	for i, id := range roots {
+		hash, err := plugin.NamespacedSha256FromCID(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid root %s: %w", id, err)
+		}
+		hashes[i] = hash
+	}
+
+	rowRoots, err := types.NmtRootsFromBytes(hashes[:len(hashes)/2])
+	if err != nil {
+		return nil, err
+	}
+	colRoots, err := types.NmtRootsFromBytes(hashes[len(hashes)/2:])
+	if err != nil {
+		return nil, err
+	}
+	dah := &types.DataAvailabilityHeader{
+		RowsRoots:   rowRoots,
+		ColumnRoots: colRoots,
+	}
+	if err := dah.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid roots: %w", err)
+	}
+	return dah, nil
+}
@@ -0,0 +1,79 @@
+package ipld
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	mdutils "github.com/ipfs/go-merkledag/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
+	"github.com/lazyledger/lazyledger-core/libs/log"
+	"github.com/lazyledger/lazyledger-core/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+)
+
+func TestExportImportSquare(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	dag := mdutils.Mock()
+	codec := types.DefaultCodec()
+
+	block := &types.Block{
+		Data:       generateRandomBlockData(4, 400),
+		LastCommit: &types.Commit{},
+	}
+	block.Hash()
+	err := PutBlock(ctx, dag, block, codec, NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = ExportSquare(ctx, dag, &block.DataAvailabilityHeader, &buf)
+	require.NoError(t, err)
+	exported := buf.Bytes()
+
+	t.Run("round trip", func(t *testing.T) {
+		imported := mdutils.Mock()
+		dah, err := ImportSquare(ctx, imported, bytes.NewReader(exported), &block.DataAvailabilityHeader)
+		require.NoError(t, err)
+		assert.True(t, dah.Equals(&block.DataAvailabilityHeader))
+
+		data, err := RetrieveBlockData(ctx, dah, imported, codec, NopMetrics())
+		require.NoError(t, err)
+		assert.Equal(t, block.Data.Txs, data.Txs)
+		assert.Equal(t, block.Data.Messages, data.Messages)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		_, err := ImportSquare(ctx, mdutils.Mock(), bytes.NewReader(exported[:len(exported)/2]), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("corrupted", func(t *testing.T) {
+		corrupted := append([]byte(nil), exported...)
+		corrupted[len(corrupted)-1] ^= 0xFF
+		_, err := ImportSquare(ctx, mdutils.Mock(), bytes.NewReader(corrupted), nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("unexpected square", func(t *testing.T) {
+		var empty types.Data
+		other, _, err := empty.ComputeDataAvailabilityHeader(codec, consts.MinSquareSize)
+		require.NoError(t, err)
+
+		imported := mdutils.Mock()
+		_, err = ImportSquare(ctx, imported, bytes.NewReader(exported), &other)
+		require.Error(t, err)
+		_, err = imported.Get(ctx, plugin.MustCidFromNamespacedSha256(block.DataAvailabilityHeader.RowsRoots[0].Bytes()))
+		assert.Error(t, err)
+	})
+
+	t.Run("missing data", func(t *testing.T) {
+		err := ExportSquare(ctx, mdutils.Mock(), &block.DataAvailabilityHeader, &bytes.Buffer{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,159 @@
+package ipld
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/lazyledger/nmt"
+
+	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
+	"github.com/lazyledger/lazyledger-core/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+)
+
+// ErrByzantineData is returned by RetrieveBlockData if a row or column of the
+// repaired extended data square does not match its root in the
+// DataAvailabilityHeader, i.e. the square was not encoded correctly.
+type ErrByzantineData struct {
+	// IsRow is true if Index refers to a row and false if to a column.
+	IsRow bool
+	// Index of the row or column in the extended data square.
+	Index uint32
+	// Err is the error reported by the repair.
+	Err error
+}
+
+func (e *ErrByzantineData) Error() string {
+	axis := "column"
+	if e.IsRow {
+		axis = "row"
+	}
+	return fmt.Sprintf("%s bad encoding of %s %d: %v", baseErrorMsg, axis, e.Index, e.Err)
+}
+
+func (e *ErrByzantineData) Unwrap() error {
+	return e.Err
+}
+
+// NewBadEncodingFraudProof fetches half of the shares of the row or column
+// reported by ErrByzantineData, each with a proof of inclusion in the root of
+// its orthogonal column or row, and builds a BadEncodingFraudProof from them.
+// The height and time are the ones of the block the DataAvailabilityHeader
+// belongs to.
+func NewBadEncodingFraudProof(
+	ctx context.Context,
+	dag ipld.NodeGetter,
+	dah *types.DataAvailabilityHeader,
+	byzErr *ErrByzantineData,
+	height int64,
+	blockTime time.Time,
+) (*types.BadEncodingFraudProof, error) {
+	width := uint32(len(dah.RowsRoots))
+	if byzErr.Index >= width {
+		return nil, fmt.Errorf("index %d is out of range of the extended square width %d", byzErr.Index, width)
+	}
+
+	orthogonalRoots := dah.RowsRoots.Bytes()
+	if byzErr.IsRow {
+		orthogonalRoots = dah.ColumnRoots.Bytes()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		share types.ShareProof
+		err   error
+	}
+	results := make(chan result, width)
+	for i, root := range orthogonalRoots {
+		go func(i uint32, root []byte) {
+			share, proof, err := getLeafWithProof(ctx, dag, root, byzErr.Index, width)
+			results <- result{
+				share: types.ShareProof{Index: i, Share: share, Proof: types.NewNMTProof(proof)},
+				err:   err,
+			}
+		}(uint32(i), root)
+	}
+
+	// the row or column can be reconstructed from any half of its shares
+	var (
+		needed = width / 2
+		shares = make([]types.ShareProof, 0, needed)
+		errs   uint32
+	)
+	for uint32(len(shares)) < needed {
+		res := <-results
+		if res.err != nil {
+			errs++
+			if errs > width-needed {
+				return nil, fmt.Errorf("failure to collect shares for bad encoding fraud proof: %w", res.err)
+			}
+			continue
+		}
+		shares = append(shares, res.share)
+	}
+
+	sort.Slice(shares, func(i, j int) bool { return shares[i].Index < shares[j].Index })
+
+	return &types.BadEncodingFraudProof{
+		BlockHeight: height,
+		IsRow:       byzErr.IsRow,
+		Index:       byzErr.Index,
+		Shares:      shares,
+		Timestamp:   blockTime,
+	}, nil
+}
+
+// getLeafWithProof walks down the NMT tree with the given root to the leaf at
+// the given index and returns its share together with its inclusion proof.
+func getLeafWithProof(
+	ctx context.Context,
+	dag ipld.NodeGetter,
+	root []byte,
+	leaf, totalLeafs uint32,
+) ([]byte, nmt.Proof, error) {
+	var (
+		hash        = root
+		start, end  = uint32(0), totalLeafs
+		left, right [][]byte
+	)
+	for end-start > 1 {
+		nd, err := dag.Get(ctx, plugin.MustCidFromNamespacedSha256(hash))
+		if err != nil {
+			return nil, nmt.Proof{}, err
+		}
+
+		l, r, err := childrenHashes(nd)
+		if err != nil {
+			return nil, nmt.Proof{}, err
+		}
+
+		mid := start + (end-start)/2
+		if leaf < mid {
+			right = append(right, r)
+			hash, end = l, mid
+		} else {
+			left = append(left, l)
+			hash, start = r, mid
+		}
+	}
+
+	nd, err := dag.Get(ctx, plugin.MustCidFromNamespacedSha256(hash))
+	if err != nil {
+		return nil, nmt.Proof{}, err
+	}
+
+	// proof nodes are expected in order from left to right: the left siblings
+	// from the top down, followed by the right siblings from the bottom up
+	nodes := left
+	for i := len(right) - 1; i >= 0; i-- {
+		nodes = append(nodes, right[i])
+	}
+
+	share := nd.RawData()[1+consts.NamespaceSize:]
+	return share, nmt.NewInclusionProof(int(leaf), int(leaf)+1, nodes, true), nil
+}
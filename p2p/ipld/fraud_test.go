@@ -0,0 +1,106 @@
+package ipld
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	format "github.com/ipfs/go-ipld-format"
+	mdutils "github.com/ipfs/go-merkledag/test"
+	"github.com/lazyledger/nmt"
+	"github.com/lazyledger/nmt/namespace"
+	"github.com/lazyledger/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lazyledger/lazyledger-core/p2p/ipld/wrapper"
+	"github.com/lazyledger/lazyledger-core/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+)
+
+func TestNewBadEncodingFraudProof(t *testing.T) {
+	const squareSize = 4
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	blockData := generateRandomBlockData(squareSize*squareSize, consts.MsgShareSize-2)
+	shareData, _ := blockData.ComputeShares()
+
+	tree := wrapper.NewErasuredNamespacedMerkleTree(squareSize)
+	eds, err := rsmt2d.ComputeExtendedDataSquare(shareData.RawShares(), rsmt2d.NewRSGF8Codec(), tree.Constructor)
+	require.NoError(t, err)
+
+	// corrupt a parity share and commit to the corrupted square
+	square := make([][][]byte, eds.Width())
+	for i := range square {
+		square[i] = eds.Row(uint(i))
+	}
+	square[0][eds.Width()-1] = make([]byte, consts.ShareSize)
+
+	dag := mdutils.Mock()
+	dah := putSquare(ctx, t, dag, square)
+
+	_, err = RetrieveBlockData(ctx, dah, dag, rsmt2d.NewRSGF8Codec(), NopMetrics())
+	var byzErr *ErrByzantineData
+	require.ErrorAs(t, err, &byzErr)
+
+	blockTime := time.Now()
+	befp, err := NewBadEncodingFraudProof(ctx, dag, dah, byzErr, 1, blockTime)
+	require.NoError(t, err)
+	require.NoError(t, befp.ValidateBasic())
+	assert.Equal(t, byzErr.IsRow, befp.IsRow)
+	assert.Equal(t, byzErr.Index, befp.Index)
+	assert.Equal(t, blockTime, befp.Time())
+	require.Len(t, befp.Shares, squareSize)
+
+	orthogonalRoots := dah.RowsRoots
+	if befp.IsRow {
+		orthogonalRoots = dah.ColumnRoots
+	}
+	for _, sp := range befp.Shares {
+		rowIdx, colIdx := sp.Index, befp.Index
+		if befp.IsRow {
+			rowIdx, colIdx = befp.Index, sp.Index
+		}
+		assert.Equal(t, square[rowIdx][colIdx], sp.Share)
+
+		nID := namespace.ID(consts.ParitySharesNamespaceID)
+		if rowIdx < squareSize && colIdx < squareSize {
+			nID = sp.Share[:consts.NamespaceSize]
+		}
+		valid := sp.Proof.ToNMT().VerifyInclusion(sha256.New(), nID, sp.Share, orthogonalRoots[sp.Index])
+		assert.True(t, valid)
+	}
+}
+
+// putSquare adds the NMT trees of all rows and columns of the given extended
+// data square to the DAG and returns their roots.
+func putSquare(ctx context.Context, t *testing.T, dag format.DAGService, square [][][]byte) *types.DataAvailabilityHeader {
+	width := len(square)
+	na := NewNmtNodeAdder(ctx, format.NewBatch(ctx, dag))
+
+	root := func(axis func(i int) []byte, axisIdx int) namespace.IntervalDigest {
+		tree := nmt.New(sha256.New, nmt.NamespaceIDSize(consts.NamespaceSize), nmt.NodeVisitor(na.Visit))
+		for i := 0; i < width; i++ {
+			share := axis(i)
+			nID := consts.ParitySharesNamespaceID
+			if axisIdx < width/2 && i < width/2 {
+				nID = share[:consts.NamespaceSize]
+			}
+			require.NoError(t, tree.Push(append(append(make([]byte, 0), nID...), share...)))
+		}
+		return tree.Root()
+	}
+
+	dah := &types.DataAvailabilityHeader{}
+	for r := 0; r < width; r++ {
+		dah.RowsRoots = append(dah.RowsRoots, root(func(i int) []byte { return square[r][i] }, r))
+	}
+	for c := 0; c < width; c++ {
+		dah.ColumnRoots = append(dah.ColumnRoots, root(func(i int) []byte { return square[i][c] }, c))
+	}
+	require.NoError(t, na.Commit())
+	return dah
+}
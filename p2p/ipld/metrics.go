@@ -0,0 +1,144 @@
+package ipld
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "ipld"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Time it took to put the block data to IPFS.
+	PutBlockDurationSeconds metrics.Histogram
+	// Number of blocks queued or being published to IPFS.
+	PublishQueueDepth metrics.Gauge
+	// Number of failed attempts to publish a block to IPFS.
+	PublishFailures metrics.Counter
+	// Number of blocks which were not published as the queue was full.
+	PublishRejected metrics.Counter
+
+	// Number of row and column roots provided to the DHT.
+	ProvidedRoots metrics.Counter
+	// Number of row and column roots which failed to be provided to the DHT.
+	ProvideFailures metrics.Counter
+	// Number of heights queued or being provided to the DHT.
+	ProvideQueueDepth metrics.Gauge
+
+	// Time it took to retrieve a single sample during data availability
+	// sampling.
+	SampleLatencySeconds metrics.Histogram
+	// Number of samples which could not be retrieved.
+	SampleFailures metrics.Counter
+
+	// Time it took to repair the extended data square when retrieving block
+	// data.
+	RepairDurationSeconds metrics.Histogram
+	// Number of shares the extended data square was repaired from.
+	RetrievedShares metrics.Histogram
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		PutBlockDurationSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "put_block_duration_seconds",
+			Help:      "Time it took to put the block data to IPFS.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.1, 2, 12),
+		}, labels).With(labelsAndValues...),
+		PublishQueueDepth: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "publish_queue_depth",
+			Help:      "Number of blocks queued or being published to IPFS.",
+		}, labels).With(labelsAndValues...),
+		PublishFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "publish_failures",
+			Help:      "Number of failed attempts to publish a block to IPFS.",
+		}, labels).With(labelsAndValues...),
+		PublishRejected: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "publish_rejected",
+			Help:      "Number of blocks which were not published as the queue was full.",
+		}, labels).With(labelsAndValues...),
+		ProvidedRoots: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "provided_roots",
+			Help:      "Number of row and column roots provided to the DHT.",
+		}, labels).With(labelsAndValues...),
+		ProvideFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "provide_failures",
+			Help:      "Number of row and column roots which failed to be provided to the DHT.",
+		}, labels).With(labelsAndValues...),
+		ProvideQueueDepth: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "provide_queue_depth",
+			Help:      "Number of heights queued or being provided to the DHT.",
+		}, labels).With(labelsAndValues...),
+		SampleLatencySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sample_latency_seconds",
+			Help:      "Time it took to retrieve a single sample during data availability sampling.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.01, 2, 14),
+		}, labels).With(labelsAndValues...),
+		SampleFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sample_failures",
+			Help:      "Number of samples which could not be retrieved.",
+		}, labels).With(labelsAndValues...),
+		RepairDurationSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "repair_duration_seconds",
+			Help:      "Time it took to repair the extended data square when retrieving block data.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.001, 2, 14),
+		}, labels).With(labelsAndValues...),
+		RetrievedShares: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "retrieved_shares",
+			Help:      "Number of shares the extended data square was repaired from.",
+			Buckets:   stdprometheus.ExponentialBuckets(1, 4, 10),
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		PutBlockDurationSeconds: discard.NewHistogram(),
+		PublishQueueDepth:       discard.NewGauge(),
+		PublishFailures:         discard.NewCounter(),
+		PublishRejected:         discard.NewCounter(),
+		ProvidedRoots:           discard.NewCounter(),
+		ProvideFailures:         discard.NewCounter(),
+		ProvideQueueDepth:       discard.NewGauge(),
+		SampleLatencySeconds:    discard.NewHistogram(),
+		SampleFailures:          discard.NewCounter(),
+		RepairDurationSeconds:   discard.NewHistogram(),
+		RetrievedShares:         discard.NewHistogram(),
+	}
+}
@@ -0,0 +1,259 @@
+package ipld
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/lazyledger/nmt"
+	"github.com/lazyledger/nmt/namespace"
+
+	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
+	"github.com/lazyledger/lazyledger-core/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+)
+
+// NamespacedRow contains the shares of a single namespace found in one row of
+// the extended data square, together with an NMT proof against the row root.
+// If the namespace falls into the row's namespace range but has no shares in
+// it, Shares is empty and Proof is a proof of absence.
+type NamespacedRow struct {
+	// RowIndex is the index of the row in the extended data square.
+	RowIndex uint32
+	// Shares contains the raw shares of the namespace in order.
+	Shares [][]byte
+	// Proof is the NMT range (or absence) proof for the shares.
+	Proof nmt.Proof
+}
+
+// Verify checks that the shares and proof of the row are valid against the
+// provided row root for the given namespace.
+func (r NamespacedRow) Verify(root namespace.IntervalDigest, nID namespace.ID) bool {
+	leaves := make([][]byte, len(r.Shares))
+	for i, share := range r.Shares {
+		// leaves are pushed to the tree prefixed with their namespace
+		leaf := make([]byte, 0, len(nID)+len(share))
+		leaves[i] = append(append(leaf, nID...), share...)
+	}
+	return r.Proof.VerifyNamespace(consts.NewBaseHashFunc(), nID, leaves, root)
+}
+
+// GetSharesByNamespace fetches all the shares of the given namespace from the
+// DAG along with NMT proofs for every row whose namespace range covers nID.
+// Only the row roots of the DataAvailabilityHeader are used and only the
+// subtrees that may contain the namespace are walked down.
+func GetSharesByNamespace(
+	ctx context.Context,
+	dag ipld.NodeGetter,
+	dah *types.DataAvailabilityHeader,
+	nID namespace.ID,
+) ([]NamespacedRow, error) {
+	if len(nID) != consts.NamespaceSize {
+		return nil, fmt.Errorf("invalid namespace ID length, got: %d, want: %d", len(nID), consts.NamespaceSize)
+	}
+
+	squareWidth := uint32(len(dah.RowsRoots))
+	rowRoots := dah.RowsRoots.Bytes()
+
+	var (
+		wg     sync.WaitGroup
+		errLk  sync.Mutex
+		rowErr error
+		rows   = make([]*NamespacedRow, len(rowRoots))
+	)
+	for i, root := range rowRoots {
+		if !intersectsNamespace(root, nID) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, root []byte) {
+			defer wg.Done()
+
+			row, err := getRowSharesByNamespace(ctx, dag, root, nID, squareWidth)
+			if err != nil {
+				errLk.Lock()
+				if rowErr == nil {
+					rowErr = fmt.Errorf("failure to retrieve namespace %X from row %d: %w", nID, i, err)
+				}
+				errLk.Unlock()
+				return
+			}
+
+			row.RowIndex = uint32(i)
+			rows[i] = row
+		}(i, root)
+	}
+	wg.Wait()
+
+	if rowErr != nil {
+		return nil, rowErr
+	}
+
+	out := make([]NamespacedRow, 0)
+	for _, row := range rows {
+		if row != nil {
+			out = append(out, *row)
+		}
+	}
+	return out, nil
+}
+
+// getRowSharesByNamespace collects the shares and builds the proof of the given
+// namespace for a single row root.
+func getRowSharesByNamespace(
+	ctx context.Context,
+	dag ipld.NodeGetter,
+	root []byte,
+	nID namespace.ID,
+	totalLeafs uint32,
+) (*NamespacedRow, error) {
+	w := &namespaceWalker{ctx: ctx, dag: dag, nID: nID}
+	if err := w.collect(root, 0, totalLeafs); err != nil {
+		return nil, err
+	}
+
+	if len(w.leaves) != 0 {
+		shares := make([][]byte, len(w.leaves))
+		for i, leaf := range w.leaves {
+			shares[i] = leaf[consts.NamespaceSize:]
+		}
+
+		return &NamespacedRow{
+			Shares: shares,
+			Proof:  nmt.NewInclusionProof(w.start, w.start+len(w.leaves), w.proof, true),
+		}, nil
+	}
+
+	// the namespace is in the range of the row but there are no leaves for
+	// it, thus prove its absence instead
+	w.proof = nil
+	if err := w.absence(root, 0, totalLeafs); err != nil {
+		return nil, err
+	}
+
+	return &NamespacedRow{
+		Shares: [][]byte{},
+		Proof:  nmt.NewAbsenceProof(w.start, w.start+1, w.proof, w.leafHash, true),
+	}, nil
+}
+
+// namespaceWalker walks down an NMT tree stored in the DAG and accumulates the
+// data needed to build a namespace proof. It is not thread-safe.
+type namespaceWalker struct {
+	ctx context.Context
+	dag ipld.NodeGetter
+	nID namespace.ID
+
+	// index of the first collected leaf
+	start int
+	// namespaced data of the collected leaves
+	leaves [][]byte
+	// hash of the leaf used for proofs of absence
+	leafHash []byte
+	// hashes of the subtrees proving the collected range in the order
+	// expected by nmt.Proof
+	proof [][]byte
+}
+
+// collect walks down the subtree with the given hash spanning over the leaves
+// [start, end) and collects all leaves of the walker's namespace. Subtrees
+// not containing the namespace are not requested and become proof nodes.
+func (w *namespaceWalker) collect(hash []byte, start, end uint32) error {
+	if !intersectsNamespace(hash, w.nID) {
+		w.proof = append(w.proof, hash)
+		return nil
+	}
+
+	nd, err := w.dag.Get(w.ctx, plugin.MustCidFromNamespacedSha256(hash))
+	if err != nil {
+		return err
+	}
+
+	// a leaf can only intersect if it is of the namespace
+	if end-start == 1 {
+		if len(w.leaves) == 0 {
+			w.start = int(start)
+		}
+		w.leaves = append(w.leaves, nd.RawData()[1:])
+		return nil
+	}
+
+	left, right, err := childrenHashes(nd)
+	if err != nil {
+		return err
+	}
+
+	mid := start + (end-start)/2
+	if err := w.collect(left, start, mid); err != nil {
+		return err
+	}
+	return w.collect(right, mid, end)
+}
+
+// absence walks down the subtree with the given hash spanning over the leaves
+// [start, end) to the first leaf with a namespace greater than the walker's
+// namespace. All the siblings on the path become proof nodes.
+func (w *namespaceWalker) absence(hash []byte, start, end uint32) error {
+	if end-start == 1 {
+		w.start = int(start)
+		w.leafHash = hash
+		return nil
+	}
+
+	nd, err := w.dag.Get(w.ctx, plugin.MustCidFromNamespacedSha256(hash))
+	if err != nil {
+		return err
+	}
+
+	left, right, err := childrenHashes(nd)
+	if err != nil {
+		return err
+	}
+
+	mid := start + (end-start)/2
+	if bytes.Compare(w.nID, maxNamespace(left)) < 0 {
+		if err := w.absence(left, start, mid); err != nil {
+			return err
+		}
+		w.proof = append(w.proof, right)
+		return nil
+	}
+
+	w.proof = append(w.proof, left)
+	return w.absence(right, mid, end)
+}
+
+// childrenHashes returns the namespaced hashes of the inner node's children.
+func childrenHashes(nd ipld.Node) ([]byte, []byte, error) {
+	lnks := nd.Links()
+	if len(lnks) != 2 {
+		return nil, nil, fmt.Errorf("expected inner node with 2 links, got: %d", len(lnks))
+	}
+
+	left, err := plugin.NamespacedSha256FromCID(lnks[0].Cid)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err := plugin.NamespacedSha256FromCID(lnks[1].Cid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+// intersectsNamespace checks whether nID is in the namespace range of the
+// given namespaced hash.
+func intersectsNamespace(hash []byte, nID namespace.ID) bool {
+	return bytes.Compare(minNamespace(hash), nID) <= 0 && bytes.Compare(nID, maxNamespace(hash)) <= 0
+}
+
+func minNamespace(hash []byte) []byte {
+	return hash[:consts.NamespaceSize]
+}
+
+func maxNamespace(hash []byte) []byte {
+	return hash[consts.NamespaceSize : consts.NamespaceSize*2]
+}
@@ -0,0 +1,104 @@
+package ipld
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	mdutils "github.com/ipfs/go-merkledag/test"
+	"github.com/lazyledger/nmt/namespace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lazyledger/lazyledger-core/libs/log"
+	"github.com/lazyledger/lazyledger-core/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+)
+
+func TestGetSharesByNamespace(t *testing.T) {
+	const (
+		squareSize      = 8
+		adjustedMsgSize = consts.MsgShareSize - 2
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	blockData := generateRandomBlockData(squareSize*squareSize, adjustedMsgSize)
+	block := &types.Block{
+		Data:       blockData,
+		LastCommit: &types.Commit{},
+	}
+	block.Hash()
+
+	dag := mdutils.Mock()
+	err := PutBlock(ctx, dag, block, types.DefaultCodec(), NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+
+	dah := &block.DataAvailabilityHeader
+	for _, msg := range blockData.Messages.MessagesList {
+		rows, err := GetSharesByNamespace(ctx, dag, dah, msg.NamespaceID)
+		require.NoError(t, err)
+		require.NotEmpty(t, rows)
+
+		var shares [][]byte
+		for _, row := range rows {
+			assert.True(t, row.Verify(dah.RowsRoots[row.RowIndex], msg.NamespaceID))
+			shares = append(shares, row.Shares...)
+		}
+
+		// every message fits into a single share
+		require.Len(t, shares, 1)
+		assert.True(t, bytes.Equal(msg.NamespaceID, shares[0][:consts.NamespaceSize]))
+	}
+}
+
+func TestGetSharesByNamespaceAbsence(t *testing.T) {
+	const (
+		squareSize      = 4
+		adjustedMsgSize = consts.MsgShareSize - 2
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	nid1 := namespace.ID{1, 1, 1, 1, 1, 1, 1, 1}
+	nid2 := namespace.ID{3, 3, 3, 3, 3, 3, 3, 3}
+	absent := namespace.ID{2, 2, 2, 2, 2, 2, 2, 2}
+
+	msgs := make([]types.Message, 0, squareSize*squareSize-1)
+	for i := 0; i < cap(msgs); i++ {
+		nid := nid1
+		if i >= cap(msgs)/2 {
+			nid = nid2
+		}
+		msgs = append(msgs, types.Message{NamespaceID: nid, Data: bytes.Repeat([]byte{byte(i)}, adjustedMsgSize)})
+	}
+
+	block := &types.Block{
+		Data: types.Data{
+			Txs:      generateRandomContiguousShares(1),
+			Messages: types.Messages{MessagesList: msgs},
+		},
+		LastCommit: &types.Commit{},
+	}
+	block.Hash()
+
+	dag := mdutils.Mock()
+	err := PutBlock(ctx, dag, block, types.DefaultCodec(), NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+
+	dah := &block.DataAvailabilityHeader
+	rows, err := GetSharesByNamespace(ctx, dag, dah, absent)
+	require.NoError(t, err)
+	require.NotEmpty(t, rows)
+	for _, row := range rows {
+		assert.Empty(t, row.Shares)
+		assert.True(t, row.Proof.IsOfAbsence())
+		assert.True(t, row.Verify(dah.RowsRoots[row.RowIndex], absent))
+	}
+
+	_, err = GetSharesByNamespace(ctx, dag, dah, namespace.ID{1})
+	assert.Error(t, err)
+}
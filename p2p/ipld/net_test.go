@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
+	"github.com/lazyledger/lazyledger-core/libs/db/memdb"
 	"github.com/lazyledger/lazyledger-core/libs/log"
 	"github.com/lazyledger/lazyledger-core/types"
 	"github.com/lazyledger/lazyledger-core/types/consts"
@@ -66,8 +67,17 @@ func TestWriteDiscoveryReadData(t *testing.T) {
 		b.Hash()
 		blocks[i] = b
 
-		err := PutBlock(ctx, dag, blocks[i], dhts[i], logger)
+		err := PutBlock(ctx, dag, blocks[i], types.DefaultCodec(), NopMetrics(), logger)
 		require.NoError(t, err)
+
+		provider := NewProvider(dhts[i], memdb.NewDB())
+		provider.SetLogger(logger)
+		require.NoError(t, provider.Start())
+		defer provider.Stop() //nolint:errcheck // ignore for tests
+
+		err = provider.Provide(int64(i+1), &b.DataAvailabilityHeader)
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return provider.QueueDepth() == 0 }, 5*time.Second, 10*time.Millisecond)
 	}
 
 	for i, dag := range dags {
@@ -76,7 +86,7 @@ func TestWriteDiscoveryReadData(t *testing.T) {
 		}
 
 		exp := blocks[i+1]
-		actual, err := RetrieveBlockData(ctx, &exp.DataAvailabilityHeader, dag, rsmt2d.NewRSGF8Codec())
+		actual, err := RetrieveBlockData(ctx, &exp.DataAvailabilityHeader, dag, rsmt2d.NewRSGF8Codec(), NopMetrics())
 		assert.NoError(t, err)
 		assert.EqualValues(t, exp.Data.Txs, actual.Txs, "blocks are not equal")
 	}
@@ -0,0 +1,473 @@
+package ipld
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p-core/routing"
+	kbucket "github.com/libp2p/go-libp2p-kbucket"
+
+	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
+	dbm "github.com/lazyledger/lazyledger-core/libs/db"
+	"github.com/lazyledger/lazyledger-core/libs/service"
+	tmsync "github.com/lazyledger/lazyledger-core/libs/sync"
+	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+	"github.com/lazyledger/lazyledger-core/types"
+)
+
+// DefaultReprovideInterval is the default interval in which the roots of all
+// retained heights are provided again. It is well below the time after which
+// the DHT expires provider records.
+const DefaultReprovideInterval = 12 * time.Hour
+
+const (
+	baseKeyQueued   = byte(0x00)
+	baseKeyRetained = byte(0x01)
+)
+
+var (
+	// provideWorkers is the number of roots provided concurrently.
+	provideWorkers = 32
+	// provideBatchSize is the number of roots up to which the roots of
+	// multiple queued heights are provided in a single batch.
+	provideBatchSize = 1024
+)
+
+// Provider announces the row and column roots of the block data of retained
+// heights to the DHT, so that the data can be discovered by sampling nodes.
+//
+// Heights are queued and their roots are provided in batches in the
+// background. The queue is persisted and resumed after restarts. The roots of
+// all retained heights are provided again in a fixed interval to keep the
+// provider records from expiring, until the height is canceled.
+type Provider struct {
+	service.BaseService
+
+	croute            routing.ContentRouting
+	db                dbm.DB
+	metrics           *Metrics
+	reprovideInterval time.Duration
+
+	mtx tmsync.Mutex
+	// heights queued to be provided in the order they are provided
+	queue  []int64
+	queued map[int64]struct{}
+	// cancels providing the heights of the current batch
+	providing map[int64]context.CancelFunc
+
+	wake   chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// ProviderOption sets an optional parameter on the Provider.
+type ProviderOption func(*Provider)
+
+// ProviderWithMetrics sets the metrics.
+func ProviderWithMetrics(metrics *Metrics) ProviderOption {
+	return func(p *Provider) { p.metrics = metrics }
+}
+
+// ProviderReprovideInterval sets the interval in which the roots of all
+// retained heights are provided again. 0 disables reproviding.
+func ProviderReprovideInterval(interval time.Duration) ProviderOption {
+	return func(p *Provider) { p.reprovideInterval = interval }
+}
+
+// NewProvider returns a Provider announcing roots to the given content
+// routing. The queue and the retained heights are persisted to the given DB.
+func NewProvider(croute routing.ContentRouting, db dbm.DB, options ...ProviderOption) *Provider {
+	p := &Provider{
+		croute:            croute,
+		db:                db,
+		metrics:           NopMetrics(),
+		reprovideInterval: DefaultReprovideInterval,
+		queued:            make(map[int64]struct{}),
+		providing:         make(map[int64]context.CancelFunc),
+		wake:              make(chan struct{}, 1),
+	}
+	p.BaseService = *service.NewBaseService(nil, "Provider", p)
+	for _, option := range options {
+		option(p)
+	}
+	return p
+}
+
+// OnStart implements service.Service by resuming the persisted queue.
+func (p *Provider) OnStart() error {
+	iter, err := dbm.IteratePrefix(p.db, []byte{baseKeyQueued})
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	p.mtx.Lock()
+	for ; iter.Valid(); iter.Next() {
+		height, err := heightFromKey(iter.Key())
+		if err != nil {
+			p.mtx.Unlock()
+			return err
+		}
+		p.enqueue(height)
+	}
+	p.mtx.Unlock()
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	p.done = make(chan struct{})
+	go p.run()
+	return nil
+}
+
+// OnStop implements service.Service. Heights which have not been provided
+// yet remain queued.
+func (p *Provider) OnStop() {
+	p.cancel()
+	<-p.done
+}
+
+// Provide queues the roots of the given DataAvailabilityHeader to be provided
+// and retains them, so they are provided again in the reprovide interval.
+// Providing the same roots for a height again is a no-op, while different
+// ones replace the retained roots of the height.
+func (p *Provider) Provide(height int64, dah *types.DataAvailabilityHeader) error {
+	pbdah, err := dah.ToProto()
+	if err != nil {
+		return err
+	}
+	bz, err := proto.Marshal(pbdah)
+	if err != nil {
+		return err
+	}
+	retained, err := p.db.Get(keyRetained(height))
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(retained, bz) {
+		return nil
+	}
+
+	batch := p.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(keyRetained(height), bz); err != nil {
+		return err
+	}
+	if err := batch.Set(keyQueued(height), []byte{}); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	p.enqueue(height)
+	p.mtx.Unlock()
+	return nil
+}
+
+// Cancel stops providing the roots of the given height, whether it is queued
+// or currently being provided, and stops reproviding it.
+func (p *Provider) Cancel(height int64) error {
+	p.mtx.Lock()
+	if _, ok := p.queued[height]; ok {
+		delete(p.queued, height)
+		for i, h := range p.queue {
+			if h == height {
+				p.queue = append(p.queue[:i], p.queue[i+1:]...)
+				break
+			}
+		}
+	}
+	if cancel, ok := p.providing[height]; ok {
+		cancel()
+	}
+	p.metrics.ProvideQueueDepth.Set(float64(p.queueDepth()))
+	p.mtx.Unlock()
+
+	batch := p.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Delete(keyQueued(height)); err != nil {
+		return err
+	}
+	if err := batch.Delete(keyRetained(height)); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// QueueDepth returns the number of heights which are queued or currently
+// being provided.
+func (p *Provider) QueueDepth() int {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.queueDepth()
+}
+
+func (p *Provider) queueDepth() int {
+	return len(p.queue) + len(p.providing)
+}
+
+// enqueue appends the height to the queue unless it is queued already. Heights
+// being provided are queued again, as their roots may have changed. It must
+// be called with the lock held.
+func (p *Provider) enqueue(height int64) {
+	if _, ok := p.queued[height]; ok {
+		return
+	}
+	p.queue = append(p.queue, height)
+	p.queued[height] = struct{}{}
+	p.metrics.ProvideQueueDepth.Set(float64(p.queueDepth()))
+
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (p *Provider) run() {
+	defer close(p.done)
+
+	var reprovide <-chan time.Time
+	if p.reprovideInterval > 0 {
+		ticker := time.NewTicker(p.reprovideInterval)
+		defer ticker.Stop()
+		reprovide = ticker.C
+	}
+
+	for {
+		if p.ctx.Err() != nil {
+			return
+		}
+		if batch := p.nextBatch(); len(batch) > 0 {
+			p.provideBatch(batch)
+			continue
+		}
+
+		select {
+		case <-p.wake:
+		case <-reprovide:
+			if err := p.reprovide(); err != nil {
+				p.Logger.Error("Failed to queue retained heights for reproviding", "err", err)
+			}
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// provideJob are the roots of a height to be provided.
+type provideJob struct {
+	height int64
+	roots  []cid.Cid
+	ctx    context.Context
+	// number of roots which failed to be provided
+	failures int32
+}
+
+// nextBatch dequeues the heights whose roots are provided next, up to
+// provideBatchSize roots but at least one height.
+func (p *Provider) nextBatch() []*provideJob {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	var (
+		batch []*provideJob
+		size  int
+	)
+	for len(p.queue) > 0 {
+		height := p.queue[0]
+		dah, err := p.loadRetained(height)
+		if err != nil {
+			p.Logger.Error("Failed to load roots to provide", "height", height, "err", err)
+		}
+		if dah == nil {
+			// canceled or unreadable, skip the height
+			p.queue = p.queue[1:]
+			delete(p.queued, height)
+			if err := p.db.Delete(keyQueued(height)); err != nil {
+				p.Logger.Error("Failed to dequeue skipped height", "height", height, "err", err)
+			}
+			continue
+		}
+
+		roots := uniqueRoots(dah)
+		if len(batch) > 0 && size+len(roots) > provideBatchSize {
+			break
+		}
+		p.queue = p.queue[1:]
+		delete(p.queued, height)
+
+		ctx, cancel := context.WithCancel(p.ctx)
+		p.providing[height] = cancel
+		batch = append(batch, &provideJob{height: height, roots: roots, ctx: ctx})
+		size += len(roots)
+	}
+	return batch
+}
+
+// provideBatch provides the roots of all the heights of the batch and blocks
+// until all are provided or canceled.
+func (p *Provider) provideBatch(batch []*provideJob) {
+	start := time.Now()
+
+	type root struct {
+		job *provideJob
+		id  cid.Cid
+	}
+	roots := make(chan root)
+	var wg sync.WaitGroup
+	for i := 0; i < provideWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range roots {
+				if r.job.ctx.Err() != nil {
+					continue
+				}
+				err := p.croute.Provide(r.job.ctx, r.id, true)
+				if err == nil {
+					p.metrics.ProvidedRoots.Add(1)
+					continue
+				}
+				p.metrics.ProvideFailures.Add(1)
+				atomic.AddInt32(&r.job.failures, 1)
+				// Omit ErrLookupFailure to decrease test log spamming as
+				// this simply indicates we haven't connected to other DHT nodes yet.
+				if !errors.Is(err, kbucket.ErrLookupFailure) && r.job.ctx.Err() == nil {
+					p.Logger.Error("Failed to provide to DHT", "height", r.job.height, "root", r.id, "err", err)
+				}
+			}
+		}()
+	}
+	for _, job := range batch {
+		for _, id := range job.roots {
+			roots <- root{job: job, id: id}
+		}
+	}
+	close(roots)
+	wg.Wait()
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, job := range batch {
+		p.providing[job.height]()
+		delete(p.providing, job.height)
+		// heights canceled or interrupted by stopping remain as they are,
+		// heights queued again remain queued
+		if job.ctx.Err() != nil {
+			continue
+		}
+		if _, ok := p.queued[job.height]; ok {
+			continue
+		}
+		// roots failing to be provided are retried when reproviding
+		if job.failures > 0 {
+			p.Logger.Info("Failed to provide some roots", "height", job.height, "failures", job.failures,
+				"roots", len(job.roots))
+		}
+		if err := p.db.Delete(keyQueued(job.height)); err != nil {
+			p.Logger.Error("Failed to dequeue provided height", "height", job.height, "err", err)
+		}
+	}
+	p.metrics.ProvideQueueDepth.Set(float64(p.queueDepth()))
+	p.Logger.Debug("Provided roots to DHT", "heights", len(batch), "took", time.Since(start))
+}
+
+// reprovide queues all the retained heights.
+func (p *Provider) reprovide() error {
+	iter, err := dbm.IteratePrefix(p.db, []byte{baseKeyRetained})
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var heights []int64
+	for ; iter.Valid(); iter.Next() {
+		height, err := heightFromKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		heights = append(heights, height)
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	batch := p.db.NewBatch()
+	defer batch.Close()
+	for _, height := range heights {
+		if err := batch.Set(keyQueued(height), []byte{}); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	p.mtx.Lock()
+	for _, height := range heights {
+		p.enqueue(height)
+	}
+	p.mtx.Unlock()
+	p.Logger.Info("Reproviding retained heights", "heights", len(heights))
+	return nil
+}
+
+// loadRetained returns the DataAvailabilityHeader retained for the given
+// height or nil if there is none.
+func (p *Provider) loadRetained(height int64) (*types.DataAvailabilityHeader, error) {
+	bz, err := p.db.Get(keyRetained(height))
+	if err != nil || len(bz) == 0 {
+		return nil, err
+	}
+	pbdah := new(tmproto.DataAvailabilityHeader)
+	if err := proto.Unmarshal(bz, pbdah); err != nil {
+		return nil, err
+	}
+	return types.DataAvailabilityHeaderFromProto(pbdah)
+}
+
+// uniqueRoots returns the CIDs of the row and column roots without
+// duplicates, which are common for squares containing padding.
+func uniqueRoots(dah *types.DataAvailabilityHeader) []cid.Cid {
+	hashes := append(dah.RowsRoots.Bytes(), dah.ColumnRoots.Bytes()...)
+	set := cid.NewSet()
+	roots := make([]cid.Cid, 0, len(hashes))
+	for _, hash := range hashes {
+		id := plugin.MustCidFromNamespacedSha256(hash)
+		if set.Visit(id) {
+			roots = append(roots, id)
+		}
+	}
+	return roots
+}
+
+func bE(h int64) string {
+	return fmt.Sprintf("%0.16X", h)
+}
+
+func keyQueued(height int64) []byte {
+	return append([]byte{baseKeyQueued}, bE(height)...)
+}
+
+func keyRetained(height int64) []byte {
+	return append([]byte{baseKeyRetained}, bE(height)...)
+}
+
+func heightFromKey(key []byte) (int64, error) {
+	var height int64
+	if _, err := fmt.Sscanf(string(key[1:]), "%X", &height); err != nil {
+		return 0, fmt.Errorf("invalid provider key %X: %w", key, err)
+	}
+	return height, nil
+}
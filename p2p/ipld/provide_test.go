@@ -0,0 +1,237 @@
+package ipld
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/metrics/generic"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lazyledger/lazyledger-core/libs/db/memdb"
+	"github.com/lazyledger/lazyledger-core/libs/log"
+	"github.com/lazyledger/lazyledger-core/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+)
+
+const provideSquareSize = 4
+
+func TestProviderProvide(t *testing.T) {
+	croute := newRecordingRouting()
+	metrics := NopMetrics()
+	provided, failed := generic.NewCounter("provided_roots"), generic.NewCounter("provide_failures")
+	depth := generic.NewGauge("provide_queue_depth")
+	metrics.ProvidedRoots, metrics.ProvideFailures, metrics.ProvideQueueDepth = provided, failed, depth
+
+	provider := startProvider(t, croute, memdb.NewDB(), ProviderWithMetrics(metrics))
+	croute.block()
+	dahs := make([]*types.DataAvailabilityHeader, 3)
+	for i := range dahs {
+		dahs[i] = randDAH(t, provideSquareSize)
+		require.NoError(t, provider.Provide(int64(i+1), dahs[i]))
+	}
+	// providing a queued height again is a no-op
+	require.NoError(t, provider.Provide(1, dahs[0]))
+	assert.Equal(t, len(dahs), provider.QueueDepth())
+	croute.unblock()
+
+	waitProvided(t, provider)
+	for _, dah := range dahs {
+		for _, id := range uniqueRoots(dah) {
+			assert.Equal(t, 1, croute.count(id))
+		}
+	}
+	// all the row and column roots of the extended squares are provided
+	assert.EqualValues(t, len(dahs)*4*provideSquareSize, provided.Value())
+	assert.Zero(t, failed.Value())
+	assert.Zero(t, depth.Value())
+}
+
+func TestProviderResume(t *testing.T) {
+	db := memdb.NewDB()
+	dah := randDAH(t, provideSquareSize)
+
+	blocked := newRecordingRouting()
+	blocked.block()
+	provider := startProvider(t, blocked, db)
+	require.NoError(t, provider.Provide(1, dah))
+	require.Eventually(t, func() bool { return blocked.waiting() > 0 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, provider.Stop())
+	assert.Zero(t, blocked.total())
+
+	// the height interrupted by stopping is provided after restarting
+	croute := newRecordingRouting()
+	provider = startProvider(t, croute, db)
+	waitProvided(t, provider)
+	for _, id := range uniqueRoots(dah) {
+		assert.Equal(t, 1, croute.count(id))
+	}
+
+	// nothing is provided after restarting again
+	croute = newRecordingRouting()
+	provider = startProvider(t, croute, db)
+	assert.Zero(t, provider.QueueDepth())
+}
+
+func TestProviderCancel(t *testing.T) {
+	db := memdb.NewDB()
+	croute := newRecordingRouting()
+	croute.block()
+	provider := startProvider(t, croute, db)
+
+	canceled, queued := randDAH(t, provideSquareSize), randDAH(t, provideSquareSize)
+	require.NoError(t, provider.Provide(1, canceled))
+	require.Eventually(t, func() bool { return croute.waiting() > 0 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, provider.Provide(2, queued))
+	assert.Equal(t, 2, provider.QueueDepth())
+
+	// cancel both the height being provided and the queued one
+	require.NoError(t, provider.Cancel(1))
+	require.NoError(t, provider.Cancel(2))
+	require.Eventually(t, func() bool { return provider.QueueDepth() == 0 }, time.Second, 10*time.Millisecond)
+	croute.unblock()
+	require.NoError(t, provider.Stop())
+	for _, id := range uniqueRoots(queued) {
+		assert.Zero(t, croute.count(id))
+	}
+
+	// canceled heights are neither resumed nor reprovided
+	provider = startProvider(t, croute, db)
+	assert.Zero(t, provider.QueueDepth())
+	require.NoError(t, provider.reprovide())
+	assert.Zero(t, provider.QueueDepth())
+}
+
+func TestProviderReprovide(t *testing.T) {
+	croute := newRecordingRouting()
+	provider := startProvider(t, croute, memdb.NewDB(), ProviderReprovideInterval(50*time.Millisecond))
+
+	dah := randDAH(t, provideSquareSize)
+	require.NoError(t, provider.Provide(1, dah))
+	roots := uniqueRoots(dah)
+	require.Eventually(t, func() bool {
+		for _, id := range roots {
+			if croute.count(id) < 3 {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// canceled heights are not reprovided anymore
+	require.NoError(t, provider.Cancel(1))
+	waitProvided(t, provider)
+	total := croute.total()
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, total, croute.total())
+}
+
+func startProvider(t *testing.T, croute *recordingRouting, db *memdb.MemDB, options ...ProviderOption) *Provider {
+	provider := NewProvider(croute, db, options...)
+	provider.SetLogger(log.TestingLogger())
+	require.NoError(t, provider.Start())
+	t.Cleanup(func() {
+		if provider.IsRunning() {
+			require.NoError(t, provider.Stop())
+		}
+	})
+	return provider
+}
+
+func waitProvided(t *testing.T, provider *Provider) {
+	require.Eventually(t, func() bool { return provider.QueueDepth() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func randDAH(t *testing.T, squareSize int) *types.DataAvailabilityHeader {
+	data := generateRandomMsgOnlyData(squareSize * squareSize)
+	eds, _, err := data.ComputeExtendedDataSquare(types.DefaultCodec(), consts.MinSquareSize)
+	require.NoError(t, err)
+	dah, err := types.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return &dah
+}
+
+// recordingRouting is a routing.ContentRouting counting the provided CIDs.
+// Once blocked, providing blocks until unblocked or canceled.
+type recordingRouting struct {
+	mtx      sync.Mutex
+	provided map[cid.Cid]int
+	blocked  chan struct{}
+	blocking int
+}
+
+func newRecordingRouting() *recordingRouting {
+	return &recordingRouting{provided: make(map[cid.Cid]int)}
+}
+
+func (r *recordingRouting) Provide(ctx context.Context, id cid.Cid, _ bool) error {
+	r.mtx.Lock()
+	blocked := r.blocked
+	if blocked != nil {
+		r.blocking++
+	}
+	r.mtx.Unlock()
+
+	if blocked != nil {
+		defer func() {
+			r.mtx.Lock()
+			r.blocking--
+			r.mtx.Unlock()
+		}()
+		select {
+		case <-blocked:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.provided[id]++
+	return nil
+}
+
+func (r *recordingRouting) FindProvidersAsync(context.Context, cid.Cid, int) <-chan peer.AddrInfo {
+	out := make(chan peer.AddrInfo)
+	close(out)
+	return out
+}
+
+func (r *recordingRouting) block() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.blocked = make(chan struct{})
+}
+
+func (r *recordingRouting) unblock() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	close(r.blocked)
+	r.blocked = nil
+}
+
+func (r *recordingRouting) waiting() int {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.blocking
+}
+
+func (r *recordingRouting) count(id cid.Cid) int {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.provided[id]
+}
+
+func (r *recordingRouting) total() int {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	var total int
+	for _, n := range r.provided {
+		total += n
+	}
+	return total
+}
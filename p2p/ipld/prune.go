@@ -0,0 +1,272 @@
+package ipld
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+
+	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
+	dbm "github.com/lazyledger/lazyledger-core/libs/db"
+	"github.com/lazyledger/lazyledger-core/libs/sync"
+	"github.com/lazyledger/lazyledger-core/types"
+)
+
+// Pruner removes the NMT nodes of extended data squares written by PutBlock
+// from the DAG.
+//
+// The DAG is content addressed, thus nodes with equal content are shared
+// between blocks, e.g. the nodes of padding shares, of messages included in
+// several blocks and the parity and inner nodes derived from them. Hence, the
+// Pruner counts the blocks referencing each node, see Reference, and only
+// removes nodes which are not referenced by any block anymore.
+//
+// Counting touches every node of a square, hence it is not done when a block
+// is saved. Instead, the heights are referenced in ascending order before any
+// of them is pruned, and the counts are kept in a DB of their own.
+type Pruner struct {
+	dag ipld.DAGService
+	// db stores the reference counts of the nodes along with the heights
+	// they were counted up to
+	db dbm.DB
+
+	// serializes updating the reference counts
+	mtx sync.Mutex
+}
+
+var (
+	// referencedHeightKey is the last height whose references are counted
+	referencedHeightKey = []byte("referencedHeight")
+	// prunedHeightKey is the height below which all heights are pruned
+	prunedHeightKey = []byte("prunedHeight")
+)
+
+// NewPruner returns a Pruner removing data from the given DAG and storing the
+// reference counts of its nodes in the given DB.
+func NewPruner(dag ipld.DAGService, db dbm.DB) *Pruner {
+	return &Pruner{
+		dag: dag,
+		db:  db,
+	}
+}
+
+// ReferencedHeight returns the last height whose references are counted, or 0
+// if none are.
+func (p *Pruner) ReferencedHeight() (int64, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.loadHeight(referencedHeightKey)
+}
+
+// Reference counts a reference of the block at the given height to each node
+// of the rows and columns committed to by the DataAvailabilityHeader. It must
+// be called once the data has been put to the DAG, subtrees which are not
+// stored locally are skipped. Heights must be referenced in ascending order,
+// referencing a height again is a no-op.
+func (p *Pruner) Reference(ctx context.Context, height int64, dah *types.DataAvailabilityHeader) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	referenced, err := p.loadHeight(referencedHeightKey)
+	if err != nil {
+		return err
+	}
+	if height <= referenced {
+		return nil
+	}
+
+	batch := p.db.NewBatch()
+	defer batch.Close()
+
+	err = p.walk(ctx, dah, func(hash []byte, _ cid.Cid) error {
+		refs, err := p.refCount(hash)
+		if err != nil {
+			return err
+		}
+		return batch.Set(refCountKey(hash), []byte(strconv.FormatInt(refs+1, 10)))
+	})
+	if err != nil {
+		return err
+	}
+	if err := batch.Set(referencedHeightKey, []byte(strconv.FormatInt(height, 10))); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// Prune releases the references of the block at the given height to the nodes
+// of the rows and columns committed to by the DataAvailabilityHeader and
+// removes the nodes which are not referenced anymore from the DAG. Nodes
+// without a reference count, i.e. which were never counted, are kept. Heights
+// must be pruned in ascending order and must have been referenced before,
+// pruning a height again is a no-op. It returns the number of removed nodes.
+func (p *Pruner) Prune(ctx context.Context, height int64, dah *types.DataAvailabilityHeader) (int, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	referenced, err := p.loadHeight(referencedHeightKey)
+	if err != nil {
+		return 0, err
+	}
+	if height > referenced {
+		return 0, fmt.Errorf("height %v is not referenced yet, last referenced height is %v", height, referenced)
+	}
+	pruned, err := p.loadHeight(prunedHeightKey)
+	if err != nil {
+		return 0, err
+	}
+	if height < pruned {
+		return 0, nil
+	}
+
+	batch := p.db.NewBatch()
+	defer batch.Close()
+
+	var remove []cid.Cid
+	err = p.walk(ctx, dah, func(hash []byte, id cid.Cid) error {
+		refs, err := p.refCount(hash)
+		if err != nil {
+			return err
+		}
+		switch {
+		case refs == 0:
+			return nil
+		case refs == 1:
+			remove = append(remove, id)
+			return batch.Delete(refCountKey(hash))
+		default:
+			return batch.Set(refCountKey(hash), []byte(strconv.FormatInt(refs-1, 10)))
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := batch.Set(prunedHeightKey, []byte(strconv.FormatInt(height+1, 10))); err != nil {
+		return 0, err
+	}
+	// if removing fails after the counts were written, the nodes are left
+	// behind instead of being removed while still referenced
+	if err := batch.WriteSync(); err != nil {
+		return 0, err
+	}
+
+	if err := p.dag.RemoveMany(ctx, remove); err != nil {
+		return 0, fmt.Errorf("failure to remove nodes: %w", err)
+	}
+	return len(remove), nil
+}
+
+// walk calls fn once with each node of the rows and columns committed to by
+// the DataAvailabilityHeader. Leaves are shared between the row and column
+// trees and are not fetched, subtrees whose root is not stored are skipped.
+func (p *Pruner) walk(
+	ctx context.Context,
+	dah *types.DataAvailabilityHeader,
+	fn func(hash []byte, id cid.Cid) error,
+) error {
+	edsWidth := uint64(len(dah.RowsRoots))
+	if edsWidth == 0 {
+		return nil
+	}
+
+	w := &pruneWalker{
+		ctx:     ctx,
+		dag:     p.dag,
+		fn:      fn,
+		visited: make(map[string]struct{}),
+	}
+	for _, root := range append(dah.RowsRoots.Bytes(), dah.ColumnRoots.Bytes()...) {
+		if err := w.walk(root, edsWidth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// refCount returns the number of blocks referencing the node with the given
+// hash, or 0 if it is unknown.
+func (p *Pruner) refCount(hash []byte) (int64, error) {
+	bz, err := p.db.Get(refCountKey(hash))
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) == 0 {
+		return 0, nil
+	}
+	refs, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract reference count from %s: %w", bz, err)
+	}
+	return refs, nil
+}
+
+// loadHeight returns the height stored under the given key, or 0 if there is
+// none.
+func (p *Pruner) loadHeight(key []byte) (int64, error) {
+	bz, err := p.db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) == 0 {
+		return 0, nil
+	}
+	height, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract height from %s: %w", bz, err)
+	}
+	return height, nil
+}
+
+func refCountKey(hash []byte) []byte {
+	return []byte(fmt.Sprintf("DAN:%x", hash))
+}
+
+// pruneWalker walks down NMT trees stored in the DAG and visits each node once.
+// It is not thread-safe.
+type pruneWalker struct {
+	ctx context.Context
+	dag ipld.NodeGetter
+	fn  func(hash []byte, id cid.Cid) error
+
+	// hashes of already visited nodes, as leaves are shared between the
+	// row and column trees and subtrees may repeat within a square
+	visited map[string]struct{}
+}
+
+// walk walks down the subtree with the given hash spanning over the given
+// amount of leaves and visits all of its nodes.
+func (w *pruneWalker) walk(hash []byte, leaves uint64) error {
+	if _, ok := w.visited[string(hash)]; ok {
+		return nil
+	}
+	w.visited[string(hash)] = struct{}{}
+
+	id := plugin.MustCidFromNamespacedSha256(hash)
+	if leaves == 1 {
+		return w.fn(hash, id)
+	}
+
+	nd, err := w.dag.Get(w.ctx, id)
+	if errors.Is(err, ipld.ErrNotFound) {
+		// the subtree is not stored
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := w.fn(hash, id); err != nil {
+		return err
+	}
+
+	left, right, err := childrenHashes(nd)
+	if err != nil {
+		return err
+	}
+	if err := w.walk(left, leaves/2); err != nil {
+		return err
+	}
+	return w.walk(right, leaves/2)
+}
@@ -0,0 +1,134 @@
+package ipld
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ipld "github.com/ipfs/go-ipld-format"
+	mdutils "github.com/ipfs/go-merkledag/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
+	"github.com/lazyledger/lazyledger-core/libs/db/memdb"
+	"github.com/lazyledger/lazyledger-core/libs/log"
+	"github.com/lazyledger/lazyledger-core/types"
+)
+
+func TestPrunerPrune(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	dag := mdutils.Mock()
+	codec := types.DefaultCodec()
+	db := memdb.NewDB()
+	pruner := NewPruner(dag, db)
+
+	// both blocks have a square of width 2 containing tail padding
+	pruned := &types.Block{
+		Data:       types.Data{Txs: generateRandomContiguousShares(3)},
+		LastCommit: &types.Commit{},
+	}
+	kept := &types.Block{
+		Data:       types.Data{Txs: generateRandomContiguousShares(2)},
+		LastCommit: &types.Commit{},
+	}
+	putReferenced(ctx, t, pruner, dag, pruned, kept)
+
+	// referencing a height again is a no-op
+	require.NoError(t, pruner.Reference(ctx, 1, &pruned.DataAvailabilityHeader))
+
+	removed, err := pruner.Prune(ctx, 1, &pruned.DataAvailabilityHeader)
+	require.NoError(t, err)
+	assert.NotZero(t, removed)
+
+	for _, root := range append(pruned.DataAvailabilityHeader.RowsRoots.Bytes(),
+		pruned.DataAvailabilityHeader.ColumnRoots.Bytes()...) {
+		_, err := dag.Get(ctx, plugin.MustCidFromNamespacedSha256(root))
+		assert.Error(t, err)
+	}
+
+	// pruning again is a no-op
+	removed, err = pruner.Prune(ctx, 1, &pruned.DataAvailabilityHeader)
+	require.NoError(t, err)
+	assert.Zero(t, removed)
+
+	// the padding shared with the pruned block is still available
+	data, err := RetrieveBlockData(ctx, &kept.DataAvailabilityHeader, dag, codec, NopMetrics())
+	require.NoError(t, err)
+	assert.Equal(t, kept.Data.Txs, data.Txs)
+}
+
+func TestPrunerPruneSharedMessage(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	dag := mdutils.Mock()
+	codec := types.DefaultCodec()
+	db := memdb.NewDB()
+	pruner := NewPruner(dag, db)
+
+	// the blocks include the same message, along with namespace padding
+	// following their txs
+	msgs := generateRandomMsgOnlyData(1).Messages
+	pruned := &types.Block{
+		Data:       types.Data{Txs: generateRandomContiguousShares(1), Messages: msgs},
+		LastCommit: &types.Commit{},
+	}
+	kept := &types.Block{
+		Data:       types.Data{Txs: generateRandomContiguousShares(1), Messages: msgs},
+		LastCommit: &types.Commit{},
+	}
+	putReferenced(ctx, t, pruner, dag, pruned, kept)
+
+	removed, err := pruner.Prune(ctx, 1, &pruned.DataAvailabilityHeader)
+	require.NoError(t, err)
+	assert.NotZero(t, removed)
+
+	data, err := RetrieveBlockData(ctx, &kept.DataAvailabilityHeader, dag, codec, NopMetrics())
+	require.NoError(t, err)
+	assert.Equal(t, kept.Data.Txs, data.Txs)
+	assert.Equal(t, msgs.MessagesList, data.Messages.MessagesList)
+
+	// the nodes are removed once no block references them anymore
+	removed, err = pruner.Prune(ctx, 2, &kept.DataAvailabilityHeader)
+	require.NoError(t, err)
+	assert.NotZero(t, removed)
+	_, err = dag.Get(ctx, plugin.MustCidFromNamespacedSha256(kept.DataAvailabilityHeader.RowsRoots.Bytes()[0]))
+	assert.Error(t, err)
+}
+
+func TestPrunerPruneUnreferenced(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	dag := mdutils.Mock()
+	pruner := NewPruner(dag, memdb.NewDB())
+
+	block := &types.Block{
+		Data:       types.Data{Txs: generateRandomContiguousShares(1)},
+		LastCommit: &types.Commit{},
+	}
+	block.Hash()
+	err := PutBlock(ctx, dag, block, types.DefaultCodec(), NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+
+	// a height is only pruned once it is referenced, as the counts of the
+	// nodes it shares with other heights would be off otherwise
+	_, err = pruner.Prune(ctx, 1, &block.DataAvailabilityHeader)
+	require.Error(t, err)
+	_, err = dag.Get(ctx, plugin.MustCidFromNamespacedSha256(block.DataAvailabilityHeader.RowsRoots.Bytes()[0]))
+	require.NoError(t, err)
+}
+
+// putReferenced puts the blocks to the DAG and references them at the heights
+// following their index.
+func putReferenced(ctx context.Context, t *testing.T, pruner *Pruner, dag ipld.DAGService, blocks ...*types.Block) {
+	for i, block := range blocks {
+		block.Hash()
+		err := PutBlock(ctx, dag, block, types.DefaultCodec(), NopMetrics(), log.TestingLogger())
+		require.NoError(t, err)
+		require.NoError(t, pruner.Reference(ctx, int64(i+1), &block.DataAvailabilityHeader))
+	}
+}
@@ -0,0 +1,267 @@
+package ipld
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/lazyledger/rsmt2d"
+
+	"github.com/lazyledger/lazyledger-core/libs/service"
+	tmsync "github.com/lazyledger/lazyledger-core/libs/sync"
+	"github.com/lazyledger/lazyledger-core/types"
+)
+
+// DefaultPublishQueueSize is the default number of blocks which can be queued
+// or being published at once.
+const DefaultPublishQueueSize = 16
+
+// ErrPublishQueueFull is returned when a block can not be published as the
+// queue is full.
+var ErrPublishQueueFull = errors.New("publish queue is full")
+
+var (
+	// publishMaxAttempts is the number of times putting a block is attempted
+	// before it is given up.
+	publishMaxAttempts = 5
+	// publishRetryDelay is the delay before the first retry of a failed put,
+	// which doubles with every further retry.
+	publishRetryDelay = time.Second
+)
+
+// Publisher puts the data of blocks to IPFS in the background and queues
+// their roots to be provided to the DHT afterwards.
+//
+// Blocks are published one at a time in the order they are queued. The queue
+// is bounded, blocks are rejected instead of silently dropping queued ones
+// when it is full. Failed puts are retried with an increasing delay. Unlike
+// the Provider queue, the queue is not persisted: the data of committed blocks
+// is put by the block store regardless, only proposals which are not
+// committed yet can be lost when stopping.
+type Publisher struct {
+	service.BaseService
+
+	dag       ipld.NodeAdder
+	provider  *Provider
+	metrics   *Metrics
+	queueSize int
+
+	mtx tmsync.Mutex
+	// blocks queued to be published in the order they are published
+	queue []*publishJob
+	// the block currently being published, if any
+	publishing *publishJob
+
+	wake   chan struct{}
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// PublisherOption sets an optional parameter on the Publisher.
+type PublisherOption func(*Publisher)
+
+// PublisherWithMetrics sets the metrics.
+func PublisherWithMetrics(metrics *Metrics) PublisherOption {
+	return func(p *Publisher) { p.metrics = metrics }
+}
+
+// PublisherWithProvider sets the provider the roots of published blocks are
+// queued to be provided with.
+func PublisherWithProvider(provider *Provider) PublisherOption {
+	return func(p *Publisher) { p.provider = provider }
+}
+
+// PublisherQueueSize sets the number of blocks which can be queued or being
+// published at once.
+func PublisherQueueSize(size int) PublisherOption {
+	return func(p *Publisher) { p.queueSize = size }
+}
+
+// NewPublisher returns a Publisher putting block data to the given DAG.
+func NewPublisher(dag ipld.NodeAdder, options ...PublisherOption) *Publisher {
+	p := &Publisher{
+		dag:       dag,
+		metrics:   NopMetrics(),
+		queueSize: DefaultPublishQueueSize,
+		wake:      make(chan struct{}, 1),
+	}
+	p.BaseService = *service.NewBaseService(nil, "Publisher", p)
+	for _, option := range options {
+		option(p)
+	}
+	return p
+}
+
+// OnStart implements service.Service.
+func (p *Publisher) OnStart() error {
+	p.ctx, p.cancel = context.WithCancel(context.Background())
+	p.done = make(chan struct{})
+	go p.run()
+	return nil
+}
+
+// OnStop implements service.Service. Blocks which have not been published yet
+// are dropped.
+func (p *Publisher) OnStop() {
+	p.cancel()
+	<-p.done
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, job := range p.queue {
+		p.Logger.Info("Dropping unpublished block", "height", job.height)
+	}
+	p.queue = nil
+	p.metrics.PublishQueueDepth.Set(0)
+}
+
+// Publish queues the data of the given block to be put with the given codec.
+// Publishing a block whose data is queued or being published already is a
+// no-op. ErrPublishQueueFull is returned if the queue is full.
+//
+// The DataAvailabilityHeader of the block must be filled in.
+func (p *Publisher) Publish(block *types.Block, codec rsmt2d.Codec) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.publishing != nil && p.publishing.publishes(block) {
+		return nil
+	}
+	for _, job := range p.queue {
+		if job.publishes(block) {
+			return nil
+		}
+	}
+	if p.queueDepth() >= p.queueSize {
+		p.metrics.PublishRejected.Add(1)
+		return ErrPublishQueueFull
+	}
+
+	p.queue = append(p.queue, &publishJob{height: block.Height, block: block, codec: codec})
+	p.metrics.PublishQueueDepth.Set(float64(p.queueDepth()))
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// QueueDepth returns the number of blocks which are queued or currently being
+// published.
+func (p *Publisher) QueueDepth() int {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.queueDepth()
+}
+
+// QueueSize returns the number of blocks which can be queued or being
+// published at once.
+func (p *Publisher) QueueSize() int {
+	return p.queueSize
+}
+
+func (p *Publisher) queueDepth() int {
+	depth := len(p.queue)
+	if p.publishing != nil {
+		depth++
+	}
+	return depth
+}
+
+// publishJob is a block to be published.
+type publishJob struct {
+	height int64
+	block  *types.Block
+	codec  rsmt2d.Codec
+	// number of failed attempts to put the block
+	failures int
+}
+
+// publishes returns true if the job publishes the data of the given block.
+func (job *publishJob) publishes(block *types.Block) bool {
+	return job.height == block.Height && bytes.Equal(job.block.DataHash, block.DataHash)
+}
+
+func (p *Publisher) run() {
+	defer close(p.done)
+
+	for {
+		if p.ctx.Err() != nil {
+			return
+		}
+		if job := p.next(); job != nil {
+			p.publish(job)
+			continue
+		}
+
+		select {
+		case <-p.wake:
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// next dequeues the block which is published next, if any.
+func (p *Publisher) next() *publishJob {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if len(p.queue) == 0 {
+		return nil
+	}
+	p.publishing, p.queue = p.queue[0], p.queue[1:]
+	return p.publishing
+}
+
+// publish puts the data of the block, retrying failed puts, and queues its
+// roots to be provided.
+func (p *Publisher) publish(job *publishJob) {
+	defer func() {
+		p.mtx.Lock()
+		p.publishing = nil
+		p.metrics.PublishQueueDepth.Set(float64(p.queueDepth()))
+		p.mtx.Unlock()
+	}()
+
+	delay := publishRetryDelay
+	for {
+		p.Logger.Info("Putting block to IPFS", "height", job.height)
+		err := PutBlock(p.ctx, p.dag, job.block, job.codec, p.metrics, p.Logger)
+		if err == nil {
+			break
+		}
+		if p.ctx.Err() != nil {
+			p.Logger.Info("Putting block was interrupted by stopping", "height", job.height)
+			return
+		}
+		p.metrics.PublishFailures.Add(1)
+		job.failures++
+		if job.failures >= publishMaxAttempts {
+			p.Logger.Error("Failed to put block to IPFS, giving up", "height", job.height,
+				"attempts", job.failures, "err", err)
+			return
+		}
+		p.Logger.Error("Failed to put block to IPFS, retrying", "height", job.height,
+			"attempts", job.failures, "retryIn", delay, "err", err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-p.ctx.Done():
+			timer.Stop()
+			return
+		}
+		delay *= 2
+	}
+	p.Logger.Info("Finished putting block to IPFS", "height", job.height)
+
+	if p.provider == nil {
+		return
+	}
+	if err := p.provider.Provide(job.height, &job.block.DataAvailabilityHeader); err != nil {
+		p.Logger.Error("Failed to queue block for providing", "height", job.height, "err", err)
+	}
+}
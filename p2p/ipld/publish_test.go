@@ -0,0 +1,194 @@
+package ipld
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/metrics/generic"
+	ipld "github.com/ipfs/go-ipld-format"
+	mdutils "github.com/ipfs/go-merkledag/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
+	"github.com/lazyledger/lazyledger-core/libs/db/memdb"
+	"github.com/lazyledger/lazyledger-core/libs/log"
+	"github.com/lazyledger/lazyledger-core/types"
+)
+
+func TestPublisherPublish(t *testing.T) {
+	dag := mdutils.Mock()
+	adder := newControlledAdder(dag)
+	croute := newRecordingRouting()
+	provider := startProvider(t, croute, memdb.NewDB())
+
+	metrics := NopMetrics()
+	depth, rejected := generic.NewGauge("publish_queue_depth"), generic.NewCounter("publish_rejected")
+	metrics.PublishQueueDepth, metrics.PublishRejected = depth, rejected
+	publisher := startPublisher(t, adder,
+		PublisherWithMetrics(metrics), PublisherWithProvider(provider), PublisherQueueSize(2))
+
+	adder.block()
+	first, second := randBlock(1), randBlock(2)
+	require.NoError(t, publisher.Publish(first, types.DefaultCodec()))
+	require.Eventually(t, func() bool { return adder.waiting() > 0 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, publisher.Publish(second, types.DefaultCodec()))
+	// publishing a block being published or queued again is a no-op
+	require.NoError(t, publisher.Publish(first, types.DefaultCodec()))
+	require.NoError(t, publisher.Publish(second, types.DefaultCodec()))
+	assert.Equal(t, 2, publisher.QueueDepth())
+	assert.EqualValues(t, 2, depth.Value())
+
+	// blocks are rejected instead of dropping queued ones
+	err := publisher.Publish(randBlock(3), types.DefaultCodec())
+	assert.True(t, errors.Is(err, ErrPublishQueueFull))
+	assert.EqualValues(t, 1, rejected.Value())
+	adder.unblock()
+
+	waitPublished(t, publisher)
+	waitProvided(t, provider)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for _, block := range []*types.Block{first, second} {
+		for _, id := range uniqueRoots(&block.DataAvailabilityHeader) {
+			_, err := dag.Get(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, 1, croute.count(id))
+		}
+	}
+	assert.Zero(t, depth.Value())
+}
+
+func TestPublisherRetry(t *testing.T) {
+	defer func(delay time.Duration) { publishRetryDelay = delay }(publishRetryDelay)
+	publishRetryDelay = 10 * time.Millisecond
+
+	dag := mdutils.Mock()
+	adder := newControlledAdder(dag)
+	metrics := NopMetrics()
+	failures := generic.NewCounter("publish_failures")
+	metrics.PublishFailures = failures
+	publisher := startPublisher(t, adder, PublisherWithMetrics(metrics))
+
+	// the block is put once adding stops failing
+	adder.setFailing(true)
+	block := randBlock(1)
+	require.NoError(t, publisher.Publish(block, types.DefaultCodec()))
+	require.Eventually(t, func() bool { return failures.Value() >= 2 }, time.Second, 10*time.Millisecond)
+	adder.setFailing(false)
+	waitPublished(t, publisher)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for _, root := range block.DataAvailabilityHeader.RowsRoots.Bytes() {
+		_, err := dag.Get(ctx, plugin.MustCidFromNamespacedSha256(root))
+		require.NoError(t, err)
+	}
+
+	// the block is given up after failing all attempts
+	failed := failures.Value()
+	adder.setFailing(true)
+	require.NoError(t, publisher.Publish(randBlock(2), types.DefaultCodec()))
+	waitPublished(t, publisher)
+	assert.EqualValues(t, failed+float64(publishMaxAttempts), failures.Value())
+}
+
+func startPublisher(t *testing.T, adder ipld.NodeAdder, options ...PublisherOption) *Publisher {
+	publisher := NewPublisher(adder, options...)
+	publisher.SetLogger(log.TestingLogger())
+	require.NoError(t, publisher.Start())
+	t.Cleanup(func() {
+		if publisher.IsRunning() {
+			require.NoError(t, publisher.Stop())
+		}
+	})
+	return publisher
+}
+
+func waitPublished(t *testing.T, publisher *Publisher) {
+	require.Eventually(t, func() bool { return publisher.QueueDepth() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func randBlock(height int64) *types.Block {
+	block := &types.Block{
+		Header:     types.Header{Height: height},
+		Data:       generateRandomBlockData(4, 400),
+		LastCommit: &types.Commit{},
+	}
+	block.Hash()
+	return block
+}
+
+// controlledAdder is an ipld.NodeAdder which can be made to block adding
+// nodes until unblocked or canceled, or to fail adding nodes.
+type controlledAdder struct {
+	ipld.NodeAdder
+
+	mtx      sync.Mutex
+	blocked  chan struct{}
+	blocking int
+	failing  bool
+}
+
+func newControlledAdder(adder ipld.NodeAdder) *controlledAdder {
+	return &controlledAdder{NodeAdder: adder}
+}
+
+func (a *controlledAdder) Add(ctx context.Context, nd ipld.Node) error {
+	return a.AddMany(ctx, []ipld.Node{nd})
+}
+
+func (a *controlledAdder) AddMany(ctx context.Context, nds []ipld.Node) error {
+	a.mtx.Lock()
+	if a.failing {
+		a.mtx.Unlock()
+		return errors.New("add failed")
+	}
+	blocked := a.blocked
+	if blocked != nil {
+		a.blocking++
+	}
+	a.mtx.Unlock()
+
+	if blocked != nil {
+		defer func() {
+			a.mtx.Lock()
+			a.blocking--
+			a.mtx.Unlock()
+		}()
+		select {
+		case <-blocked:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return a.NodeAdder.AddMany(ctx, nds)
+}
+
+func (a *controlledAdder) block() {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.blocked = make(chan struct{})
+}
+
+func (a *controlledAdder) unblock() {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	close(a.blocked)
+	a.blocked = nil
+}
+
+func (a *controlledAdder) setFailing(failing bool) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.failing = failing
+}
+
+func (a *controlledAdder) waiting() int {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	return a.blocking
+}
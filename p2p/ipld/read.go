@@ -1,12 +1,16 @@
 package ipld
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
+	"time"
 
 	"github.com/ipfs/go-cid"
 	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/lazyledger/nmt"
 	"github.com/lazyledger/rsmt2d"
 
 	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
@@ -22,12 +26,34 @@ var ErrTimeout = fmt.Errorf("%s %s", baseErrorMsg, "timeout")
 
 // RetrieveBlockData asynchronously fetches block data using the minimum number
 // of requests to IPFS. It fails if one of the random samples sampled is not available.
+// If the repaired data does not match the DataAvailabilityHeader, an
+// *ErrByzantineData is returned which can be used to build a fraud proof.
 func RetrieveBlockData(
 	ctx context.Context,
 	dah *types.DataAvailabilityHeader,
 	dag ipld.NodeGetter,
 	codec rsmt2d.Codec,
+	metrics *Metrics,
 ) (types.Data, error) {
+	eds, err := RetrieveExtendedDataSquare(ctx, dah, dag, codec, metrics)
+	if err != nil {
+		return types.Data{}, err
+	}
+	return types.DataFromSquare(eds)
+}
+
+// RetrieveExtendedDataSquare fetches and repairs the extended data square
+// committed to by the DataAvailabilityHeader like RetrieveBlockData does.
+func RetrieveExtendedDataSquare(
+	ctx context.Context,
+	dah *types.DataAvailabilityHeader,
+	dag ipld.NodeGetter,
+	codec rsmt2d.Codec,
+	metrics *Metrics,
+) (*rsmt2d.ExtendedDataSquare, error) {
+	if err := dah.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("%s %w", baseErrorMsg, err)
+	}
 	edsWidth := len(dah.RowsRoots)
 	sc := newshareCounter(ctx, uint32(edsWidth))
 
@@ -41,7 +67,7 @@ func RetrieveBlockData(
 		for _, col := range uniqueRandNumbers(edsWidth/2, edsWidth) {
 			rootCid, err := plugin.CidFromNamespacedSha256(rowRoots[row])
 			if err != nil {
-				return types.Data{}, err
+				return nil, err
 			}
 
 			go sc.retrieveShare(rootCid, true, row, col, dag)
@@ -52,7 +78,7 @@ func RetrieveBlockData(
 	// or the timeout is reached
 	err := sc.wait()
 	if err != nil {
-		return types.Data{}, err
+		return nil, err
 	}
 
 	// flatten the square
@@ -61,17 +87,249 @@ func RetrieveBlockData(
 	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(edsWidth) / 2)
 
 	// repair the square
+	start := time.Now()
 	eds, err := rsmt2d.RepairExtendedDataSquare(rowRoots, colRoots, flattened, codec, tree.Constructor)
+	metrics.RepairDurationSeconds.Observe(time.Since(start).Seconds())
+	metrics.RetrievedShares.Observe(float64(sc.counter))
 	if err != nil {
-		return types.Data{}, err
+		var (
+			rowErr *rsmt2d.ErrByzantineRow
+			colErr *rsmt2d.ErrByzantineCol
+		)
+		switch {
+		case errors.As(err, &rowErr):
+			return nil, &ErrByzantineData{IsRow: true, Index: uint32(rowErr.RowNumber), Err: err}
+		case errors.As(err, &colErr):
+			return nil, &ErrByzantineData{IsRow: false, Index: uint32(colErr.ColumnNumber), Err: err}
+		}
+		return nil, err
 	}
 
-	blockData, err := types.DataFromSquare(eds)
+	return eds, nil
+}
+
+// RowFunc is called with the shares of each row of the original data square.
+// Returning an error stops the retrieval of the remaining rows.
+type RowFunc func(row uint32, shares [][]byte) error
+
+// retrieveRowsInFlight is the number of rows retrieved concurrently when
+// streaming block data.
+var retrieveRowsInFlight = 4
+
+// RetrieveBlockDataStreaming fetches block data like RetrieveBlockData, but
+// row by row, see RetrieveRows. The rows are parsed while the following ones
+// are being retrieved.
+func RetrieveBlockDataStreaming(
+	ctx context.Context,
+	dah *types.DataAvailabilityHeader,
+	dag ipld.NodeGetter,
+	codec rsmt2d.Codec,
+	metrics *Metrics,
+) (types.Data, error) {
+	var parser types.RowParser
+	err := RetrieveRows(ctx, dah, dag, codec, metrics, func(_ uint32, shares [][]byte) error {
+		parser.AddRow(shares)
+		return nil
+	})
 	if err != nil {
 		return types.Data{}, err
 	}
+	return parser.Data()
+}
+
+// RetrieveRows fetches the rows of the original data square one after the
+// other and passes them to fn in order. Each row is repaired on its own from
+// any half of its shares, preferring the original ones, so that only the
+// original data square is retrieved and held in memory at most a few rows at
+// a time, instead of a quarter of the extended data square at once.
+//
+// Only the row roots are checked. If a repaired row does not match its root,
+// an *ErrByzantineData is returned which can be used to build a fraud proof.
+func RetrieveRows(
+	ctx context.Context,
+	dah *types.DataAvailabilityHeader,
+	dag ipld.NodeGetter,
+	codec rsmt2d.Codec,
+	metrics *Metrics,
+	fn RowFunc,
+) error {
+	if err := dah.ValidateBasic(); err != nil {
+		return fmt.Errorf("%s %w", baseErrorMsg, err)
+	}
+	edsWidth := uint32(len(dah.RowsRoots))
+	rowRoots := dah.RowsRoots.Bytes()
 
-	return blockData, nil
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// retrieve up to retrieveRowsInFlight rows ahead of the one passed to fn
+	results := make([]chan rowResult, edsWidth/2)
+	for i := range results {
+		results[i] = make(chan rowResult, 1)
+	}
+	inFlight := make(chan struct{}, retrieveRowsInFlight)
+	go func() {
+		for i := range results {
+			select {
+			case inFlight <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func(row uint32) {
+				results[row] <- retrieveRow(ctx, dag, codec, rowRoots[row], row, edsWidth)
+			}(uint32(i))
+		}
+	}()
+
+	var (
+		retrieved int
+		repairing time.Duration
+	)
+	defer func() {
+		metrics.RepairDurationSeconds.Observe(repairing.Seconds())
+		metrics.RetrievedShares.Observe(float64(retrieved))
+	}()
+	for i, result := range results {
+		var res rowResult
+		select {
+		case res = <-result:
+		case <-ctx.Done():
+			return ErrTimeout
+		}
+		<-inFlight
+
+		retrieved += res.retrieved
+		repairing += res.repairing
+		if res.err != nil {
+			return res.err
+		}
+		if err := fn(uint32(i), res.shares); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type rowResult struct {
+	// the shares of the original data square in the row
+	shares [][]byte
+	// number of shares retrieved
+	retrieved int
+	// time it took to repair the row
+	repairing time.Duration
+	err       error
+}
+
+// retrieveRow fetches half of the shares of a row of the extended data square,
+// starting with the original ones and falling back to the parity ones for
+// those which can not be retrieved, and repairs the row from them.
+func retrieveRow(
+	ctx context.Context,
+	dag ipld.NodeGetter,
+	codec rsmt2d.Codec,
+	root []byte,
+	row, edsWidth uint32,
+) (res rowResult) {
+	rootCid, err := plugin.CidFromNamespacedSha256(root)
+	if err != nil {
+		return rowResult{err: err}
+	}
+
+	squareSize := edsWidth / 2
+	type leaf struct {
+		idx  uint32
+		data []byte
+		err  error
+	}
+	leaves := make(chan leaf, edsWidth)
+	fetch := func(idx uint32) {
+		go func() {
+			data, err := GetLeafData(ctx, rootCid, idx, edsWidth, dag)
+			leaves <- leaf{idx: idx, data: data, err: err}
+		}()
+	}
+	for idx := uint32(0); idx < squareSize; idx++ {
+		fetch(idx)
+	}
+
+	var (
+		shares  = make([][]byte, edsWidth)
+		next    = squareSize // the next parity share to fetch on failures
+		pending = squareSize
+		lastErr error
+	)
+	for uint32(res.retrieved) < squareSize {
+		if pending == 0 {
+			return rowResult{
+				retrieved: res.retrieved,
+				err:       fmt.Errorf("%w: row %d: %v", ErrEncounteredTooManyErrors, row, lastErr),
+			}
+		}
+		l := <-leaves
+		pending--
+		if l.err == nil && len(l.data) < consts.ShareSize {
+			l.err = fmt.Errorf("share %d of row %d is too short", l.idx, row)
+		}
+		if l.err != nil {
+			if ctx.Err() != nil {
+				return rowResult{retrieved: res.retrieved, err: ErrTimeout}
+			}
+			lastErr = l.err
+			if next < edsWidth {
+				fetch(next)
+				next++
+				pending++
+			}
+			continue
+		}
+		shares[l.idx] = l.data[consts.NamespaceSize:]
+		res.retrieved++
+	}
+
+	// nothing to repair if all the original shares were retrieved
+	if next == squareSize {
+		res.shares = shares[:squareSize]
+		return res
+	}
+
+	start := time.Now()
+	res.shares, err = repairRow(codec, shares, root)
+	res.repairing = time.Since(start)
+	if err != nil {
+		res.err = &ErrByzantineData{IsRow: true, Index: row, Err: err}
+	}
+	return res
+}
+
+// repairRow recomputes the missing shares of a row of the extended data square
+// from half of its shares and returns the original ones. It fails if the
+// repaired row does not match the given root.
+func repairRow(codec rsmt2d.Codec, shares [][]byte, root []byte) ([][]byte, error) {
+	squareSize := len(shares) / 2
+	decoded, err := codec.Decode(shares)
+	if err != nil {
+		return nil, err
+	}
+	original := decoded[:squareSize:squareSize]
+	parity, err := codec.Encode(original)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := nmt.New(consts.NewBaseHashFunc, nmt.NamespaceIDSize(consts.NamespaceSize))
+	for i, share := range append(original, parity...) {
+		nID := consts.ParitySharesNamespaceID
+		if i < squareSize {
+			nID = share[:consts.NamespaceSize]
+		}
+		if err := tree.Push(append(append(make([]byte, 0, len(nID)+len(share)), nID...), share...)); err != nil {
+			return nil, fmt.Errorf("repaired row can not be committed to: %w", err)
+		}
+	}
+	if !bytes.Equal(tree.Root().Bytes(), root) {
+		return nil, errors.New("repaired row does not match its root")
+	}
+	return original, nil
 }
 
 // uniqueRandNumbers generates count unique random numbers with a max of max
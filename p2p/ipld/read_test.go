@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"math"
 	"math/rand"
@@ -19,10 +20,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/lazyledger/lazyledger-core/ipfs"
 	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
 	"github.com/lazyledger/lazyledger-core/libs/log"
 	"github.com/lazyledger/lazyledger-core/p2p/ipld/wrapper"
+	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
 	"github.com/lazyledger/lazyledger-core/types"
 	"github.com/lazyledger/lazyledger-core/types/consts"
 )
@@ -128,7 +129,7 @@ func TestRetrieveBlockData(t *testing.T) {
 		{"4 KB block", 4, false, ""},
 		{"16 KB block", 8, false, ""},
 		{"16 KB block timeout expected", 8, true, "not found"},
-		{"max square size", consts.MaxSquareSize, false, ""},
+		{"max square size", int(types.MaxSquareSize(tmproto.ErasureCodecRSGF8)), false, ""},
 	}
 
 	for _, tc := range tests {
@@ -141,7 +142,6 @@ func TestRetrieveBlockData(t *testing.T) {
 		t.Run(fmt.Sprintf("%s size %d", tc.name, tc.squareSize), func(t *testing.T) {
 			ctx := context.Background()
 			dag := mdutils.Mock()
-			croute := ipfs.MockRouting()
 
 			blockData := generateRandomBlockData(tc.squareSize*tc.squareSize, consts.MsgShareSize-2)
 			block := &types.Block{
@@ -151,7 +151,7 @@ func TestRetrieveBlockData(t *testing.T) {
 
 			// if an error is exected, don't put the block
 			if !tc.expectErr {
-				err := PutBlock(ctx, dag, block, croute, logger)
+				err := PutBlock(ctx, dag, block, types.DefaultCodec(), NopMetrics(), logger)
 				require.NoError(t, err)
 			}
 
@@ -179,6 +179,7 @@ func TestRetrieveBlockData(t *testing.T) {
 				},
 				dag,
 				rsmt2d.NewRSGF8Codec(),
+				NopMetrics(),
 			)
 
 			if tc.expectErr {
@@ -194,6 +195,112 @@ func TestRetrieveBlockData(t *testing.T) {
 	}
 }
 
+func TestRetrieveBlockDataStreaming(t *testing.T) {
+	const squareSize = 8
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	dag := mdutils.Mock()
+	block := &types.Block{
+		Data:       generateRandomBlockData(squareSize*squareSize, consts.MsgShareSize-2),
+		LastCommit: &types.Commit{},
+	}
+	block.Hash()
+	dah := &block.DataAvailabilityHeader
+	err := PutBlock(ctx, dag, block, types.DefaultCodec(), NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+
+	expected, err := RetrieveBlockData(ctx, dah, dag, types.DefaultCodec(), NopMetrics())
+	require.NoError(t, err)
+
+	// removeLeaf removes the given share of the given row from the DAG
+	removeLeaf := func(row, col uint32) {
+		width := uint32(len(dah.RowsRoots))
+		root := plugin.MustCidFromNamespacedSha256(dah.RowsRoots[row].Bytes())
+		nd, err := GetLeaf(ctx, dag, root, col, width)
+		require.NoError(t, err)
+		require.NoError(t, dag.Remove(ctx, nd.Cid()))
+	}
+
+	t.Run("rows in order", func(t *testing.T) {
+		var rows []uint32
+		err := RetrieveRows(ctx, dah, dag, types.DefaultCodec(), NopMetrics(), func(row uint32, shares [][]byte) error {
+			assert.Len(t, shares, squareSize)
+			rows = append(rows, row)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uint32{0, 1, 2, 3, 4, 5, 6, 7}, rows)
+	})
+
+	t.Run("stop on error", func(t *testing.T) {
+		stop := errors.New("stop")
+		var calls int
+		err := RetrieveRows(ctx, dah, dag, types.DefaultCodec(), NopMetrics(), func(row uint32, shares [][]byte) error {
+			calls++
+			if row == 1 {
+				return stop
+			}
+			return nil
+		})
+		assert.Equal(t, stop, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("complete square", func(t *testing.T) {
+		data, err := RetrieveBlockDataStreaming(ctx, dah, dag, types.DefaultCodec(), NopMetrics())
+		require.NoError(t, err)
+		assert.Equal(t, expected, data)
+	})
+
+	t.Run("repair rows", func(t *testing.T) {
+		// every row misses one of its original shares
+		for row := uint32(0); row < squareSize; row++ {
+			removeLeaf(row, row)
+		}
+		data, err := RetrieveBlockDataStreaming(ctx, dah, dag, types.DefaultCodec(), NopMetrics())
+		require.NoError(t, err)
+		assert.Equal(t, expected, data)
+	})
+
+	t.Run("unrepairable row", func(t *testing.T) {
+		// the first row misses more than half of its shares
+		for col := uint32(1); col <= squareSize; col++ {
+			removeLeaf(0, col)
+		}
+		_, err := RetrieveBlockDataStreaming(ctx, dah, dag, types.DefaultCodec(), NopMetrics())
+		assert.ErrorIs(t, err, ErrEncounteredTooManyErrors)
+	})
+}
+
+func TestRepairRowByzantine(t *testing.T) {
+	const squareSize = 4
+
+	shares := generateRandNamespacedRawData(squareSize*squareSize, consts.NamespaceSize, consts.ShareSize)
+	sortByteArrays(shares)
+	tree := wrapper.NewErasuredNamespacedMerkleTree(squareSize)
+	eds, err := rsmt2d.ComputeExtendedDataSquare(shares, types.DefaultCodec(), tree.Constructor)
+	require.NoError(t, err)
+	root := eds.RowRoots()[0]
+
+	// half of the shares of a valid row repair it
+	row := eds.Row(0)
+	partial := make([][]byte, len(row))
+	copy(partial[squareSize:], row[squareSize:])
+	original, err := repairRow(types.DefaultCodec(), partial, root)
+	require.NoError(t, err)
+	assert.Equal(t, row[:squareSize], original)
+
+	// a row which is not a valid extension does not match its root
+	partial = make([][]byte, len(row))
+	copy(partial[squareSize:], row[squareSize:])
+	partial[squareSize] = append([]byte(nil), row[squareSize]...)
+	partial[squareSize][consts.ShareSize-1]++
+	_, err = repairRow(types.DefaultCodec(), partial, root)
+	assert.Error(t, err)
+}
+
 func flatten(eds *rsmt2d.ExtendedDataSquare) [][]byte {
 	flattenedEDSSize := eds.Width() * eds.Width()
 	out := make([][]byte, flattenedEDSSize)
@@ -24,6 +24,49 @@ func SampleSquare(squareWidth uint32, num int) []Sample {
 	return ss.samples()
 }
 
+// NumSamples returns the smallest number of unique random samples of an
+// extended data square of the given width that detect with at least the given
+// confidence that the square can not be repaired, see Confidence. A confidence
+// of 1 requires sampling every share which may be available.
+func NumSamples(squareWidth uint32, confidence float64) int {
+	total, available := sampledShares(squareWidth)
+	// probability of all samples hitting available shares
+	missed := 1.0
+	for n := 1; n <= total; n++ {
+		missed *= float64(available-n+1) / float64(total-n+1)
+		if 1-missed >= confidence {
+			return n
+		}
+	}
+	return total
+}
+
+// Confidence returns the probability that numSamples unique random samples of
+// an extended data square of the given width detect that the square can not be
+// repaired, i.e. that at least one sample is not available. It assumes the
+// fewest shares are withheld that prevent repairing the square, which is the
+// hardest case to detect.
+func Confidence(squareWidth uint32, numSamples int) float64 {
+	total, available := sampledShares(squareWidth)
+	// probability of all samples hitting available shares
+	missed := 1.0
+	for n := 1; n <= numSamples && n <= total; n++ {
+		missed *= float64(available-n+1) / float64(total-n+1)
+	}
+	return 1 - missed
+}
+
+// sampledShares returns the number of shares of an extended data square of the
+// given width along with the most shares which may be available without the
+// square being repairable. Withholding a quarter of the square plus one row and
+// one column, i.e. (k+1)^2 shares of a square of width 2k, suffices to prevent
+// repairing it.
+func sampledShares(squareWidth uint32) (total, available int) {
+	k := int(squareWidth) / 2
+	total = int(squareWidth) * int(squareWidth)
+	return total, total - (k+1)*(k+1)
+}
+
 // Leaf returns leaf info needed for retrieval using data provided with DAHeader.
 func (s Sample) Leaf(dah *types.DataAvailabilityHeader) (cid.Cid, uint32, error) {
 	var (
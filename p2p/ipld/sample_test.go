@@ -33,3 +33,40 @@ func TestSampleSquare(t *testing.T) {
 		}
 	}
 }
+
+func TestNumSamples(t *testing.T) {
+	tests := []struct {
+		name        string
+		squareWidth uint32
+		confidence  float64
+		expected    int
+	}{
+		// all shares have to be withheld, thus a single sample suffices
+		{"smallest square", 2, 0.9999, 1},
+		// 9 of 16 shares have to be withheld
+		{"single sample", 4, 0.5, 1},
+		{"two samples", 4, 0.8, 2},
+		// sampling all 7 available shares guarantees to hit a withheld one
+		{"full confidence", 4, 1, 8},
+		{"large square", 256, 0.9999, 32},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			n := NumSamples(tt.squareWidth, tt.confidence)
+			assert.Equal(t, tt.expected, n)
+			assert.GreaterOrEqual(t, Confidence(tt.squareWidth, n), tt.confidence)
+			if n > 1 {
+				assert.Less(t, Confidence(tt.squareWidth, n-1), tt.confidence)
+			}
+		})
+	}
+}
+
+func TestConfidence(t *testing.T) {
+	assert.Equal(t, 1.0, Confidence(2, 1))
+	assert.InDelta(t, 9.0/16, Confidence(4, 1), 1e-9)
+	assert.InDelta(t, 1-7.0/16*6.0/15, Confidence(4, 2), 1e-9)
+	assert.Equal(t, 1.0, Confidence(4, 16))
+	assert.Zero(t, Confidence(4, 0))
+}
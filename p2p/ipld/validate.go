@@ -12,30 +12,32 @@ import (
 	"github.com/lazyledger/lazyledger-core/types"
 )
 
-// ValidationTimeout specifies timeout for DA validation during which data have to be found on the network,
-// otherwise ErrValidationFailed is thrown.
-// TODO: github.com/lazyledger/lazyledger-core/issues/280
-const ValidationTimeout = 10 * time.Minute
-
 // ErrValidationFailed is returned whenever DA validation fails
 var ErrValidationFailed = errors.New("validation failed")
 
 // ValidateAvailability randomly samples the block data that composes a provided
-// data availability header. It only returns when all samples have been completed
-// successfully. `onLeafValidity` is called on each sampled leaf after
-// retrieval. Implements the protocol described in
-// https://fc21.ifca.ai/papers/83.pdf.
+// data availability header. It takes as many samples as needed to detect with
+// the given confidence that the block data is unavailable, see NumSamples, and
+// only returns when all samples have been completed successfully or the timeout
+// expired. `onLeafValidity` is called on each sampled leaf after retrieval.
+// Implements the protocol described in https://fc21.ifca.ai/papers/83.pdf.
+//
+// It returns the confidence achieved by the samples, see Confidence, which is
+// at least the given one.
 func ValidateAvailability(
 	ctx context.Context,
 	dag ipld.NodeGetter,
 	dah *types.DataAvailabilityHeader,
-	numSamples int,
+	confidence float64,
+	timeout time.Duration,
 	onLeafValidity func(namespace.PrefixedData8),
-) error {
-	ctx, cancel := context.WithTimeout(ctx, ValidationTimeout)
+	metrics *Metrics,
+) (float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	squareWidth := uint32(len(dah.ColumnRoots))
+	numSamples := NumSamples(squareWidth, confidence)
 	samples := SampleSquare(squareWidth, numSamples)
 
 	type res struct {
@@ -54,7 +56,13 @@ func ValidateAvailability(
 				return
 			}
 
+			start := time.Now()
 			data, err := GetLeafData(ctx, root, leaf, squareWidth, dag)
+			if err != nil {
+				metrics.SampleFailures.Add(1)
+			} else {
+				metrics.SampleLatencySeconds.Observe(time.Since(start).Seconds())
+			}
 			select {
 			case resCh <- res{data: data, err: err}:
 			case <-ctx.Done():
@@ -67,10 +75,10 @@ func ValidateAvailability(
 		case r := <-resCh:
 			if r.err != nil {
 				if errors.Is(r.err, ipld.ErrNotFound) {
-					return ErrValidationFailed
+					return 0, ErrValidationFailed
 				}
 
-				return r.err
+				return 0, r.err
 			}
 
 			// the fact that we read the data, already gives us Merkle proof,
@@ -79,12 +87,12 @@ func ValidateAvailability(
 		case <-ctx.Done():
 			err := ctx.Err()
 			if err == context.DeadlineExceeded {
-				return fmt.Errorf("%v: %w", ErrValidationFailed, err)
+				return 0, fmt.Errorf("%v: %w", ErrValidationFailed, err)
 			}
 
-			return err
+			return 0, err
 		}
 	}
 
-	return nil
+	return Confidence(squareWidth, numSamples), nil
 }
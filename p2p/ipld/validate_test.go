@@ -10,7 +10,6 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
-	"github.com/lazyledger/lazyledger-core/ipfs"
 	"github.com/lazyledger/lazyledger-core/libs/log"
 	"github.com/lazyledger/lazyledger-core/types"
 	"github.com/lazyledger/lazyledger-core/types/consts"
@@ -20,9 +19,9 @@ import (
 
 func TestValidateAvailability(t *testing.T) {
 	const (
-		shares          = 15
-		squareSize      = 8
-		adjustedMsgSize = consts.MsgShareSize - 2
+		targetConfidence = 0.9999
+		squareSize       = 8
+		adjustedMsgSize  = consts.MsgShareSize - 2
 	)
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -36,13 +35,15 @@ func TestValidateAvailability(t *testing.T) {
 	block.Hash()
 
 	dag := mdutils.Mock()
-	err := PutBlock(ctx, dag, block, ipfs.MockRouting(), log.TestingLogger())
+	err := PutBlock(ctx, dag, block, types.DefaultCodec(), NopMetrics(), log.TestingLogger())
 	require.NoError(t, err)
 
 	calls := 0
-	err = ValidateAvailability(ctx, dag, &block.DataAvailabilityHeader, shares, func(data namespace.PrefixedData8) {
-		calls++
-	})
+	confidence, err := ValidateAvailability(ctx, dag, &block.DataAvailabilityHeader, targetConfidence, time.Second,
+		func(data namespace.PrefixedData8) {
+			calls++
+		}, NopMetrics())
 	assert.NoError(t, err)
-	assert.Equal(t, shares, calls)
+	assert.Equal(t, NumSamples(uint32(len(block.DataAvailabilityHeader.RowsRoots)), targetConfidence), calls)
+	assert.GreaterOrEqual(t, confidence, targetConfidence)
 }
@@ -4,36 +4,35 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"sync/atomic"
 	"time"
 
-	"github.com/ipfs/go-cid"
 	ipld "github.com/ipfs/go-ipld-format"
 	"github.com/lazyledger/nmt"
 	"github.com/lazyledger/rsmt2d"
-	"github.com/libp2p/go-libp2p-core/routing"
-	kbucket "github.com/libp2p/go-libp2p-kbucket"
 
-	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
 	"github.com/lazyledger/lazyledger-core/libs/log"
-	"github.com/lazyledger/lazyledger-core/libs/sync"
 	"github.com/lazyledger/lazyledger-core/p2p/ipld/wrapper"
 	"github.com/lazyledger/lazyledger-core/types"
 )
 
 // PutBlock posts and pins erasured block data to IPFS using the provided
-// ipld.NodeAdder. Note: the erasured data is currently recomputed
-// TODO this craves for refactor
+// ipld.NodeAdder. If the block carries the extended data square its
+// DataAvailabilityHeader was computed from, only the NMT nodes are recomputed
+// from it. Otherwise, the erasured data is recomputed with the given codec,
+// which must be the one the block was created with.
+//
+// The roots are not provided to the DHT, see Provider.
 func PutBlock(
 	ctx context.Context,
 	adder ipld.NodeAdder,
 	block *types.Block,
-	croute routing.ContentRouting,
+	codec rsmt2d.Codec,
+	metrics *Metrics,
 	logger log.Logger,
 ) error {
-	// recompute the shares
-	namespacedShares, _ := block.Data.ComputeShares()
-	shares := namespacedShares.RawShares()
+	start := time.Now()
+	// reuse or recompute the shares
+	shares, squareSize := blockShares(block)
 
 	// don't do anything if there is no data to put on IPFS
 	if len(shares) == 0 {
@@ -44,123 +43,52 @@ func PutBlock(
 	batchAdder := NewNmtNodeAdder(ctx, ipld.NewBatch(ctx, adder))
 
 	// create the nmt wrapper to generate row and col commitments
-	squareSize := uint32(math.Sqrt(float64(len(shares))))
 	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), nmt.NodeVisitor(batchAdder.Visit))
 
-	// recompute the eds
-	eds, err := rsmt2d.ComputeExtendedDataSquare(shares, rsmt2d.NewRSGF8Codec(), tree.Constructor)
+	var (
+		eds *rsmt2d.ExtendedDataSquare
+		err error
+	)
+	if len(shares) == int(squareSize*squareSize) {
+		// recompute the eds
+		eds, err = rsmt2d.ComputeExtendedDataSquare(shares, codec, tree.Constructor)
+	} else {
+		// the shares already are erasured, only the trees are to be recomputed
+		eds, err = rsmt2d.ImportExtendedDataSquare(shares, codec, tree.Constructor)
+	}
 	if err != nil {
 		return fmt.Errorf("failure to recompute the extended data square: %w", err)
 	}
-	// get row and col roots to be provided
-	// this also triggers adding data to DAG
-	prov := newProvider(ctx, croute, int32(squareSize*4), logger.With("height", block.Height))
-	for _, root := range eds.RowRoots() {
-		prov.Provide(plugin.MustCidFromNamespacedSha256(root))
-	}
-	for _, root := range eds.ColumnRoots() {
-		prov.Provide(plugin.MustCidFromNamespacedSha256(root))
-	}
+	// computing the row and col roots triggers adding data to DAG
+	eds.RowRoots()
+	eds.ColumnRoots()
 	// commit the batch to ipfs
 	err = batchAdder.Commit()
 	if err != nil {
 		return err
 	}
-	// wait until we provided all the roots if requested
-	<-prov.Done()
-	return prov.Err()
-}
-
-var provideWorkers = 32
-
-type provider struct {
-	ctx  context.Context
-	done chan struct{}
-
-	err   error
-	errLk sync.RWMutex
-
-	jobs  chan cid.Cid
-	total int32
-
-	croute    routing.ContentRouting
-	log       log.Logger
-	startTime time.Time
-}
-
-func newProvider(ctx context.Context, croute routing.ContentRouting, toProvide int32, logger log.Logger) *provider {
-	p := &provider{
-		ctx:    ctx,
-		done:   make(chan struct{}),
-		jobs:   make(chan cid.Cid, provideWorkers),
-		total:  toProvide,
-		croute: croute,
-		log:    logger,
-	}
-	for range make([]bool, provideWorkers) {
-		go p.worker()
-	}
-	logger.Info("Started Providing to DHT")
-	p.startTime = time.Now()
-	return p
+	metrics.PutBlockDurationSeconds.Observe(time.Since(start).Seconds())
+	logger.Debug("Put block data to IPFS", "height", block.Height, "took", time.Since(start))
+	return nil
 }
 
-func (p *provider) Provide(id cid.Cid) {
-	select {
-	case p.jobs <- id:
-	case <-p.ctx.Done():
-	}
-}
-
-func (p *provider) Done() <-chan struct{} {
-	return p.done
-}
-
-func (p *provider) Err() error {
-	p.errLk.RLock()
-	defer p.errLk.RUnlock()
-	if p.err != nil {
-		return p.err
-	}
-	return p.ctx.Err()
-}
-
-func (p *provider) worker() {
-	for {
-		select {
-		case id := <-p.jobs:
-			err := p.croute.Provide(p.ctx, id, true)
-			// Omit ErrLookupFailure to decrease test log spamming as
-			// this simply indicates we haven't connected to other DHT nodes yet.
-			if err != nil && err != kbucket.ErrLookupFailure {
-				if p.Err() == nil {
-					p.errLk.Lock()
-					p.err = err
-					p.errLk.Unlock()
-				}
-
-				p.log.Error("failed to provide to DHT", "err", err.Error())
-			}
-
-			p.provided()
-		case <-p.ctx.Done():
-			for {
-				select {
-				case <-p.jobs: // drain chan
-					p.provided() // ensure done is closed
-				default:
-					return
-				}
-			}
-		case <-p.done:
-			return
+// blockShares returns the shares to be put to IPFS along with the original
+// square size. If the block carries its extended data square, all shares of
+// the extended square are returned, otherwise only the original ones.
+func blockShares(block *types.Block) ([][]byte, uint32) {
+	if eds := block.ExtendedDataSquare(); eds != nil {
+		width := eds.Width()
+		shares := make([][]byte, 0, width*width)
+		for i := uint(0); i < width; i++ {
+			shares = append(shares, eds.Row(i)...)
 		}
+		return shares, uint32(width / 2)
 	}
-}
 
-func (p *provider) provided() {
-	if atomic.AddInt32(&p.total, -1) == 0 {
-		p.log.Info("Finished providing to DHT", "took", time.Since(p.startTime).String())
-		close(p.done)
-	}
+	// the square of the block may be larger than needed for its data, see
+	// DataAvailabilityParams.MinSquareSize
+	minSquareSize := uint32(len(block.DataAvailabilityHeader.RowsRoots) / 2)
+	namespacedShares, _ := block.Data.ComputeSharesForBlockVersion(block.Version.Block, minSquareSize)
+	shares := namespacedShares.RawShares()
+	return shares, uint32(math.Sqrt(float64(len(shares))))
 }
@@ -13,7 +13,6 @@ import (
 
 	abci "github.com/lazyledger/lazyledger-core/abci/types"
 	"github.com/lazyledger/lazyledger-core/crypto/tmhash"
-	"github.com/lazyledger/lazyledger-core/ipfs"
 	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
 	"github.com/lazyledger/lazyledger-core/libs/log"
 	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
@@ -24,9 +23,8 @@ import (
 func TestPutBlock(t *testing.T) {
 	logger := log.TestingLogger()
 	dag := mdutils.Mock()
-	croute := ipfs.MockRouting()
 
-	maxOriginalSquareSize := consts.MaxSquareSize / 2
+	maxOriginalSquareSize := int(types.MaxSquareSize(tmproto.ErasureCodecRSGF8)) / 2
 	maxShareCount := maxOriginalSquareSize * maxOriginalSquareSize
 
 	testCases := []struct {
@@ -47,7 +45,7 @@ func TestPutBlock(t *testing.T) {
 		block := &types.Block{Data: tc.blockData}
 
 		t.Run(tc.name, func(t *testing.T) {
-			err := PutBlock(ctx, dag, block, croute, logger)
+			err := PutBlock(ctx, dag, block, types.DefaultCodec(), NopMetrics(), logger)
 			if tc.expectErr {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), tc.errString)
@@ -77,6 +75,65 @@ func TestPutBlock(t *testing.T) {
 	}
 }
 
+func TestPutBlockReusesExtendedDataSquare(t *testing.T) {
+	const squareSize = 8
+
+	data := generateRandomMsgOnlyData(squareSize * squareSize)
+	eds, _, err := data.ComputeExtendedDataSquare(types.DefaultCodec(), consts.MinSquareSize)
+	require.NoError(t, err)
+	dah, err := types.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	block := &types.Block{Data: data}
+	block.SetExtendedDataSquare(eds)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*2)
+	defer cancel()
+	dag := mdutils.Mock()
+	err = PutBlock(ctx, dag, block, types.DefaultCodec(), NopMetrics(), log.TestingLogger())
+	require.NoError(t, err)
+
+	// the data put from the cached square must be retrievable by the roots
+	// committed to in the header
+	out, err := RetrieveBlockData(ctx, &dah, dag, types.DefaultCodec(), NopMetrics())
+	require.NoError(t, err)
+	shares, _ := data.ComputeShares()
+	outShares, _ := out.ComputeShares()
+	assert.Equal(t, shares.RawShares(), outShares.RawShares())
+}
+
+func BenchmarkPutBlock(b *testing.B) {
+	maxOriginalSquareSize := int(types.MaxSquareSize(tmproto.ErasureCodecRSGF8)) / 2
+	data := generateRandomMsgOnlyData(maxOriginalSquareSize * maxOriginalSquareSize)
+	eds, _, err := data.ComputeExtendedDataSquare(types.DefaultCodec(), consts.MinSquareSize)
+	require.NoError(b, err)
+
+	benchmarks := []struct {
+		name  string
+		block *types.Block
+	}{
+		{"recompute max square size", &types.Block{Data: data}},
+		{"reuse max square size", func() *types.Block {
+			block := &types.Block{Data: data}
+			block.SetExtendedDataSquare(eds)
+			return block
+		}()},
+	}
+
+	ctx := context.Background()
+	logger := log.NewNopLogger()
+	for _, bm := range benchmarks {
+		bm := bm
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				err := PutBlock(ctx, mdutils.Mock(), bm.block, types.DefaultCodec(), NopMetrics(), logger)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
 type preprocessingApp struct {
 	abci.BaseApplication
 }
@@ -111,7 +168,6 @@ func toMessageSlice(msgs [][]byte) []*tmproto.Message {
 func TestDataAvailabilityHeaderRewriteBug(t *testing.T) {
 	logger := log.TestingLogger()
 	dag := mdutils.Mock()
-	croute := ipfs.MockRouting()
 
 	txs := types.Txs{}
 	l := len(txs)
@@ -148,7 +204,7 @@ func TestDataAvailabilityHeaderRewriteBug(t *testing.T) {
 	hash1 := block.DataAvailabilityHeader.Hash()
 
 	ctx := context.TODO()
-	err = PutBlock(ctx, dag, block, croute, logger)
+	err = PutBlock(ctx, dag, block, types.DefaultCodec(), NopMetrics(), logger)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -104,7 +104,7 @@ func (m *NewRoundStep) GetLastCommitRound() int32 {
 }
 
 // NewValidBlock is sent when a validator observes a valid block B in some round r,
-//i.e., there is a Proposal for block B and 2/3+ prevotes for the block B in the round r.
+// i.e., there is a Proposal for block B and 2/3+ prevotes for the block B in the round r.
 // In case the block is also committed, then IsCommit flag is set to true.
 type NewValidBlock struct {
 	Height             int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -349,6 +349,76 @@ func (m *BlockPart) GetPart() types.Part {
 	return types.Part{}
 }
 
+// BlockHeader is sent with a proposal whose block data is retrieved from IPFS
+// by its DataAvailabilityHeader instead of being gossiped in block parts.
+type BlockHeader struct {
+	Height     int64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round      int32         `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Header     types.Header  `protobuf:"bytes,3,opt,name=header,proto3" json:"header"`
+	LastCommit *types.Commit `protobuf:"bytes,4,opt,name=last_commit,json=lastCommit,proto3" json:"last_commit,omitempty"`
+}
+
+func (m *BlockHeader) Reset()         { *m = BlockHeader{} }
+func (m *BlockHeader) String() string { return proto.CompactTextString(m) }
+func (*BlockHeader) ProtoMessage()    {}
+func (*BlockHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{5}
+}
+func (m *BlockHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeader.Merge(m, src)
+}
+func (m *BlockHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockHeader proto.InternalMessageInfo
+
+func (m *BlockHeader) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockHeader) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *BlockHeader) GetHeader() types.Header {
+	if m != nil {
+		return m.Header
+	}
+	return types.Header{}
+}
+
+func (m *BlockHeader) GetLastCommit() *types.Commit {
+	if m != nil {
+		return m.LastCommit
+	}
+	return nil
+}
+
 // Vote is sent when voting for a proposal (or lack thereof).
 type Vote struct {
 	Vote *types.Vote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
@@ -358,7 +428,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81a22d2efc008981, []int{5}
+	return fileDescriptor_81a22d2efc008981, []int{6}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +476,7 @@ func (m *HasVote) Reset()         { *m = HasVote{} }
 func (m *HasVote) String() string { return proto.CompactTextString(m) }
 func (*HasVote) ProtoMessage()    {}
 func (*HasVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81a22d2efc008981, []int{6}
+	return fileDescriptor_81a22d2efc008981, []int{7}
 }
 func (m *HasVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,7 +545,7 @@ func (m *VoteSetMaj23) Reset()         { *m = VoteSetMaj23{} }
 func (m *VoteSetMaj23) String() string { return proto.CompactTextString(m) }
 func (*VoteSetMaj23) ProtoMessage()    {}
 func (*VoteSetMaj23) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81a22d2efc008981, []int{7}
+	return fileDescriptor_81a22d2efc008981, []int{8}
 }
 func (m *VoteSetMaj23) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +615,7 @@ func (m *VoteSetBits) Reset()         { *m = VoteSetBits{} }
 func (m *VoteSetBits) String() string { return proto.CompactTextString(m) }
 func (*VoteSetBits) ProtoMessage()    {}
 func (*VoteSetBits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81a22d2efc008981, []int{8}
+	return fileDescriptor_81a22d2efc008981, []int{9}
 }
 func (m *VoteSetBits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,6 +690,7 @@ type Message struct {
 	//	*Message_HasVote
 	//	*Message_VoteSetMaj23
 	//	*Message_VoteSetBits
+	//	*Message_BlockHeader
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -627,7 +698,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81a22d2efc008981, []int{9}
+	return fileDescriptor_81a22d2efc008981, []int{10}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,6 +760,9 @@ type Message_VoteSetMaj23 struct {
 type Message_VoteSetBits struct {
 	VoteSetBits *VoteSetBits `protobuf:"bytes,9,opt,name=vote_set_bits,json=voteSetBits,proto3,oneof" json:"vote_set_bits,omitempty"`
 }
+type Message_BlockHeader struct {
+	BlockHeader *BlockHeader `protobuf:"bytes,10,opt,name=block_header,json=blockHeader,proto3,oneof" json:"block_header,omitempty"`
+}
 
 func (*Message_NewRoundStep) isMessage_Sum()  {}
 func (*Message_NewValidBlock) isMessage_Sum() {}
@@ -699,6 +773,7 @@ func (*Message_Vote) isMessage_Sum()          {}
 func (*Message_HasVote) isMessage_Sum()       {}
 func (*Message_VoteSetMaj23) isMessage_Sum()  {}
 func (*Message_VoteSetBits) isMessage_Sum()   {}
+func (*Message_BlockHeader) isMessage_Sum()   {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -770,6 +845,13 @@ func (m *Message) GetVoteSetBits() *VoteSetBits {
 	return nil
 }
 
+func (m *Message) GetBlockHeader() *BlockHeader {
+	if x, ok := m.GetSum().(*Message_BlockHeader); ok {
+		return x.BlockHeader
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -782,6 +864,7 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_HasVote)(nil),
 		(*Message_VoteSetMaj23)(nil),
 		(*Message_VoteSetBits)(nil),
+		(*Message_BlockHeader)(nil),
 	}
 }
 
@@ -791,6 +874,7 @@ func init() {
 	proto.RegisterType((*Proposal)(nil), "tendermint.consensus.Proposal")
 	proto.RegisterType((*ProposalPOL)(nil), "tendermint.consensus.ProposalPOL")
 	proto.RegisterType((*BlockPart)(nil), "tendermint.consensus.BlockPart")
+	proto.RegisterType((*BlockHeader)(nil), "tendermint.consensus.BlockHeader")
 	proto.RegisterType((*Vote)(nil), "tendermint.consensus.Vote")
 	proto.RegisterType((*HasVote)(nil), "tendermint.consensus.HasVote")
 	proto.RegisterType((*VoteSetMaj23)(nil), "tendermint.consensus.VoteSetMaj23")
@@ -801,61 +885,64 @@ func init() {
 func init() { proto.RegisterFile("tendermint/consensus/types.proto", fileDescriptor_81a22d2efc008981) }
 
 var fileDescriptor_81a22d2efc008981 = []byte{
-	// 863 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0xb7, 0x59, 0x67, 0x93, 0x7d, 0xde, 0x3f, 0x30, 0xda, 0x56, 0x61, 0x81, 0x6c, 0x30, 0x97,
-	0x15, 0x02, 0x07, 0x65, 0x0f, 0x48, 0x15, 0x12, 0xc5, 0xfc, 0xa9, 0x5b, 0x35, 0x6d, 0xe4, 0x54,
-	0x15, 0xea, 0xc5, 0x72, 0xe2, 0x91, 0x33, 0xd4, 0xf6, 0x58, 0x9e, 0x49, 0x96, 0x70, 0xe4, 0x13,
-	0xf0, 0x01, 0xf8, 0x1a, 0x48, 0x7c, 0x84, 0x1e, 0x7b, 0xe4, 0x54, 0xa1, 0xec, 0x47, 0x40, 0x70,
-	0x46, 0x33, 0x9e, 0x24, 0x13, 0xea, 0x2e, 0xec, 0x05, 0xa9, 0xb7, 0x99, 0xbc, 0xf7, 0x7e, 0xf3,
-	0xde, 0xef, 0xbd, 0xf7, 0x8b, 0xa1, 0xcb, 0x71, 0x1e, 0xe3, 0x32, 0x23, 0x39, 0xef, 0x4d, 0x68,
-	0xce, 0x70, 0xce, 0x66, 0xac, 0xc7, 0x17, 0x05, 0x66, 0x6e, 0x51, 0x52, 0x4e, 0xd1, 0xf1, 0xc6,
-	0xc3, 0x5d, 0x7b, 0x9c, 0x1c, 0x27, 0x34, 0xa1, 0xd2, 0xa1, 0x27, 0x4e, 0x95, 0xef, 0xc9, 0xbb,
-	0x1a, 0x9a, 0xc4, 0xd0, 0x91, 0x4e, 0xf4, 0xb7, 0x52, 0x32, 0x66, 0xbd, 0x31, 0xe1, 0x5b, 0x1e,
-	0xce, 0x2f, 0x26, 0xec, 0x3f, 0xc0, 0x17, 0x01, 0x9d, 0xe5, 0xf1, 0x88, 0xe3, 0x02, 0xdd, 0x84,
-	0xdd, 0x29, 0x26, 0xc9, 0x94, 0xb7, 0xcd, 0xae, 0x79, 0xb6, 0x13, 0xa8, 0x1b, 0x3a, 0x86, 0x46,
-	0x29, 0x9c, 0xda, 0x6f, 0x74, 0xcd, 0xb3, 0x46, 0x50, 0x5d, 0x10, 0x02, 0x8b, 0x71, 0x5c, 0xb4,
-	0x77, 0xba, 0xe6, 0xd9, 0x41, 0x20, 0xcf, 0xe8, 0x53, 0x68, 0x33, 0x3c, 0xa1, 0x79, 0xcc, 0x42,
-	0x46, 0xf2, 0x09, 0x0e, 0x19, 0x8f, 0x4a, 0x1e, 0x72, 0x92, 0xe1, 0xb6, 0x25, 0x31, 0x6f, 0x28,
-	0xfb, 0x48, 0x98, 0x47, 0xc2, 0xfa, 0x88, 0x64, 0x18, 0x7d, 0x08, 0x6f, 0xa5, 0x11, 0xe3, 0xe1,
-	0x84, 0x66, 0x19, 0xe1, 0x61, 0xf5, 0x5c, 0x43, 0x3e, 0x77, 0x24, 0x0c, 0x5f, 0xca, 0xdf, 0x65,
-	0xaa, 0xce, 0x9f, 0x26, 0x1c, 0x3c, 0xc0, 0x17, 0x8f, 0xa3, 0x94, 0xc4, 0x5e, 0x4a, 0x27, 0x4f,
-	0xaf, 0x99, 0xf8, 0xb7, 0x70, 0x63, 0x2c, 0xc2, 0xc2, 0x42, 0xe4, 0xc6, 0x30, 0x0f, 0xa7, 0x38,
-	0x8a, 0x71, 0x29, 0x2b, 0xb1, 0xfb, 0xa7, 0xae, 0xd6, 0x83, 0x8a, 0xaf, 0x61, 0x54, 0xf2, 0x11,
-	0xe6, 0xbe, 0x74, 0xf3, 0xac, 0x67, 0x2f, 0x4e, 0x8d, 0x00, 0x49, 0x8c, 0x2d, 0x0b, 0xfa, 0x1c,
-	0xec, 0x0d, 0x32, 0x93, 0x15, 0xdb, 0xfd, 0x8e, 0x8e, 0x27, 0x3a, 0xe1, 0x8a, 0x4e, 0xb8, 0x1e,
-	0xe1, 0x5f, 0x94, 0x65, 0xb4, 0x08, 0x60, 0x0d, 0xc4, 0xd0, 0x3b, 0xb0, 0x47, 0x98, 0x22, 0x41,
-	0x96, 0xdf, 0x0a, 0x5a, 0x84, 0x55, 0xc5, 0x3b, 0x3e, 0xb4, 0x86, 0x25, 0x2d, 0x28, 0x8b, 0x52,
-	0xf4, 0x19, 0xb4, 0x0a, 0x75, 0x96, 0x35, 0xdb, 0xfd, 0x93, 0x9a, 0xb4, 0x95, 0x87, 0xca, 0x78,
-	0x1d, 0xe1, 0xfc, 0x6c, 0x82, 0xbd, 0x32, 0x0e, 0x1f, 0xde, 0x7f, 0x25, 0x7f, 0x1f, 0x01, 0x5a,
-	0xc5, 0x84, 0x05, 0x4d, 0x43, 0x9d, 0xcc, 0x37, 0x57, 0x96, 0x21, 0x4d, 0x65, 0x5f, 0xd0, 0x1d,
-	0xd8, 0xd7, 0xbd, 0x15, 0x9d, 0xff, 0x52, 0xbe, 0xca, 0xcd, 0xd6, 0xd0, 0x9c, 0xa7, 0xb0, 0xe7,
-	0xad, 0x38, 0xb9, 0x66, 0x6f, 0x3f, 0x01, 0x4b, 0x70, 0xaf, 0xde, 0xbe, 0x59, 0xdf, 0x4a, 0xf5,
-	0xa6, 0xf4, 0x74, 0xfa, 0x60, 0x3d, 0xa6, 0x5c, 0x4c, 0xa0, 0x35, 0xa7, 0x1c, 0x2b, 0x36, 0x6b,
-	0x22, 0x85, 0x57, 0x20, 0x7d, 0x9c, 0x1f, 0x4d, 0x68, 0xfa, 0x11, 0x93, 0x71, 0xd7, 0xcb, 0xef,
-	0x1c, 0x2c, 0x81, 0x26, 0xf3, 0x3b, 0xac, 0x1b, 0xb5, 0x11, 0x49, 0x72, 0x1c, 0x0f, 0x58, 0xf2,
-	0x68, 0x51, 0xe0, 0x40, 0x3a, 0x0b, 0x28, 0x92, 0xc7, 0xf8, 0x7b, 0x39, 0x50, 0x8d, 0xa0, 0xba,
-	0x38, 0xbf, 0x9a, 0xb0, 0x2f, 0x32, 0x18, 0x61, 0x3e, 0x88, 0xbe, 0xeb, 0x9f, 0xff, 0x1f, 0x99,
-	0x7c, 0x0d, 0xad, 0x6a, 0xc0, 0x49, 0xac, 0xa6, 0xfb, 0xed, 0x97, 0x03, 0x65, 0xef, 0xee, 0x7e,
-	0xe5, 0x1d, 0x09, 0x96, 0x97, 0x2f, 0x4e, 0x9b, 0xea, 0x87, 0xa0, 0x29, 0x63, 0xef, 0xc6, 0xce,
-	0x1f, 0x26, 0xd8, 0x2a, 0x75, 0x8f, 0x70, 0xf6, 0xfa, 0x64, 0x8e, 0x6e, 0x41, 0x43, 0x4c, 0x00,
-	0x93, 0xcb, 0xf9, 0x5f, 0x87, 0xbb, 0x0a, 0x71, 0xfe, 0xb2, 0xa0, 0x39, 0xc0, 0x8c, 0x45, 0x09,
-	0x46, 0xf7, 0xe0, 0x30, 0xc7, 0x17, 0xd5, 0x42, 0x85, 0x52, 0x46, 0xab, 0xb9, 0x73, 0xdc, 0xba,
-	0x3f, 0x00, 0x57, 0x97, 0x69, 0xdf, 0x08, 0xf6, 0x73, 0x5d, 0xb6, 0x07, 0x70, 0x24, 0xb0, 0xe6,
-	0x42, 0x0f, 0x43, 0x99, 0xa8, 0xe4, 0xcb, 0xee, 0x7f, 0xf0, 0x4a, 0xb0, 0x8d, 0x76, 0xfa, 0x46,
-	0x70, 0x90, 0x6f, 0x89, 0xa9, 0x2e, 0x2d, 0x35, 0x2b, 0xbc, 0xc1, 0x59, 0x29, 0x88, 0xaf, 0x49,
-	0x0b, 0xfa, 0xe6, 0x1f, 0x22, 0x50, 0x71, 0xfd, 0xfe, 0xd5, 0x08, 0xc3, 0x87, 0xf7, 0xfd, 0x6d,
-	0x0d, 0x40, 0xb7, 0x01, 0x36, 0x52, 0xaa, 0xd8, 0x3e, 0xad, 0x47, 0x59, 0x6b, 0x85, 0x6f, 0x04,
-	0x7b, 0x6b, 0x31, 0x15, 0x52, 0x20, 0x17, 0x7a, 0xf7, 0x65, 0x79, 0xdc, 0xc4, 0x8a, 0x29, 0xf4,
-	0x8d, 0x6a, 0xad, 0xd1, 0x2d, 0x68, 0x4d, 0x23, 0x16, 0xca, 0xa8, 0xa6, 0x8c, 0x7a, 0xaf, 0x3e,
-	0x4a, 0xed, 0xbe, 0x6f, 0x04, 0xcd, 0xa9, 0x92, 0x81, 0x7b, 0x70, 0x28, 0xe2, 0xe4, 0xdf, 0x49,
-	0x26, 0xd6, 0xb1, 0xdd, 0xba, 0xaa, 0xa1, 0xfa, 0xe2, 0x8a, 0x86, 0xce, 0xf5, 0x45, 0xbe, 0x03,
-	0x07, 0x6b, 0x2c, 0x31, 0x4f, 0xed, 0xbd, 0xab, 0x48, 0xd4, 0x16, 0x49, 0x90, 0x38, 0xdf, 0x5c,
-	0xbd, 0x06, 0xec, 0xb0, 0x59, 0xe6, 0x3d, 0x79, 0xb6, 0xec, 0x98, 0xcf, 0x97, 0x1d, 0xf3, 0xf7,
-	0x65, 0xc7, 0xfc, 0xe9, 0xb2, 0x63, 0x3c, 0xbf, 0xec, 0x18, 0xbf, 0x5d, 0x76, 0x8c, 0x27, 0xb7,
-	0x13, 0xc2, 0xa7, 0xb3, 0xb1, 0x3b, 0xa1, 0x59, 0x2f, 0x8d, 0x7e, 0x58, 0xa4, 0x38, 0x4e, 0x70,
-	0xa9, 0x1d, 0x3f, 0x9e, 0xd0, 0x12, 0xf7, 0xaa, 0x4f, 0x8f, 0xba, 0x8f, 0x97, 0xf1, 0xae, 0xb4,
-	0x9d, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x08, 0xab, 0x10, 0x2c, 0xdb, 0x08, 0x00, 0x00,
+	// 909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0xb7, 0xc9, 0x3a, 0xbb, 0x79, 0x4e, 0x1a, 0x18, 0xa5, 0x95, 0x09, 0xb0, 0x09, 0xe6, 0x12,
+	0x21, 0xf0, 0xa2, 0x8d, 0x04, 0xa2, 0x42, 0xa2, 0x98, 0x3f, 0x75, 0x51, 0xd3, 0xae, 0xbc, 0x55,
+	0x85, 0x7a, 0xb1, 0xbc, 0xeb, 0x91, 0x77, 0xa8, 0xed, 0xb1, 0x3c, 0x93, 0x84, 0x70, 0xe4, 0x13,
+	0xf0, 0x01, 0xf8, 0x0c, 0xdc, 0x90, 0x38, 0x71, 0xee, 0xb1, 0x47, 0x4e, 0x11, 0x4a, 0x3e, 0x02,
+	0xe2, 0x8e, 0x66, 0x3c, 0xbb, 0x9e, 0x25, 0x4e, 0xc4, 0x5e, 0x90, 0xb8, 0xcd, 0xf8, 0xbd, 0xf7,
+	0x7b, 0xff, 0x7f, 0x63, 0xd8, 0xe7, 0xb8, 0x48, 0x70, 0x95, 0x93, 0x82, 0x0f, 0xa6, 0xb4, 0x60,
+	0xb8, 0x60, 0xc7, 0x6c, 0xc0, 0xcf, 0x4a, 0xcc, 0xbc, 0xb2, 0xa2, 0x9c, 0xa2, 0x9d, 0x46, 0xc3,
+	0x5b, 0x68, 0xec, 0xee, 0xa4, 0x34, 0xa5, 0x52, 0x61, 0x20, 0x4e, 0xb5, 0xee, 0xee, 0x9b, 0x1a,
+	0x9a, 0xc4, 0xd0, 0x91, 0x76, 0x75, 0x5f, 0x19, 0x99, 0xb0, 0xc1, 0x84, 0xf0, 0x25, 0x0d, 0xf7,
+	0x17, 0x13, 0x36, 0x1f, 0xe1, 0xd3, 0x90, 0x1e, 0x17, 0xc9, 0x98, 0xe3, 0x12, 0xdd, 0x81, 0xf5,
+	0x19, 0x26, 0xe9, 0x8c, 0x3b, 0xe6, 0xbe, 0x79, 0xb0, 0x16, 0xaa, 0x1b, 0xda, 0x01, 0xab, 0x12,
+	0x4a, 0xce, 0x2b, 0xfb, 0xe6, 0x81, 0x15, 0xd6, 0x17, 0x84, 0xa0, 0xc3, 0x38, 0x2e, 0x9d, 0xb5,
+	0x7d, 0xf3, 0x60, 0x2b, 0x94, 0x67, 0xf4, 0x11, 0x38, 0x0c, 0x4f, 0x69, 0x91, 0xb0, 0x88, 0x91,
+	0x62, 0x8a, 0x23, 0xc6, 0xe3, 0x8a, 0x47, 0x9c, 0xe4, 0xd8, 0xe9, 0x48, 0xcc, 0xdb, 0x4a, 0x3e,
+	0x16, 0xe2, 0xb1, 0x90, 0x3e, 0x21, 0x39, 0x46, 0xef, 0xc2, 0x6b, 0x59, 0xcc, 0x78, 0x34, 0xa5,
+	0x79, 0x4e, 0x78, 0x54, 0xbb, 0xb3, 0xa4, 0xbb, 0x6d, 0x21, 0xf8, 0x5c, 0x7e, 0x97, 0xa1, 0xba,
+	0x7f, 0x99, 0xb0, 0xf5, 0x08, 0x9f, 0x3e, 0x8d, 0x33, 0x92, 0xf8, 0x19, 0x9d, 0x3e, 0x5f, 0x31,
+	0xf0, 0x6f, 0xe0, 0xf6, 0x44, 0x98, 0x45, 0xa5, 0x88, 0x8d, 0x61, 0x1e, 0xcd, 0x70, 0x9c, 0xe0,
+	0x4a, 0x66, 0x62, 0x0f, 0xf7, 0x3c, 0xad, 0x07, 0x75, 0xbd, 0x46, 0x71, 0xc5, 0xc7, 0x98, 0x07,
+	0x52, 0xcd, 0xef, 0xbc, 0x38, 0xdf, 0x33, 0x42, 0x24, 0x31, 0x96, 0x24, 0xe8, 0x53, 0xb0, 0x1b,
+	0x64, 0x26, 0x33, 0xb6, 0x87, 0x7d, 0x1d, 0x4f, 0x74, 0xc2, 0x13, 0x9d, 0xf0, 0x7c, 0xc2, 0x3f,
+	0xab, 0xaa, 0xf8, 0x2c, 0x84, 0x05, 0x10, 0x43, 0x6f, 0xc0, 0x06, 0x61, 0xaa, 0x08, 0x32, 0xfd,
+	0x5e, 0xd8, 0x23, 0xac, 0x4e, 0xde, 0x0d, 0xa0, 0x37, 0xaa, 0x68, 0x49, 0x59, 0x9c, 0xa1, 0x4f,
+	0xa0, 0x57, 0xaa, 0xb3, 0xcc, 0xd9, 0x1e, 0xee, 0xb6, 0x84, 0xad, 0x34, 0x54, 0xc4, 0x0b, 0x0b,
+	0xf7, 0x27, 0x13, 0xec, 0xb9, 0x70, 0xf4, 0xf8, 0xe1, 0xb5, 0xf5, 0x7b, 0x0f, 0xd0, 0xdc, 0x26,
+	0x2a, 0x69, 0x16, 0xe9, 0xc5, 0x7c, 0x75, 0x2e, 0x19, 0xd1, 0x4c, 0xf6, 0x05, 0xdd, 0x87, 0x4d,
+	0x5d, 0xdb, 0x59, 0xfb, 0x37, 0xe9, 0xab, 0xd8, 0x6c, 0x0d, 0xcd, 0x7d, 0x0e, 0x1b, 0xfe, 0xbc,
+	0x26, 0x2b, 0xf6, 0xf6, 0x03, 0xe8, 0x88, 0xda, 0x2b, 0xdf, 0x77, 0xda, 0x5b, 0xa9, 0x7c, 0x4a,
+	0x4d, 0xf7, 0x67, 0x13, 0x6c, 0xe9, 0x4d, 0xf5, 0x70, 0x35, 0x7f, 0x1f, 0x0a, 0x6d, 0x6d, 0x78,
+	0x9c, 0xab, 0x1e, 0x97, 0xa6, 0x46, 0x69, 0xa3, 0x8f, 0xc1, 0xd6, 0xe6, 0xdd, 0xe9, 0x5c, 0x67,
+	0xac, 0xe6, 0x1e, 0x9a, 0x1d, 0x70, 0x87, 0xd0, 0x79, 0x4a, 0xb9, 0x58, 0x99, 0xce, 0x09, 0xe5,
+	0xd8, 0x31, 0xaf, 0x4b, 0x55, 0x68, 0x85, 0x52, 0xc7, 0xfd, 0xc1, 0x84, 0x6e, 0x10, 0x33, 0x69,
+	0xb7, 0x5a, 0x82, 0x87, 0xd0, 0x11, 0x68, 0x32, 0xbd, 0x5b, 0x6d, 0xbb, 0x31, 0x26, 0x69, 0x81,
+	0x93, 0x23, 0x96, 0x3e, 0x39, 0x2b, 0x71, 0x28, 0x95, 0x05, 0x14, 0x29, 0x12, 0xfc, 0x9d, 0xcc,
+	0xcb, 0x0a, 0xeb, 0x8b, 0xfb, 0xab, 0x09, 0x9b, 0x22, 0x82, 0x31, 0xe6, 0x47, 0xf1, 0xb7, 0xc3,
+	0xc3, 0xff, 0x22, 0x92, 0x2f, 0xa1, 0x57, 0x6f, 0x24, 0x49, 0x54, 0x91, 0x5f, 0xbf, 0x6a, 0x28,
+	0xdb, 0xff, 0xe0, 0x0b, 0x7f, 0x5b, 0xb4, 0xe8, 0xe2, 0x7c, 0xaf, 0xab, 0x3e, 0x84, 0x5d, 0x69,
+	0xfb, 0x20, 0x71, 0xff, 0x34, 0xc1, 0x56, 0xa1, 0xfb, 0x84, 0xb3, 0xff, 0x4f, 0xe4, 0xe8, 0x2e,
+	0x58, 0x62, 0x02, 0x98, 0x63, 0xad, 0xb0, 0x8d, 0xb5, 0x89, 0xfb, 0x9b, 0x05, 0xdd, 0x23, 0xcc,
+	0x58, 0x9c, 0x62, 0xf4, 0x35, 0xdc, 0x2a, 0xf0, 0x69, 0xcd, 0x00, 0x91, 0xe4, 0xfd, 0x7a, 0xee,
+	0x5c, 0xaf, 0xed, 0xc5, 0xf2, 0xf4, 0x77, 0x25, 0x30, 0xc2, 0xcd, 0x42, 0xbb, 0xa3, 0x23, 0xd8,
+	0x16, 0x58, 0x27, 0x82, 0xc0, 0x23, 0x19, 0xa8, 0xac, 0x97, 0x3d, 0x7c, 0xe7, 0x5a, 0xb0, 0x86,
+	0xec, 0x03, 0x23, 0xdc, 0x2a, 0xf4, 0x0f, 0x4b, 0x5c, 0xd8, 0xc2, 0x39, 0x0d, 0xce, 0x9c, 0xf2,
+	0x02, 0x8d, 0x0b, 0xd1, 0x57, 0xff, 0x60, 0xad, 0xba, 0xd6, 0x6f, 0xdf, 0x8c, 0x30, 0x7a, 0xfc,
+	0x30, 0x58, 0x26, 0x2d, 0x74, 0x0f, 0xa0, 0xe1, 0x7e, 0x55, 0xed, 0xbd, 0x76, 0x94, 0x05, 0xb9,
+	0x05, 0x46, 0xb8, 0xb1, 0x60, 0x7f, 0xc1, 0x5d, 0x72, 0xa1, 0xd7, 0xaf, 0xf2, 0x79, 0x63, 0x2b,
+	0xa6, 0x30, 0x30, 0xea, 0xb5, 0x46, 0x77, 0xa1, 0x37, 0x8b, 0x59, 0x24, 0xad, 0xba, 0xd2, 0xea,
+	0xad, 0x76, 0x2b, 0xb5, 0xfb, 0x81, 0x11, 0x76, 0x67, 0xf5, 0x51, 0x34, 0x54, 0xd8, 0xc9, 0xf7,
+	0x2f, 0x17, 0xeb, 0xe8, 0xf4, 0x6e, 0x6a, 0xa8, 0xbe, 0xb8, 0xa2, 0xa1, 0x27, 0xfa, 0x22, 0xdf,
+	0x87, 0xad, 0x05, 0x96, 0x98, 0x27, 0x67, 0xe3, 0xa6, 0x22, 0x6a, 0x8b, 0x24, 0x8a, 0x78, 0xd2,
+	0x5c, 0x45, 0x33, 0xea, 0x22, 0x2a, 0x52, 0x85, 0x9b, 0x70, 0x34, 0xd6, 0x16, 0x38, 0x93, 0xe6,
+	0xea, 0x5b, 0xb0, 0xc6, 0x8e, 0x73, 0xff, 0xd9, 0x8b, 0x8b, 0xbe, 0xf9, 0xf2, 0xa2, 0x6f, 0xfe,
+	0x71, 0xd1, 0x37, 0x7f, 0xbc, 0xec, 0x1b, 0x2f, 0x2f, 0xfb, 0xc6, 0xef, 0x97, 0x7d, 0xe3, 0xd9,
+	0xbd, 0x94, 0xf0, 0xd9, 0xf1, 0xc4, 0x9b, 0xd2, 0x7c, 0x90, 0xc5, 0xdf, 0x9f, 0x65, 0x38, 0x49,
+	0x71, 0xa5, 0x1d, 0xdf, 0x9f, 0xd2, 0x0a, 0x0f, 0xea, 0x7f, 0xae, 0xb6, 0xbf, 0xb6, 0xc9, 0xba,
+	0x94, 0x1d, 0xfe, 0x3d, 0x00, 0xb9, 0x1f, 0xe2, 0xf8, 0xd4, 0x09, 0x00, 0x00,
 }
 
 func (m *NewRoundStep) Marshal() (dAtA []byte, err error) {
@@ -1090,6 +1177,61 @@ func (m *BlockPart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastCommit != nil {
+		{
+			size, err := m.LastCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1495,6 +1637,27 @@ func (m *Message_VoteSetBits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_BlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_BlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlockHeader != nil {
+		{
+			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1599,6 +1762,27 @@ func (m *BlockPart) Size() (n int) {
 	return n
 }
 
+func (m *BlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	l = m.Header.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.LastCommit != nil {
+		l = m.LastCommit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1795,6 +1979,18 @@ func (m *Message_VoteSetBits) Size() (n int) {
 	}
 	return n
 }
+func (m *Message_BlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		l = m.BlockHeader.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -2464,6 +2660,166 @@ func (m *BlockPart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCommit == nil {
+				m.LastCommit = &types.Commit{}
+			}
+			if err := m.LastCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3345,6 +3701,41 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_VoteSetBits{v}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlockHeader{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_BlockHeader{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
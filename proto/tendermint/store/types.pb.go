@@ -25,6 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type BlockStoreState struct {
 	Base   int64 `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty"`
 	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// da_base is the lowest height whose block data may still be stored in the
+	// IPFS repo, which can be lower than base if the data is retained longer
+	// than the blocks.
+	DaBase int64 `protobuf:"varint,3,opt,name=da_base,json=daBase,proto3" json:"da_base,omitempty"`
 }
 
 func (m *BlockStoreState) Reset()         { *m = BlockStoreState{} }
@@ -74,6 +78,13 @@ func (m *BlockStoreState) GetHeight() int64 {
 	return 0
 }
 
+func (m *BlockStoreState) GetDaBase() int64 {
+	if m != nil {
+		return m.DaBase
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BlockStoreState)(nil), "tendermint.store.BlockStoreState")
 }
@@ -81,19 +92,19 @@ func init() {
 func init() { proto.RegisterFile("tendermint/store/types.proto", fileDescriptor_ff9e53a0a74267f7) }
 
 var fileDescriptor_ff9e53a0a74267f7 = []byte{
-	// 177 bytes of a gzipped FileDescriptorProto
+	// 192 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x49, 0xcd, 0x4b,
 	0x49, 0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x2e, 0xc9, 0x2f, 0x4a, 0xd5, 0x2f, 0xa9, 0x2c,
 	0x48, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x40, 0xc8, 0xea, 0x81, 0x65, 0x95,
-	0x6c, 0xb9, 0xf8, 0x9d, 0x72, 0xf2, 0x93, 0xb3, 0x83, 0x41, 0xbc, 0xe0, 0x92, 0xc4, 0x92, 0x54,
+	0xc2, 0xb8, 0xf8, 0x9d, 0x72, 0xf2, 0x93, 0xb3, 0x83, 0x41, 0xbc, 0xe0, 0x92, 0xc4, 0x92, 0x54,
 	0x21, 0x21, 0x2e, 0x96, 0xa4, 0xc4, 0xe2, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xe6, 0x20, 0x30,
 	0x5b, 0x48, 0x8c, 0x8b, 0x2d, 0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44, 0x82, 0x09, 0x2c, 0x0a, 0xe5,
-	0x39, 0x85, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x4d, 0x7a, 0x66,
-	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x4e, 0x62, 0x55, 0x65, 0x4e, 0x6a, 0x4a,
-	0x7a, 0x6a, 0x11, 0x12, 0x53, 0x37, 0x19, 0xe4, 0x3a, 0xb0, 0xbb, 0xf4, 0xd1, 0x1d, 0x9d, 0xc4,
-	0x06, 0x16, 0x37, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x64, 0xea, 0x7b, 0xcf, 0x00, 0x00,
-	0x00,
+	0x09, 0x89, 0x73, 0xb1, 0xa7, 0x24, 0xc6, 0x83, 0x95, 0x33, 0x43, 0x24, 0x52, 0x12, 0x9d, 0x12,
+	0x8b, 0x53, 0x9d, 0xc2, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
+	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x26,
+	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x27, 0xb1, 0xaa, 0x32, 0x27,
+	0x35, 0x25, 0x3d, 0xb5, 0x08, 0x89, 0xa9, 0x9b, 0x0c, 0x72, 0x36, 0xd8, 0xc1, 0xfa, 0xe8, 0xbe,
+	0x49, 0x62, 0x03, 0x8b, 0x1b, 0x03, 0x06, 0x00, 0x8c, 0xc6, 0x6a, 0x4e, 0xe8, 0x00, 0x00, 0x00,
 }
 
 func (m *BlockStoreState) Marshal() (dAtA []byte, err error) {
@@ -116,6 +127,11 @@ func (m *BlockStoreState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DaBase != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DaBase))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Height != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
 		i--
@@ -152,6 +168,9 @@ func (m *BlockStoreState) Size() (n int) {
 	if m.Height != 0 {
 		n += 1 + sovTypes(uint64(m.Height))
 	}
+	if m.DaBase != 0 {
+		n += 1 + sovTypes(uint64(m.DaBase))
+	}
 	return n
 }
 
@@ -228,6 +247,25 @@ func (m *BlockStoreState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaBase", wireType)
+			}
+			m.DaBase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaBase |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -27,13 +27,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ErasureCodec is the erasure code used to extend the block data into the
+// extended data square.
+type ErasureCodec int32
+
+const (
+	ErasureCodecRSGF8       ErasureCodec = 0
+	ErasureCodecLeopardFF16 ErasureCodec = 1
+)
+
+var ErasureCodec_name = map[int32]string{
+	0: "ERASURE_CODEC_RS_GF8",
+	1: "ERASURE_CODEC_LEOPARD_FF16",
+}
+
+var ErasureCodec_value = map[string]int32{
+	"ERASURE_CODEC_RS_GF8":       0,
+	"ERASURE_CODEC_LEOPARD_FF16": 1,
+}
+
+func (x ErasureCodec) String() string {
+	return proto.EnumName(ErasureCodec_name, int32(x))
+}
+
+func (ErasureCodec) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{0}
+}
+
 // ConsensusParams contains consensus critical parameters that determine the
 // validity of blocks.
 type ConsensusParams struct {
-	Block     BlockParams     `protobuf:"bytes,1,opt,name=block,proto3" json:"block"`
-	Evidence  EvidenceParams  `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence"`
-	Validator ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator"`
-	Version   VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version"`
+	Block            BlockParams            `protobuf:"bytes,1,opt,name=block,proto3" json:"block"`
+	Evidence         EvidenceParams         `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence"`
+	Validator        ValidatorParams        `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator"`
+	Version          VersionParams          `protobuf:"bytes,4,opt,name=version,proto3" json:"version"`
+	DataAvailability DataAvailabilityParams `protobuf:"bytes,5,opt,name=data_availability,json=dataAvailability,proto3" json:"data_availability"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
@@ -97,6 +125,13 @@ func (m *ConsensusParams) GetVersion() VersionParams {
 	return VersionParams{}
 }
 
+func (m *ConsensusParams) GetDataAvailability() DataAvailabilityParams {
+	if m != nil {
+		return m.DataAvailability
+	}
+	return DataAvailabilityParams{}
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Max block size, in bytes.
@@ -330,6 +365,75 @@ func (m *VersionParams) GetAppVersion() uint64 {
 	return 0
 }
 
+// DataAvailabilityParams contains the parameters of the data availability
+// scheme.
+type DataAvailabilityParams struct {
+	// Erasure codec used to compute the extended data square.
+	//
+	// Not exposed to the application as it is fixed at genesis.
+	Codec ErasureCodec `protobuf:"varint,1,opt,name=codec,proto3,enum=tendermint.types.ErasureCodec" json:"codec,omitempty"`
+	// Max width of the original data square.
+	// Note: must be a power of 2 supported by the erasure codec
+	MaxSquareSize uint32 `protobuf:"varint,2,opt,name=max_square_size,json=maxSquareSize,proto3" json:"max_square_size,omitempty"`
+	// Min width of the original data square. Blocks with less data are padded.
+	// Note: must be a power of 2 not greater than max_square_size
+	MinSquareSize uint32 `protobuf:"varint,3,opt,name=min_square_size,json=minSquareSize,proto3" json:"min_square_size,omitempty"`
+}
+
+func (m *DataAvailabilityParams) Reset()         { *m = DataAvailabilityParams{} }
+func (m *DataAvailabilityParams) String() string { return proto.CompactTextString(m) }
+func (*DataAvailabilityParams) ProtoMessage()    {}
+func (*DataAvailabilityParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{5}
+}
+func (m *DataAvailabilityParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataAvailabilityParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataAvailabilityParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataAvailabilityParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataAvailabilityParams.Merge(m, src)
+}
+func (m *DataAvailabilityParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataAvailabilityParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataAvailabilityParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataAvailabilityParams proto.InternalMessageInfo
+
+func (m *DataAvailabilityParams) GetCodec() ErasureCodec {
+	if m != nil {
+		return m.Codec
+	}
+	return ErasureCodecRSGF8
+}
+
+func (m *DataAvailabilityParams) GetMaxSquareSize() uint32 {
+	if m != nil {
+		return m.MaxSquareSize
+	}
+	return 0
+}
+
+func (m *DataAvailabilityParams) GetMinSquareSize() uint32 {
+	if m != nil {
+		return m.MinSquareSize
+	}
+	return 0
+}
+
 // HashedParams is a subset of ConsensusParams.
 //
 // It is hashed into the Header.ConsensusHash.
@@ -342,7 +446,7 @@ func (m *HashedParams) Reset()         { *m = HashedParams{} }
 func (m *HashedParams) String() string { return proto.CompactTextString(m) }
 func (*HashedParams) ProtoMessage()    {}
 func (*HashedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e12598271a686f57, []int{5}
+	return fileDescriptor_e12598271a686f57, []int{6}
 }
 func (m *HashedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,53 +490,67 @@ func (m *HashedParams) GetBlockMaxGas() int64 {
 }
 
 func init() {
+	proto.RegisterEnum("tendermint.types.ErasureCodec", ErasureCodec_name, ErasureCodec_value)
 	proto.RegisterType((*ConsensusParams)(nil), "tendermint.types.ConsensusParams")
 	proto.RegisterType((*BlockParams)(nil), "tendermint.types.BlockParams")
 	proto.RegisterType((*EvidenceParams)(nil), "tendermint.types.EvidenceParams")
 	proto.RegisterType((*ValidatorParams)(nil), "tendermint.types.ValidatorParams")
 	proto.RegisterType((*VersionParams)(nil), "tendermint.types.VersionParams")
+	proto.RegisterType((*DataAvailabilityParams)(nil), "tendermint.types.DataAvailabilityParams")
 	proto.RegisterType((*HashedParams)(nil), "tendermint.types.HashedParams")
 }
 
 func init() { proto.RegisterFile("tendermint/types/params.proto", fileDescriptor_e12598271a686f57) }
 
 var fileDescriptor_e12598271a686f57 = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x18, 0xcc, 0xd6, 0xf9, 0xdb, 0xe4, 0x4b, 0xd3, 0x54, 0xab, 0x5f, 0x22, 0x14, 0xd5, 0x0e, 0x3e,
-	0xa0, 0x4a, 0x08, 0x5b, 0x82, 0x13, 0x15, 0x52, 0x85, 0xa1, 0x2a, 0x08, 0x05, 0x21, 0x0b, 0x71,
-	0xc8, 0xc5, 0x5a, 0xc7, 0x8b, 0x6b, 0x35, 0xeb, 0xb5, 0xbc, 0xeb, 0x28, 0xe1, 0x29, 0x38, 0xf6,
-	0xd8, 0x23, 0xbc, 0x01, 0x8f, 0xd0, 0x63, 0x8f, 0x9c, 0x00, 0x25, 0x17, 0x1e, 0x03, 0x79, 0x6d,
-	0x93, 0x38, 0xe5, 0xe6, 0xfd, 0xbe, 0x99, 0x59, 0xcf, 0x8c, 0x16, 0x0e, 0x25, 0x8d, 0x03, 0x9a,
-	0xb2, 0x28, 0x96, 0xb6, 0x9c, 0x27, 0x54, 0xd8, 0x09, 0x49, 0x09, 0x13, 0x56, 0x92, 0x72, 0xc9,
-	0xf1, 0xfe, 0x6a, 0x6d, 0xa9, 0xf5, 0xc1, 0xff, 0x21, 0x0f, 0xb9, 0x5a, 0xda, 0xf9, 0x57, 0x81,
-	0x3b, 0xd0, 0x43, 0xce, 0xc3, 0x09, 0xb5, 0xd5, 0xc9, 0xcf, 0x3e, 0xda, 0x41, 0x96, 0x12, 0x19,
-	0xf1, 0xb8, 0xd8, 0x9b, 0x97, 0x5b, 0xd0, 0x7b, 0xc1, 0x63, 0x41, 0x63, 0x91, 0x89, 0x77, 0xea,
-	0x06, 0xfc, 0x14, 0xfe, 0xf3, 0x27, 0x7c, 0x7c, 0xd1, 0x47, 0x03, 0x74, 0xd4, 0x79, 0x7c, 0x68,
-	0x6d, 0xde, 0x65, 0x39, 0xf9, 0xba, 0x40, 0x3b, 0xcd, 0xeb, 0x1f, 0x46, 0xc3, 0x2d, 0x18, 0xd8,
-	0x81, 0x16, 0x9d, 0x46, 0x01, 0x8d, 0xc7, 0xb4, 0xbf, 0xa5, 0xd8, 0x83, 0xdb, 0xec, 0xd3, 0x12,
-	0x51, 0x13, 0xf8, 0xcb, 0xc3, 0xa7, 0xd0, 0x9e, 0x92, 0x49, 0x14, 0x10, 0xc9, 0xd3, 0xbe, 0xa6,
-	0x44, 0xee, 0xdf, 0x16, 0xf9, 0x50, 0x41, 0x6a, 0x2a, 0x2b, 0x26, 0x3e, 0x81, 0x9d, 0x29, 0x4d,
-	0x45, 0xc4, 0xe3, 0x7e, 0x53, 0x89, 0x18, 0xff, 0x10, 0x29, 0x00, 0x35, 0x89, 0x8a, 0x65, 0x52,
-	0xe8, 0xac, 0xf9, 0xc4, 0xf7, 0xa0, 0xcd, 0xc8, 0xcc, 0xf3, 0xe7, 0x92, 0x0a, 0x95, 0x8c, 0xe6,
-	0xb6, 0x18, 0x99, 0x39, 0xf9, 0x19, 0xdf, 0x81, 0x9d, 0x7c, 0x19, 0x12, 0xa1, 0x6c, 0x6b, 0xee,
-	0x36, 0x23, 0xb3, 0x33, 0x22, 0xf0, 0x00, 0x76, 0x65, 0xc4, 0xa8, 0x17, 0x71, 0x49, 0x3c, 0x26,
-	0x94, 0x1f, 0xcd, 0x85, 0x7c, 0xf6, 0x9a, 0x4b, 0x32, 0x14, 0xe6, 0x57, 0x04, 0x7b, 0xf5, 0x44,
-	0xf0, 0x43, 0xc0, 0xb9, 0x1a, 0x09, 0xa9, 0x17, 0x67, 0xcc, 0x53, 0xd1, 0x56, 0x77, 0xf6, 0x18,
-	0x99, 0x3d, 0x0f, 0xe9, 0xdb, 0x8c, 0xa9, 0x9f, 0x13, 0x78, 0x08, 0xfb, 0x15, 0xb8, 0xea, 0xb6,
-	0x8c, 0xfe, 0xae, 0x55, 0x94, 0x6f, 0x55, 0xe5, 0x5b, 0x2f, 0x4b, 0x80, 0xd3, 0xca, 0xad, 0x5e,
-	0xfe, 0x34, 0x90, 0xbb, 0x57, 0xe8, 0x55, 0x9b, 0xba, 0x4d, 0xad, 0x6e, 0xd3, 0x3c, 0x81, 0xde,
-	0x46, 0xee, 0xd8, 0x84, 0x6e, 0x92, 0xf9, 0xde, 0x05, 0x9d, 0x7b, 0x2a, 0xd3, 0x3e, 0x1a, 0x68,
-	0x47, 0x6d, 0xb7, 0x93, 0x64, 0xfe, 0x1b, 0x3a, 0x7f, 0x9f, 0x8f, 0x8e, 0x5b, 0xdf, 0xae, 0x0c,
-	0xf4, 0xfb, 0xca, 0x40, 0xe6, 0x31, 0x74, 0x6b, 0x99, 0x63, 0x03, 0x3a, 0x24, 0x49, 0xbc, 0xaa,
-	0xa9, 0xdc, 0x63, 0xd3, 0x05, 0x92, 0x24, 0x25, 0x6c, 0x8d, 0x3b, 0x82, 0xdd, 0x57, 0x44, 0x9c,
-	0xd3, 0xa0, 0xa4, 0x3e, 0x80, 0x9e, 0x4a, 0xc6, 0xdb, 0xac, 0xa5, 0xab, 0xc6, 0xc3, 0xaa, 0x1b,
-	0x13, 0xba, 0x2b, 0xdc, 0xaa, 0xa1, 0x4e, 0x85, 0x3a, 0x23, 0xc2, 0x19, 0x7d, 0x59, 0xe8, 0xe8,
-	0x7a, 0xa1, 0xa3, 0x9b, 0x85, 0x8e, 0x7e, 0x2d, 0x74, 0xf4, 0x79, 0xa9, 0x37, 0x6e, 0x96, 0x7a,
-	0xe3, 0xfb, 0x52, 0x6f, 0x8c, 0x9e, 0x85, 0x91, 0x3c, 0xcf, 0x7c, 0x6b, 0xcc, 0x99, 0x3d, 0x21,
-	0x9f, 0xe6, 0x13, 0x1a, 0x84, 0x34, 0x5d, 0xfb, 0x7c, 0x34, 0xe6, 0x69, 0xf9, 0xc6, 0xec, 0xcd,
-	0x77, 0xeb, 0x6f, 0xab, 0xf9, 0x93, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x28, 0xc6, 0x94,
-	0xd2, 0x03, 0x00, 0x00,
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0xc7, 0xe3, 0xa6, 0x2f, 0xe9, 0xa5, 0x69, 0xd2, 0x53, 0xa1, 0x21, 0x55, 0x9d, 0x90, 0xa1,
+	0xaa, 0x40, 0xd8, 0xa2, 0x20, 0x54, 0x0a, 0x52, 0x95, 0x77, 0x10, 0x2d, 0xad, 0x1c, 0x60, 0x28,
+	0xc3, 0xe9, 0x1c, 0x1f, 0xae, 0xd5, 0xd8, 0x67, 0x7c, 0x76, 0x94, 0xf4, 0x03, 0x20, 0xd4, 0x89,
+	0x91, 0xa5, 0x52, 0x25, 0x18, 0xca, 0x37, 0xe0, 0x23, 0x74, 0xec, 0xc8, 0x04, 0x28, 0x5d, 0xf8,
+	0x02, 0xec, 0xc8, 0xe7, 0x98, 0xc4, 0x69, 0x37, 0xfb, 0x79, 0x7e, 0xff, 0xff, 0xdd, 0xf3, 0x62,
+	0x83, 0x15, 0x97, 0x58, 0x1a, 0x71, 0x4c, 0xc3, 0x72, 0x65, 0xb7, 0x67, 0x13, 0x26, 0xdb, 0xd8,
+	0xc1, 0x26, 0x93, 0x6c, 0x87, 0xba, 0x14, 0x66, 0x86, 0x69, 0x89, 0xa7, 0x73, 0x8b, 0x3a, 0xd5,
+	0x29, 0x4f, 0xca, 0xfe, 0x53, 0xc0, 0xe5, 0x44, 0x9d, 0x52, 0xbd, 0x4d, 0x64, 0xfe, 0xa6, 0x7a,
+	0xef, 0x64, 0xcd, 0x73, 0xb0, 0x6b, 0x50, 0x2b, 0xc8, 0x17, 0xff, 0x4e, 0x80, 0x74, 0x85, 0x5a,
+	0x8c, 0x58, 0xcc, 0x63, 0x7b, 0xfc, 0x04, 0xf8, 0x18, 0x4c, 0xa9, 0x6d, 0xda, 0x3a, 0xcc, 0x0a,
+	0x05, 0x61, 0x2d, 0xb9, 0xbe, 0x22, 0x8d, 0x9f, 0x25, 0x95, 0xfd, 0x74, 0x40, 0x97, 0x27, 0xcf,
+	0x7f, 0xe6, 0x63, 0x4a, 0xa0, 0x80, 0x65, 0x90, 0x20, 0x1d, 0x43, 0x23, 0x56, 0x8b, 0x64, 0x27,
+	0xb8, 0xba, 0x70, 0x55, 0x5d, 0x1b, 0x10, 0x11, 0x83, 0xff, 0x3a, 0x58, 0x03, 0xb3, 0x1d, 0xdc,
+	0x36, 0x34, 0xec, 0x52, 0x27, 0x1b, 0xe7, 0x26, 0xb7, 0xaf, 0x9a, 0xbc, 0x09, 0x91, 0x88, 0xcb,
+	0x50, 0x09, 0xb7, 0xc0, 0x4c, 0x87, 0x38, 0xcc, 0xa0, 0x56, 0x76, 0x92, 0x9b, 0xe4, 0xaf, 0x31,
+	0x09, 0x80, 0x88, 0x45, 0xa8, 0x82, 0x6f, 0xc1, 0x82, 0x86, 0x5d, 0x8c, 0x70, 0x07, 0x1b, 0x6d,
+	0xac, 0x1a, 0x6d, 0xc3, 0xed, 0x65, 0xa7, 0xb8, 0xd5, 0xda, 0x55, 0xab, 0x2a, 0x76, 0x71, 0x69,
+	0x84, 0x8c, 0x78, 0x66, 0xb4, 0xb1, 0x6c, 0x91, 0x80, 0xe4, 0x48, 0x13, 0xe1, 0x32, 0x98, 0x35,
+	0x71, 0x17, 0xa9, 0x3d, 0x97, 0x30, 0xde, 0xf6, 0xb8, 0x92, 0x30, 0x71, 0xb7, 0xec, 0xbf, 0xc3,
+	0x25, 0x30, 0xe3, 0x27, 0x75, 0xcc, 0x78, 0x4f, 0xe3, 0xca, 0xb4, 0x89, 0xbb, 0x0d, 0xcc, 0x60,
+	0x01, 0xcc, 0xb9, 0x86, 0x49, 0x90, 0x41, 0x5d, 0x8c, 0x4c, 0xc6, 0x9b, 0x15, 0x57, 0x80, 0x1f,
+	0x7b, 0x4e, 0x5d, 0xbc, 0xc3, 0x8a, 0xdf, 0x04, 0x30, 0x1f, 0x6d, 0x37, 0xbc, 0x0b, 0xa0, 0xef,
+	0x86, 0x75, 0x82, 0x2c, 0xcf, 0x44, 0x7c, 0x6e, 0xe1, 0x99, 0x69, 0x13, 0x77, 0x4b, 0x3a, 0x79,
+	0xe9, 0x99, 0xfc, 0x72, 0x0c, 0xee, 0x80, 0x4c, 0x08, 0x87, 0x8b, 0x33, 0x98, 0xeb, 0x2d, 0x29,
+	0xd8, 0x2c, 0x29, 0xdc, 0x2c, 0xa9, 0x3a, 0x00, 0xca, 0x09, 0xbf, 0xe6, 0xcf, 0xbf, 0xf2, 0x82,
+	0x32, 0x1f, 0xf8, 0x85, 0x99, 0x68, 0x99, 0xf1, 0x68, 0x99, 0xc5, 0x2d, 0x90, 0x1e, 0x1b, 0x2a,
+	0x2c, 0x82, 0x94, 0xed, 0xa9, 0xe8, 0x90, 0xf4, 0x10, 0xef, 0x72, 0x56, 0x28, 0xc4, 0xd7, 0x66,
+	0x95, 0xa4, 0xed, 0xa9, 0x2f, 0x48, 0xef, 0x95, 0x1f, 0xda, 0x4c, 0x7c, 0x3f, 0xcd, 0x0b, 0x7f,
+	0x4e, 0xf3, 0x42, 0x71, 0x13, 0xa4, 0x22, 0x03, 0x85, 0x79, 0x90, 0xc4, 0xb6, 0x8d, 0xc2, 0x35,
+	0xf0, 0x6b, 0x9c, 0x54, 0x00, 0xb6, 0xed, 0x01, 0x36, 0xa2, 0x3d, 0x13, 0xc0, 0xcd, 0xeb, 0x47,
+	0x08, 0x1f, 0x82, 0xa9, 0x16, 0xd5, 0x48, 0x8b, 0xeb, 0xe7, 0xd7, 0xc5, 0x6b, 0x16, 0xda, 0xc1,
+	0xcc, 0x73, 0x48, 0xc5, 0xa7, 0x94, 0x00, 0x86, 0xab, 0xc0, 0x6f, 0x26, 0x62, 0xef, 0x3d, 0xec,
+	0x10, 0xc4, 0x8c, 0xa3, 0xe0, 0x83, 0x48, 0x29, 0x29, 0x13, 0x77, 0x9b, 0x3c, 0xda, 0x34, 0x8e,
+	0x08, 0xe7, 0x0c, 0x2b, 0xc2, 0xc5, 0x07, 0x9c, 0x61, 0x0d, 0xb9, 0x91, 0xab, 0xee, 0x83, 0xb9,
+	0x67, 0x98, 0x1d, 0x10, 0x6d, 0x70, 0xbf, 0x55, 0x90, 0xe6, 0x43, 0x44, 0xe3, 0x1b, 0x94, 0xe2,
+	0xe1, 0x9d, 0x70, 0x8d, 0x8a, 0x20, 0x35, 0xe4, 0x86, 0xcb, 0x94, 0x0c, 0xa9, 0x06, 0x66, 0x77,
+	0x3e, 0x08, 0x60, 0x6e, 0xb4, 0x1a, 0x28, 0x83, 0xc5, 0x9a, 0x52, 0x6a, 0xbe, 0x56, 0x6a, 0xa8,
+	0xb2, 0x5b, 0xad, 0x55, 0x90, 0xd2, 0x44, 0x8d, 0xfa, 0x46, 0x26, 0x96, 0xbb, 0x71, 0x7c, 0x52,
+	0x58, 0x88, 0x54, 0xde, 0x6c, 0xd4, 0x37, 0xe0, 0x13, 0x90, 0x8b, 0x0a, 0xb6, 0x6b, 0xbb, 0x7b,
+	0x25, 0xa5, 0x8a, 0xea, 0xf5, 0xfb, 0x8f, 0x32, 0x42, 0x6e, 0xf9, 0xf8, 0xa4, 0xb0, 0x34, 0x2a,
+	0xdb, 0x26, 0xd4, 0xc6, 0x8e, 0xe6, 0xa7, 0x73, 0x89, 0x8f, 0x5f, 0xc4, 0xd8, 0xd9, 0x57, 0x51,
+	0x28, 0xef, 0x9f, 0xf5, 0x45, 0xe1, 0xbc, 0x2f, 0x0a, 0x17, 0x7d, 0x51, 0xf8, 0xdd, 0x17, 0x85,
+	0x4f, 0x97, 0x62, 0xec, 0xe2, 0x52, 0x8c, 0xfd, 0xb8, 0x14, 0x63, 0xfb, 0x4f, 0x75, 0xc3, 0x3d,
+	0xf0, 0x54, 0xa9, 0x45, 0x4d, 0xb9, 0x8d, 0x8f, 0x7a, 0x6d, 0xa2, 0xe9, 0xc4, 0x19, 0x79, 0xbc,
+	0xd7, 0xa2, 0xce, 0xe0, 0xa7, 0x27, 0x8f, 0xff, 0x48, 0xd5, 0x69, 0x1e, 0x7f, 0xf0, 0x6f, 0x00,
+	0xf5, 0xd4, 0x12, 0x99, 0x63, 0x05, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -466,6 +584,9 @@ func (this *ConsensusParams) Equal(that interface{}) bool {
 	if !this.Version.Equal(&that1.Version) {
 		return false
 	}
+	if !this.DataAvailability.Equal(&that1.DataAvailability) {
+		return false
+	}
 	return true
 }
 func (this *BlockParams) Equal(that interface{}) bool {
@@ -581,6 +702,36 @@ func (this *VersionParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DataAvailabilityParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataAvailabilityParams)
+	if !ok {
+		that2, ok := that.(DataAvailabilityParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Codec != that1.Codec {
+		return false
+	}
+	if this.MaxSquareSize != that1.MaxSquareSize {
+		return false
+	}
+	if this.MinSquareSize != that1.MinSquareSize {
+		return false
+	}
+	return true
+}
 func (this *HashedParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -628,6 +779,16 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.DataAvailability.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -734,12 +895,12 @@ func (m *EvidenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
 	if m.MaxAgeNumBlocks != 0 {
@@ -810,6 +971,44 @@ func (m *VersionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DataAvailabilityParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataAvailabilityParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataAvailabilityParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinSquareSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinSquareSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxSquareSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSquareSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Codec != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Codec))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HashedParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -874,6 +1073,16 @@ func NewPopulatedVersionParams(r randyParams, easy bool) *VersionParams {
 	return this
 }
 
+func NewPopulatedDataAvailabilityParams(r randyParams, easy bool) *DataAvailabilityParams {
+	this := &DataAvailabilityParams{}
+	this.Codec = ErasureCodec([]int32{0, 1}[r.Intn(2)])
+	this.MaxSquareSize = uint32(r.Uint32())
+	this.MinSquareSize = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyParams interface {
 	Float32() float32
 	Float64() float64
@@ -960,6 +1169,8 @@ func (m *ConsensusParams) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.Version.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.DataAvailability.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -1025,6 +1236,24 @@ func (m *VersionParams) Size() (n int) {
 	return n
 }
 
+func (m *DataAvailabilityParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Codec != 0 {
+		n += 1 + sovParams(uint64(m.Codec))
+	}
+	if m.MaxSquareSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxSquareSize))
+	}
+	if m.MinSquareSize != 0 {
+		n += 1 + sovParams(uint64(m.MinSquareSize))
+	}
+	return n
+}
+
 func (m *HashedParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1207,6 +1436,39 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAvailability", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DataAvailability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1622,6 +1884,116 @@ func (m *VersionParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DataAvailabilityParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataAvailabilityParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataAvailabilityParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+			}
+			m.Codec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Codec |= ErasureCodec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSquareSize", wireType)
+			}
+			m.MaxSquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSquareSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSquareSize", wireType)
+			}
+			m.MinSquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSquareSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HashedParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
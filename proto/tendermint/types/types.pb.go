@@ -499,6 +499,7 @@ type Evidence struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Evidence_DuplicateVoteEvidence
 	//	*Evidence_LightClientAttackEvidence
+	//	*Evidence_BadEncodingFraudProof
 	Sum isEvidence_Sum `protobuf_oneof:"sum"`
 }
 
@@ -547,9 +548,13 @@ type Evidence_DuplicateVoteEvidence struct {
 type Evidence_LightClientAttackEvidence struct {
 	LightClientAttackEvidence *LightClientAttackEvidence `protobuf:"bytes,2,opt,name=light_client_attack_evidence,json=lightClientAttackEvidence,proto3,oneof" json:"light_client_attack_evidence,omitempty"`
 }
+type Evidence_BadEncodingFraudProof struct {
+	BadEncodingFraudProof *BadEncodingFraudProof `protobuf:"bytes,3,opt,name=bad_encoding_fraud_proof,json=badEncodingFraudProof,proto3,oneof" json:"bad_encoding_fraud_proof,omitempty"`
+}
 
 func (*Evidence_DuplicateVoteEvidence) isEvidence_Sum()     {}
 func (*Evidence_LightClientAttackEvidence) isEvidence_Sum() {}
+func (*Evidence_BadEncodingFraudProof) isEvidence_Sum()     {}
 
 func (m *Evidence) GetSum() isEvidence_Sum {
 	if m != nil {
@@ -572,11 +577,19 @@ func (m *Evidence) GetLightClientAttackEvidence() *LightClientAttackEvidence {
 	return nil
 }
 
+func (m *Evidence) GetBadEncodingFraudProof() *BadEncodingFraudProof {
+	if x, ok := m.GetSum().(*Evidence_BadEncodingFraudProof); ok {
+		return x.BadEncodingFraudProof
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Evidence) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Evidence_DuplicateVoteEvidence)(nil),
 		(*Evidence_LightClientAttackEvidence)(nil),
+		(*Evidence_BadEncodingFraudProof)(nil),
 	}
 }
 
@@ -734,6 +747,215 @@ func (m *LightClientAttackEvidence) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+// BadEncodingFraudProof contains evidence that a row or column committed to in the
+// DataAvailabilityHeader of a block is not a valid Reed-Solomon extension of its data.
+type BadEncodingFraudProof struct {
+	Height    int64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	IsRow     bool          `protobuf:"varint,2,opt,name=is_row,json=isRow,proto3" json:"is_row,omitempty"`
+	Index     uint32        `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Shares    []*ShareProof `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares,omitempty"`
+	Timestamp time.Time     `protobuf:"bytes,5,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *BadEncodingFraudProof) Reset()         { *m = BadEncodingFraudProof{} }
+func (m *BadEncodingFraudProof) String() string { return proto.CompactTextString(m) }
+func (*BadEncodingFraudProof) ProtoMessage()    {}
+func (*BadEncodingFraudProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3a6e55e2345de56, []int{8}
+}
+func (m *BadEncodingFraudProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadEncodingFraudProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadEncodingFraudProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadEncodingFraudProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadEncodingFraudProof.Merge(m, src)
+}
+func (m *BadEncodingFraudProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadEncodingFraudProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadEncodingFraudProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadEncodingFraudProof proto.InternalMessageInfo
+
+func (m *BadEncodingFraudProof) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BadEncodingFraudProof) GetIsRow() bool {
+	if m != nil {
+		return m.IsRow
+	}
+	return false
+}
+
+func (m *BadEncodingFraudProof) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BadEncodingFraudProof) GetShares() []*ShareProof {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *BadEncodingFraudProof) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+// ShareProof is a share of the extended data square together with a proof of its
+// inclusion in the root of the orthogonal row or column.
+type ShareProof struct {
+	Index uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Share []byte   `protobuf:"bytes,2,opt,name=share,proto3" json:"share,omitempty"`
+	Proof NMTProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof"`
+}
+
+func (m *ShareProof) Reset()         { *m = ShareProof{} }
+func (m *ShareProof) String() string { return proto.CompactTextString(m) }
+func (*ShareProof) ProtoMessage()    {}
+func (*ShareProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3a6e55e2345de56, []int{9}
+}
+func (m *ShareProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareProof.Merge(m, src)
+}
+func (m *ShareProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareProof proto.InternalMessageInfo
+
+func (m *ShareProof) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ShareProof) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+func (m *ShareProof) GetProof() NMTProof {
+	if m != nil {
+		return m.Proof
+	}
+	return NMTProof{}
+}
+
+// NMTProof is a proof of inclusion (or absence) of leaves in a Namespaced Merkle Tree.
+type NMTProof struct {
+	Start    int32    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End      int32    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Nodes    [][]byte `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	LeafHash []byte   `protobuf:"bytes,4,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+}
+
+func (m *NMTProof) Reset()         { *m = NMTProof{} }
+func (m *NMTProof) String() string { return proto.CompactTextString(m) }
+func (*NMTProof) ProtoMessage()    {}
+func (*NMTProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3a6e55e2345de56, []int{10}
+}
+func (m *NMTProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NMTProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NMTProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NMTProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NMTProof.Merge(m, src)
+}
+func (m *NMTProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *NMTProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_NMTProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NMTProof proto.InternalMessageInfo
+
+func (m *NMTProof) GetStart() int32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *NMTProof) GetEnd() int32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *NMTProof) GetNodes() [][]byte {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *NMTProof) GetLeafHash() []byte {
+	if m != nil {
+		return m.LeafHash
+	}
+	return nil
+}
+
 type EvidenceList struct {
 	Evidence []Evidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence"`
 }
@@ -742,7 +964,7 @@ func (m *EvidenceList) Reset()         { *m = EvidenceList{} }
 func (m *EvidenceList) String() string { return proto.CompactTextString(m) }
 func (*EvidenceList) ProtoMessage()    {}
 func (*EvidenceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{8}
+	return fileDescriptor_d3a6e55e2345de56, []int{11}
 }
 func (m *EvidenceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,7 +1008,7 @@ func (m *IntermediateStateRoots) Reset()         { *m = IntermediateStateRoots{}
 func (m *IntermediateStateRoots) String() string { return proto.CompactTextString(m) }
 func (*IntermediateStateRoots) ProtoMessage()    {}
 func (*IntermediateStateRoots) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{9}
+	return fileDescriptor_d3a6e55e2345de56, []int{12}
 }
 func (m *IntermediateStateRoots) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -830,7 +1052,7 @@ func (m *Messages) Reset()         { *m = Messages{} }
 func (m *Messages) String() string { return proto.CompactTextString(m) }
 func (*Messages) ProtoMessage()    {}
 func (*Messages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{10}
+	return fileDescriptor_d3a6e55e2345de56, []int{13}
 }
 func (m *Messages) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1097,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{11}
+	return fileDescriptor_d3a6e55e2345de56, []int{14}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -939,7 +1161,7 @@ func (m *DataAvailabilityHeader) Reset()         { *m = DataAvailabilityHeader{}
 func (m *DataAvailabilityHeader) String() string { return proto.CompactTextString(m) }
 func (*DataAvailabilityHeader) ProtoMessage()    {}
 func (*DataAvailabilityHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{12}
+	return fileDescriptor_d3a6e55e2345de56, []int{15}
 }
 func (m *DataAvailabilityHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -999,7 +1221,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{13}
+	return fileDescriptor_d3a6e55e2345de56, []int{16}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1097,7 +1319,7 @@ func (m *Commit) Reset()         { *m = Commit{} }
 func (m *Commit) String() string { return proto.CompactTextString(m) }
 func (*Commit) ProtoMessage()    {}
 func (*Commit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{14}
+	return fileDescriptor_d3a6e55e2345de56, []int{17}
 }
 func (m *Commit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1173,7 +1395,7 @@ func (m *CommitSig) Reset()         { *m = CommitSig{} }
 func (m *CommitSig) String() string { return proto.CompactTextString(m) }
 func (*CommitSig) ProtoMessage()    {}
 func (*CommitSig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{15}
+	return fileDescriptor_d3a6e55e2345de56, []int{18}
 }
 func (m *CommitSig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1245,7 +1467,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{16}
+	return fileDescriptor_d3a6e55e2345de56, []int{19}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1339,7 +1561,7 @@ func (m *SignedHeader) Reset()         { *m = SignedHeader{} }
 func (m *SignedHeader) String() string { return proto.CompactTextString(m) }
 func (*SignedHeader) ProtoMessage()    {}
 func (*SignedHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{17}
+	return fileDescriptor_d3a6e55e2345de56, []int{20}
 }
 func (m *SignedHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1392,7 +1614,7 @@ func (m *LightBlock) Reset()         { *m = LightBlock{} }
 func (m *LightBlock) String() string { return proto.CompactTextString(m) }
 func (*LightBlock) ProtoMessage()    {}
 func (*LightBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{18}
+	return fileDescriptor_d3a6e55e2345de56, []int{21}
 }
 func (m *LightBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1454,7 +1676,7 @@ func (m *BlockMeta) Reset()         { *m = BlockMeta{} }
 func (m *BlockMeta) String() string { return proto.CompactTextString(m) }
 func (*BlockMeta) ProtoMessage()    {}
 func (*BlockMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{19}
+	return fileDescriptor_d3a6e55e2345de56, []int{22}
 }
 func (m *BlockMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1518,25 +1740,24 @@ func (m *BlockMeta) GetDaHeader() *DataAvailabilityHeader {
 	return nil
 }
 
-// TxProof represents a Merkle proof of the presence of a transaction in the Merkle tree.
-type TxProof struct {
-	RootHash []byte        `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
-	Data     []byte        `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Proof    *crypto.Proof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+// TxShareProof proves the presence of a transaction in the shares of a block.
+type TxShareProof struct {
+	Data      []byte           `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	RowProofs []*RowShareProof `protobuf:"bytes,2,rep,name=row_proofs,json=rowProofs,proto3" json:"row_proofs,omitempty"`
 }
 
-func (m *TxProof) Reset()         { *m = TxProof{} }
-func (m *TxProof) String() string { return proto.CompactTextString(m) }
-func (*TxProof) ProtoMessage()    {}
-func (*TxProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{20}
+func (m *TxShareProof) Reset()         { *m = TxShareProof{} }
+func (m *TxShareProof) String() string { return proto.CompactTextString(m) }
+func (*TxShareProof) ProtoMessage()    {}
+func (*TxShareProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3a6e55e2345de56, []int{23}
 }
-func (m *TxProof) XXX_Unmarshal(b []byte) error {
+func (m *TxShareProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TxProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxShareProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TxProof.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxShareProof.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1546,35 +1767,98 @@ func (m *TxProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TxProof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxProof.Merge(m, src)
+func (m *TxShareProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxShareProof.Merge(m, src)
 }
-func (m *TxProof) XXX_Size() int {
+func (m *TxShareProof) XXX_Size() int {
 	return m.Size()
 }
-func (m *TxProof) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxProof.DiscardUnknown(m)
+func (m *TxShareProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxShareProof.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxProof proto.InternalMessageInfo
+var xxx_messageInfo_TxShareProof proto.InternalMessageInfo
 
-func (m *TxProof) GetRootHash() []byte {
+func (m *TxShareProof) GetData() []byte {
 	if m != nil {
-		return m.RootHash
+		return m.Data
 	}
 	return nil
 }
 
-func (m *TxProof) GetData() []byte {
+func (m *TxShareProof) GetRowProofs() []*RowShareProof {
 	if m != nil {
-		return m.Data
+		return m.RowProofs
+	}
+	return nil
+}
+
+// RowShareProof proves consecutive shares of a row of the original data square
+// against the row root, and the row root against the data hash.
+type RowShareProof struct {
+	RowIndex     uint32        `protobuf:"varint,1,opt,name=row_index,json=rowIndex,proto3" json:"row_index,omitempty"`
+	RowRoot      []byte        `protobuf:"bytes,2,opt,name=row_root,json=rowRoot,proto3" json:"row_root,omitempty"`
+	RowRootProof crypto.Proof  `protobuf:"bytes,3,opt,name=row_root_proof,json=rowRootProof,proto3" json:"row_root_proof"`
+	Shares       []*ShareProof `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (m *RowShareProof) Reset()         { *m = RowShareProof{} }
+func (m *RowShareProof) String() string { return proto.CompactTextString(m) }
+func (*RowShareProof) ProtoMessage()    {}
+func (*RowShareProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3a6e55e2345de56, []int{24}
+}
+func (m *RowShareProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RowShareProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RowShareProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RowShareProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RowShareProof.Merge(m, src)
+}
+func (m *RowShareProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *RowShareProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_RowShareProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RowShareProof proto.InternalMessageInfo
+
+func (m *RowShareProof) GetRowIndex() uint32 {
+	if m != nil {
+		return m.RowIndex
+	}
+	return 0
+}
+
+func (m *RowShareProof) GetRowRoot() []byte {
+	if m != nil {
+		return m.RowRoot
 	}
 	return nil
 }
 
-func (m *TxProof) GetProof() *crypto.Proof {
+func (m *RowShareProof) GetRowRootProof() crypto.Proof {
 	if m != nil {
-		return m.Proof
+		return m.RowRootProof
+	}
+	return crypto.Proof{}
+}
+
+func (m *RowShareProof) GetShares() []*ShareProof {
+	if m != nil {
+		return m.Shares
 	}
 	return nil
 }
@@ -1590,6 +1874,9 @@ func init() {
 	proto.RegisterType((*Evidence)(nil), "tendermint.types.Evidence")
 	proto.RegisterType((*DuplicateVoteEvidence)(nil), "tendermint.types.DuplicateVoteEvidence")
 	proto.RegisterType((*LightClientAttackEvidence)(nil), "tendermint.types.LightClientAttackEvidence")
+	proto.RegisterType((*BadEncodingFraudProof)(nil), "tendermint.types.BadEncodingFraudProof")
+	proto.RegisterType((*ShareProof)(nil), "tendermint.types.ShareProof")
+	proto.RegisterType((*NMTProof)(nil), "tendermint.types.NMTProof")
 	proto.RegisterType((*EvidenceList)(nil), "tendermint.types.EvidenceList")
 	proto.RegisterType((*IntermediateStateRoots)(nil), "tendermint.types.IntermediateStateRoots")
 	proto.RegisterType((*Messages)(nil), "tendermint.types.Messages")
@@ -1602,131 +1889,145 @@ func init() {
 	proto.RegisterType((*SignedHeader)(nil), "tendermint.types.SignedHeader")
 	proto.RegisterType((*LightBlock)(nil), "tendermint.types.LightBlock")
 	proto.RegisterType((*BlockMeta)(nil), "tendermint.types.BlockMeta")
-	proto.RegisterType((*TxProof)(nil), "tendermint.types.TxProof")
+	proto.RegisterType((*TxShareProof)(nil), "tendermint.types.TxShareProof")
+	proto.RegisterType((*RowShareProof)(nil), "tendermint.types.RowShareProof")
 }
 
 func init() { proto.RegisterFile("tendermint/types/types.proto", fileDescriptor_d3a6e55e2345de56) }
 
 var fileDescriptor_d3a6e55e2345de56 = []byte{
-	// 1879 bytes of a gzipped FileDescriptorProto
+	// 2083 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4b, 0x6f, 0x1b, 0xc9,
-	0x11, 0xd6, 0xf0, 0x21, 0x92, 0x45, 0x52, 0xa2, 0x3a, 0x92, 0x4c, 0xc9, 0x36, 0xc5, 0x30, 0x8f,
-	0xd5, 0xbe, 0x28, 0xc7, 0x1b, 0xe4, 0x01, 0x6c, 0x16, 0x4b, 0x4a, 0x5a, 0x9b, 0x59, 0x3d, 0x88,
-	0xa1, 0x56, 0x79, 0x5c, 0x06, 0x4d, 0x4e, 0x9b, 0x9c, 0x78, 0x38, 0x3d, 0x98, 0x6e, 0xca, 0x92,
-	0x8f, 0x39, 0x6d, 0x74, 0xf2, 0x1f, 0x10, 0x72, 0x48, 0x0e, 0xf9, 0x29, 0x7b, 0x09, 0xb0, 0xb7,
-	0xe4, 0x12, 0x27, 0x91, 0x73, 0x08, 0x90, 0x3f, 0x11, 0xf4, 0x63, 0x86, 0x43, 0x91, 0x74, 0x1c,
-	0x43, 0xd8, 0x0b, 0xc1, 0xae, 0xfa, 0xaa, 0xba, 0xaa, 0xba, 0xaa, 0xba, 0x7a, 0xe0, 0x1e, 0x27,
-	0x9e, 0x4d, 0x82, 0xa1, 0xe3, 0xf1, 0x1d, 0x7e, 0xe1, 0x13, 0xa6, 0x7e, 0xeb, 0x7e, 0x40, 0x39,
-	0x45, 0xa5, 0x31, 0xb7, 0x2e, 0xe9, 0x9b, 0xab, 0x7d, 0xda, 0xa7, 0x92, 0xb9, 0x23, 0xfe, 0x29,
-	0xdc, 0xe6, 0x56, 0x9f, 0xd2, 0xbe, 0x4b, 0x76, 0xe4, 0xaa, 0x3b, 0x7a, 0xb2, 0xc3, 0x9d, 0x21,
-	0x61, 0x1c, 0x0f, 0x7d, 0x0d, 0xb8, 0x1f, 0xdb, 0xa6, 0x17, 0x5c, 0xf8, 0x9c, 0x0a, 0x2c, 0x7d,
-	0xa2, 0xd9, 0x95, 0x18, 0xfb, 0x8c, 0x04, 0xcc, 0xa1, 0x5e, 0xdc, 0x8e, 0xcd, 0xea, 0x94, 0x95,
-	0x67, 0xd8, 0x75, 0x6c, 0xcc, 0x69, 0xa0, 0x10, 0xb5, 0x9f, 0x42, 0xb1, 0x8d, 0x03, 0xde, 0x21,
-	0xfc, 0x31, 0xc1, 0x36, 0x09, 0xd0, 0x2a, 0xa4, 0x39, 0xe5, 0xd8, 0x2d, 0x1b, 0x55, 0x63, 0xbb,
-	0x68, 0xaa, 0x05, 0x42, 0x90, 0x1a, 0x60, 0x36, 0x28, 0x27, 0xaa, 0xc6, 0x76, 0xc1, 0x94, 0xff,
-	0x6b, 0x03, 0x48, 0x09, 0x51, 0x21, 0xe1, 0x78, 0x36, 0x39, 0x0f, 0x25, 0xe4, 0x42, 0x50, 0xbb,
-	0x17, 0x9c, 0x30, 0x2d, 0xa2, 0x16, 0xe8, 0x87, 0x90, 0x96, 0xf6, 0x97, 0x93, 0x55, 0x63, 0x3b,
-	0xff, 0xb0, 0x5c, 0x8f, 0x05, 0x4a, 0xf9, 0x57, 0x6f, 0x0b, 0x7e, 0x33, 0xf5, 0xd5, 0xcb, 0xad,
-	0x05, 0x53, 0x81, 0x6b, 0x2e, 0x64, 0x9a, 0x2e, 0xed, 0x3d, 0x6d, 0xed, 0x45, 0x86, 0x18, 0x63,
-	0x43, 0xd0, 0x21, 0x2c, 0xfb, 0x38, 0xe0, 0x16, 0x23, 0xdc, 0x1a, 0x48, 0x2f, 0xe4, 0xa6, 0xf9,
-	0x87, 0x5b, 0xf5, 0x9b, 0xe7, 0x50, 0x9f, 0x70, 0x56, 0xef, 0x52, 0xf4, 0xe3, 0xc4, 0xda, 0xef,
-	0xd3, 0xb0, 0xa8, 0x83, 0xf1, 0x33, 0xc8, 0xe8, 0xb0, 0xca, 0x0d, 0xf3, 0x0f, 0xef, 0xc7, 0x35,
-	0x6a, 0x56, 0x7d, 0x97, 0x7a, 0x8c, 0x78, 0x6c, 0xc4, 0xb4, 0xbe, 0x50, 0x06, 0x7d, 0x1f, 0xb2,
-	0xbd, 0x01, 0x76, 0x3c, 0xcb, 0xb1, 0xa5, 0x45, 0xb9, 0x66, 0xfe, 0xfa, 0xe5, 0x56, 0x66, 0x57,
-	0xd0, 0x5a, 0x7b, 0x66, 0x46, 0x32, 0x5b, 0x36, 0x5a, 0x87, 0xc5, 0x01, 0x71, 0xfa, 0x03, 0x2e,
-	0xc3, 0x92, 0x34, 0xf5, 0x0a, 0xfd, 0x04, 0x52, 0x22, 0x21, 0xca, 0x29, 0xb9, 0xf7, 0x66, 0x5d,
-	0x65, 0x4b, 0x3d, 0xcc, 0x96, 0xfa, 0x49, 0x98, 0x2d, 0xcd, 0xac, 0xd8, 0xf8, 0xc5, 0xdf, 0xb7,
-	0x0c, 0x53, 0x4a, 0xa0, 0x5d, 0x28, 0xba, 0x98, 0x71, 0xab, 0x2b, 0xc2, 0x26, 0xb6, 0x4f, 0x4b,
-	0x15, 0x1b, 0xd3, 0x01, 0xd1, 0x81, 0xd5, 0xa6, 0xe7, 0x85, 0x94, 0x22, 0xd9, 0x68, 0x1b, 0x4a,
-	0x52, 0x49, 0x8f, 0x0e, 0x87, 0x0e, 0xb7, 0x64, 0xdc, 0x17, 0x65, 0xdc, 0x97, 0x04, 0x7d, 0x57,
-	0x92, 0x1f, 0x8b, 0x13, 0xf8, 0x31, 0x94, 0xbd, 0xd1, 0xd0, 0xa2, 0x81, 0xd3, 0x77, 0x3c, 0xec,
-	0x5a, 0x36, 0xe6, 0xd8, 0x62, 0x03, 0x1c, 0x10, 0x56, 0xce, 0x54, 0x8d, 0xed, 0x94, 0xb9, 0xe6,
-	0x8d, 0x86, 0xc7, 0x9a, 0xbd, 0x87, 0x39, 0xee, 0x48, 0x26, 0xba, 0x0b, 0x39, 0x89, 0x95, 0xba,
-	0xb3, 0x52, 0x77, 0x56, 0x10, 0xa4, 0xd6, 0x77, 0x60, 0x39, 0x4a, 0x57, 0xa6, 0x20, 0x39, 0xb5,
-	0xfd, 0x98, 0x2c, 0x81, 0x0f, 0x60, 0xd5, 0x23, 0xe7, 0xdc, 0xba, 0x89, 0x06, 0x89, 0x46, 0x82,
-	0x77, 0x3a, 0x29, 0xf1, 0x3d, 0x58, 0xea, 0x85, 0xa7, 0xa6, 0xb0, 0x79, 0x89, 0x2d, 0x46, 0x54,
-	0x09, 0xdb, 0x80, 0x2c, 0xf6, 0x7d, 0x05, 0x28, 0x48, 0x40, 0x06, 0xfb, 0xbe, 0x64, 0xbd, 0x07,
-	0x2b, 0x32, 0x38, 0x01, 0x61, 0x23, 0x97, 0x6b, 0x25, 0x45, 0x89, 0x59, 0x16, 0x0c, 0x53, 0xd1,
-	0x25, 0xf6, 0x3b, 0x50, 0x24, 0x67, 0x8e, 0x4d, 0xbc, 0x1e, 0x51, 0xb8, 0x25, 0x89, 0x2b, 0x84,
-	0x44, 0x09, 0x7a, 0x17, 0x4a, 0x7e, 0x40, 0x7d, 0xca, 0x48, 0x60, 0x61, 0xdb, 0x0e, 0x08, 0x63,
-	0xe5, 0x65, 0xa5, 0x2f, 0xa4, 0x37, 0x14, 0xb9, 0xf6, 0xdb, 0x04, 0xa4, 0x44, 0x10, 0x51, 0x09,
-	0x92, 0xfc, 0x9c, 0x95, 0x8d, 0x6a, 0x72, 0xbb, 0x60, 0x8a, 0xbf, 0x68, 0x00, 0x65, 0xc7, 0xe3,
-	0x24, 0x18, 0x12, 0xdb, 0xc1, 0x9c, 0x58, 0x8c, 0x8b, 0xdf, 0x80, 0x52, 0xce, 0x74, 0x51, 0x6c,
-	0x4f, 0xe7, 0x40, 0x2b, 0x26, 0xd1, 0x11, 0x02, 0xa6, 0xc0, 0xeb, 0x94, 0x58, 0x77, 0x66, 0x72,
-	0xd1, 0xa7, 0x90, 0x0d, 0xed, 0xd7, 0xd5, 0x5c, 0x99, 0xd6, 0xbc, 0xaf, 0x11, 0x07, 0x0e, 0xe3,
-	0x5a, 0x5f, 0x24, 0x85, 0x3e, 0x86, 0xec, 0x90, 0x30, 0x86, 0xfb, 0x84, 0x45, 0x29, 0x3e, 0xa5,
-	0xe1, 0x50, 0x23, 0x42, 0xe9, 0x50, 0xa2, 0xf6, 0x2f, 0x03, 0xb2, 0xa1, 0x7a, 0x84, 0xe1, 0x8e,
-	0x3d, 0xf2, 0x5d, 0xa7, 0x27, 0xbc, 0x3d, 0xa3, 0x9c, 0x58, 0x91, 0x6d, 0xaa, 0x70, 0xdf, 0x99,
-	0xd6, 0xbc, 0x17, 0x0a, 0x9c, 0x52, 0x4e, 0x42, 0x4d, 0x8f, 0x17, 0xcc, 0x35, 0x7b, 0x16, 0x03,
-	0x79, 0x70, 0xcf, 0x15, 0x55, 0x69, 0xf5, 0x5c, 0x87, 0x78, 0xdc, 0xc2, 0x9c, 0xe3, 0xde, 0xd3,
-	0xf1, 0x3e, 0x2a, 0xba, 0xef, 0x4f, 0xef, 0x73, 0x20, 0xa4, 0x76, 0xa5, 0x50, 0x43, 0xca, 0xc4,
-	0xf6, 0xda, 0x70, 0xe7, 0x31, 0x9b, 0x69, 0x48, 0xb2, 0xd1, 0xb0, 0xf6, 0x22, 0x01, 0x6b, 0x33,
-	0x2d, 0x45, 0x1f, 0xc2, 0xa2, 0xf4, 0x14, 0x6b, 0x17, 0xd7, 0xa7, 0xb7, 0x16, 0x78, 0x33, 0x2d,
-	0x50, 0x8d, 0x08, 0xde, 0xd5, 0x96, 0xbe, 0x16, 0xde, 0x44, 0x1f, 0x00, 0x92, 0xad, 0x5f, 0x44,
-	0xd3, 0xf1, 0xfa, 0x96, 0x4f, 0x9f, 0x91, 0x40, 0xf7, 0xa7, 0x92, 0xe4, 0x9c, 0x4a, 0x46, 0x5b,
-	0xd0, 0x27, 0x4a, 0x55, 0x43, 0x53, 0x12, 0x3a, 0x2e, 0x55, 0x05, 0x6c, 0x42, 0x2e, 0xba, 0xe3,
-	0x74, 0x53, 0x7a, 0xb3, 0xbe, 0x36, 0x16, 0xab, 0xfd, 0x39, 0x01, 0x1b, 0x73, 0x83, 0x8a, 0x5a,
-	0xb0, 0xd2, 0xa3, 0xde, 0x13, 0xd7, 0xe9, 0x49, 0xbb, 0x65, 0x07, 0xd4, 0x11, 0xba, 0x37, 0xe7,
-	0x70, 0x64, 0xc3, 0x33, 0x4b, 0x31, 0x31, 0x49, 0x11, 0x75, 0x2b, 0x7a, 0x1f, 0xf5, 0x2c, 0xdd,
-	0x9e, 0x13, 0xd2, 0xa7, 0x82, 0x22, 0x3e, 0x56, 0x4d, 0xfa, 0x08, 0x56, 0xbb, 0x17, 0xcf, 0xb1,
-	0xc7, 0x1d, 0x8f, 0xc4, 0x3a, 0x50, 0x39, 0x59, 0x4d, 0x6e, 0xe7, 0x1f, 0xde, 0x9d, 0x11, 0xe5,
-	0x10, 0x63, 0x7e, 0x2b, 0x12, 0x1c, 0xb7, 0xa7, 0x39, 0x81, 0x4f, 0xcd, 0x09, 0xfc, 0x6d, 0xc4,
-	0xf3, 0x00, 0x0a, 0xf1, 0x3a, 0x15, 0x75, 0x19, 0xab, 0x9e, 0xe4, 0xec, 0xba, 0x8c, 0xf2, 0xf4,
-	0x46, 0x55, 0xd7, 0x3e, 0x81, 0xf5, 0xd9, 0xfd, 0x04, 0x7d, 0x17, 0x96, 0x02, 0xfc, 0x4c, 0x35,
-	0x23, 0xcb, 0x75, 0x18, 0xd7, 0x8d, 0xab, 0x10, 0xe0, 0x67, 0x12, 0x21, 0x76, 0xaf, 0xfd, 0x1c,
-	0xb2, 0x61, 0xcd, 0xa3, 0x4f, 0xa0, 0x18, 0xd6, 0xfb, 0x58, 0x60, 0xe6, 0x35, 0xa6, 0x45, 0xcc,
-	0x42, 0x88, 0x97, 0xba, 0x3e, 0x85, 0x8c, 0x66, 0xa0, 0x6f, 0x43, 0xc1, 0xc3, 0x43, 0xc2, 0x7c,
-	0xdc, 0x23, 0xe2, 0x42, 0x54, 0x03, 0x44, 0x3e, 0xa2, 0xb5, 0x6c, 0x31, 0x5b, 0x88, 0xbb, 0x27,
-	0x1c, 0x72, 0xc4, 0xff, 0xda, 0x2f, 0x61, 0x5d, 0x74, 0xda, 0xc6, 0x19, 0x76, 0x5c, 0xdc, 0x75,
-	0x5c, 0x87, 0x5f, 0xe8, 0xd9, 0xe0, 0x2e, 0xe4, 0x02, 0xaa, 0xbd, 0xd1, 0x8e, 0x64, 0x03, 0xaa,
-	0x1c, 0x11, 0xbb, 0xf5, 0xa8, 0x3b, 0x1a, 0x7a, 0x51, 0xeb, 0x15, 0xfc, 0xbc, 0xa2, 0x49, 0x48,
-	0xed, 0xdf, 0x09, 0x48, 0x89, 0x82, 0x43, 0x1f, 0x41, 0x4a, 0xf8, 0x20, 0x2d, 0x5a, 0x9a, 0x35,
-	0xb3, 0x74, 0x9c, 0xbe, 0x47, 0xec, 0x43, 0xd6, 0x3f, 0xb9, 0xf0, 0x89, 0x29, 0xc1, 0xb1, 0x91,
-	0x21, 0x31, 0x31, 0x32, 0xac, 0x42, 0x3a, 0xa0, 0x23, 0xcf, 0x96, 0x95, 0x9a, 0x36, 0xd5, 0x02,
-	0xed, 0x43, 0x36, 0x9a, 0x04, 0x52, 0xff, 0x6b, 0x12, 0x58, 0x16, 0x07, 0x2a, 0xe6, 0x14, 0x4d,
-	0x30, 0x33, 0x5d, 0x3d, 0x10, 0xdc, 0x42, 0xb2, 0xa1, 0xf7, 0x61, 0x65, 0xdc, 0x29, 0xc2, 0x7b,
-	0x4e, 0x4d, 0x15, 0xa5, 0x88, 0xa1, 0x2f, 0xba, 0xc9, 0xb6, 0xa2, 0x86, 0xcc, 0x8c, 0xf4, 0x6b,
-	0xdc, 0x56, 0x5a, 0x72, 0xda, 0xbc, 0x07, 0x39, 0xe6, 0xf4, 0x3d, 0xcc, 0x47, 0x01, 0xd1, 0x73,
-	0xc4, 0x98, 0x50, 0xfb, 0xa7, 0x01, 0x8b, 0x6a, 0x5a, 0x89, 0xc5, 0xcd, 0x98, 0x1d, 0xb7, 0xc4,
-	0xbc, 0xb8, 0x25, 0xdf, 0x3e, 0x6e, 0x0d, 0x80, 0xc8, 0x18, 0x71, 0xd5, 0xcd, 0x69, 0x0c, 0xca,
-	0xc4, 0x8e, 0xd3, 0xd7, 0x35, 0x15, 0x13, 0x42, 0x5b, 0x90, 0x57, 0xa3, 0xad, 0x1a, 0x20, 0xd2,
-	0xd2, 0x45, 0x50, 0x24, 0x31, 0x3e, 0xd4, 0xfe, 0x66, 0x40, 0x2e, 0x52, 0x80, 0x1a, 0x50, 0x0c,
-	0x0d, 0xb7, 0x9e, 0xb8, 0xb8, 0xaf, 0x93, 0xeb, 0xfe, 0x5c, 0xeb, 0x3f, 0x73, 0x71, 0xdf, 0xcc,
-	0x6b, 0x83, 0xc5, 0x62, 0xf6, 0x41, 0x25, 0xe6, 0x1c, 0xd4, 0x44, 0x66, 0x24, 0xdf, 0x2e, 0x33,
-	0x26, 0xce, 0x30, 0x75, 0xf3, 0x0c, 0xbf, 0x4c, 0x42, 0xb6, 0x2d, 0xe7, 0x20, 0xec, 0x7e, 0x13,
-	0x25, 0x73, 0x17, 0x72, 0x3e, 0x75, 0x2d, 0xc5, 0x49, 0x49, 0x4e, 0xd6, 0xa7, 0xae, 0x39, 0x95,
-	0x17, 0xe9, 0x5b, 0xaa, 0xa7, 0xc5, 0x5b, 0x88, 0x5a, 0xe6, 0x46, 0xd4, 0x50, 0x47, 0xcc, 0xd7,
-	0xe1, 0xa3, 0x28, 0x3b, 0x6f, 0xfe, 0x9b, 0xdd, 0xe1, 0x9a, 0x85, 0xeb, 0x97, 0x5b, 0xd9, 0xbd,
-	0x86, 0x5a, 0x89, 0xb9, 0x5c, 0x3f, 0x90, 0x02, 0x28, 0xa8, 0xf8, 0xea, 0x4e, 0xf8, 0x40, 0x04,
-	0x56, 0xee, 0x60, 0x4c, 0xbf, 0xea, 0xd4, 0x0e, 0x5a, 0x87, 0xc6, 0x09, 0x09, 0xf5, 0xa8, 0xd0,
-	0xb3, 0x48, 0x79, 0x5e, 0x31, 0x98, 0x1a, 0x57, 0xfb, 0x8f, 0x01, 0x30, 0xbe, 0xab, 0xc5, 0xfb,
-	0x86, 0x49, 0x13, 0xac, 0x89, 0x9d, 0x2b, 0xf3, 0x32, 0x41, 0xef, 0x5f, 0x60, 0x71, 0xbb, 0x77,
-	0xa1, 0x38, 0xce, 0x70, 0x46, 0x42, 0x63, 0x2a, 0xaf, 0xb9, 0xb2, 0x3b, 0x84, 0x9b, 0x85, 0xb3,
-	0xd8, 0x6a, 0x32, 0xc2, 0xc9, 0x5b, 0x8a, 0xf0, 0xef, 0x12, 0x90, 0x93, 0x8e, 0x1e, 0x12, 0x8e,
-	0x27, 0xb2, 0xcd, 0x78, 0xfb, 0x6c, 0xbb, 0x0f, 0xa0, 0xd4, 0x30, 0xe7, 0x39, 0xd1, 0x35, 0x90,
-	0x93, 0x94, 0x8e, 0xf3, 0x9c, 0xa0, 0x1f, 0x45, 0xa7, 0x98, 0x7c, 0xfd, 0x29, 0xea, 0xee, 0x14,
-	0x9e, 0xe5, 0x1d, 0xc8, 0x88, 0xb7, 0x9f, 0x78, 0x87, 0xa8, 0x21, 0x65, 0xd1, 0x1b, 0x0d, 0x4f,
-	0xce, 0x19, 0xda, 0x8f, 0x47, 0x26, 0xfd, 0xff, 0x45, 0x26, 0x16, 0x8b, 0xdf, 0x40, 0xe6, 0xe4,
-	0x5c, 0x7e, 0x14, 0x50, 0x57, 0x2e, 0xd5, 0x2f, 0x51, 0x75, 0x81, 0x67, 0x05, 0x41, 0xbe, 0x9f,
-	0x66, 0xdc, 0xde, 0xa8, 0xfe, 0x86, 0x9f, 0x1b, 0xf4, 0x87, 0x86, 0xf7, 0xfe, 0x62, 0x40, 0x3e,
-	0xd6, 0x10, 0xd1, 0x0f, 0x60, 0xad, 0x79, 0x70, 0xbc, 0xfb, 0xb9, 0xd5, 0xda, 0xb3, 0x3e, 0x3b,
-	0x68, 0x3c, 0xb2, 0xbe, 0x38, 0xfa, 0xfc, 0xe8, 0xf8, 0x17, 0x47, 0xa5, 0x85, 0xcd, 0xf5, 0xcb,
-	0xab, 0x2a, 0x8a, 0x61, 0xbf, 0xf0, 0x9e, 0x7a, 0xf4, 0x99, 0x87, 0x76, 0x60, 0x75, 0x52, 0xa4,
-	0xd1, 0xec, 0xec, 0x1f, 0x9d, 0x94, 0x8c, 0xcd, 0xb5, 0xcb, 0xab, 0xea, 0x4a, 0x4c, 0xa2, 0xd1,
-	0x65, 0xc4, 0xe3, 0xd3, 0x02, 0xbb, 0xc7, 0x87, 0x87, 0xad, 0x93, 0x52, 0x62, 0x4a, 0x40, 0x5f,
-	0x61, 0xef, 0xc2, 0xca, 0xa4, 0xc0, 0x51, 0xeb, 0xa0, 0x94, 0xdc, 0x44, 0x97, 0x57, 0xd5, 0xa5,
-	0x18, 0xfa, 0xc8, 0x71, 0x37, 0xb3, 0x5f, 0xfe, 0xa1, 0xb2, 0xf0, 0xa7, 0x3f, 0x56, 0x0c, 0xe1,
-	0x59, 0x71, 0xa2, 0x29, 0xa2, 0x0f, 0xe0, 0x4e, 0xa7, 0xf5, 0xe8, 0x68, 0x7f, 0xcf, 0x3a, 0xec,
-	0x3c, 0xb2, 0x4e, 0x7e, 0xd5, 0xde, 0x8f, 0x79, 0xb7, 0x7c, 0x79, 0x55, 0xcd, 0x6b, 0x97, 0xe6,
-	0xa1, 0xdb, 0xe6, 0xfe, 0xe9, 0xf1, 0xc9, 0x7e, 0xc9, 0x50, 0xe8, 0x76, 0x40, 0xc4, 0xcb, 0x41,
-	0xa2, 0x1f, 0xc0, 0xc6, 0x0c, 0x74, 0xe4, 0xd8, 0xca, 0xe5, 0x55, 0xb5, 0xd8, 0x0e, 0x88, 0xaa,
-	0x6d, 0x29, 0x51, 0x87, 0xf2, 0xb4, 0xc4, 0x71, 0xfb, 0xb8, 0xd3, 0x38, 0x28, 0x55, 0x37, 0x4b,
-	0x97, 0x57, 0xd5, 0x42, 0xd8, 0xfd, 0x05, 0x7e, 0xec, 0x59, 0xf3, 0xf4, 0xab, 0xeb, 0x8a, 0xf1,
-	0xf5, 0x75, 0xc5, 0xf8, 0xc7, 0x75, 0xc5, 0x78, 0xf1, 0xaa, 0xb2, 0xf0, 0xf5, 0xab, 0xca, 0xc2,
-	0x5f, 0x5f, 0x55, 0x16, 0x7e, 0xfd, 0x71, 0xdf, 0xe1, 0x83, 0x51, 0xb7, 0xde, 0xa3, 0xc3, 0x1d,
-	0x17, 0x3f, 0xbf, 0x70, 0x89, 0xdd, 0x27, 0x41, 0xec, 0xef, 0x87, 0x3d, 0x1a, 0xe8, 0x8f, 0x6f,
-	0x3b, 0x37, 0xbf, 0x94, 0x75, 0x17, 0x25, 0xfd, 0xa3, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xfb,
-	0xec, 0xf0, 0x8b, 0xea, 0x13, 0x00, 0x00,
+	0x11, 0xd6, 0xf0, 0xcd, 0x22, 0x29, 0x51, 0x1d, 0x49, 0xa6, 0x64, 0x9b, 0x62, 0x98, 0xc7, 0x6a,
+	0x5f, 0x94, 0xe3, 0x5d, 0xe4, 0x01, 0x6c, 0x8c, 0x25, 0x25, 0xd9, 0x66, 0x56, 0x2f, 0x0c, 0xb5,
+	0xce, 0xe3, 0x32, 0x68, 0x72, 0x5a, 0xe4, 0xc0, 0xc3, 0x19, 0x62, 0xba, 0x29, 0x59, 0x3e, 0xe6,
+	0xb4, 0xd1, 0xc9, 0x7f, 0x40, 0xc8, 0x21, 0x39, 0xe4, 0x7f, 0xe4, 0xe2, 0x4b, 0x80, 0x3d, 0x25,
+	0xb9, 0xc4, 0x49, 0xec, 0x4b, 0x80, 0xfc, 0x89, 0xa0, 0xab, 0x7b, 0xc8, 0xa1, 0x48, 0x66, 0x61,
+	0x43, 0xd8, 0x0b, 0x31, 0x5d, 0xf5, 0x55, 0x55, 0x57, 0x75, 0x75, 0x55, 0x35, 0xe1, 0x8e, 0x60,
+	0x9e, 0xcd, 0x82, 0xbe, 0xe3, 0x89, 0x6d, 0x71, 0x31, 0x60, 0x5c, 0xfd, 0xd6, 0x06, 0x81, 0x2f,
+	0x7c, 0x52, 0x1c, 0x73, 0x6b, 0x48, 0xdf, 0x58, 0xe9, 0xfa, 0x5d, 0x1f, 0x99, 0xdb, 0xf2, 0x4b,
+	0xe1, 0x36, 0x36, 0xbb, 0xbe, 0xdf, 0x75, 0xd9, 0x36, 0xae, 0xda, 0xc3, 0xd3, 0x6d, 0xe1, 0xf4,
+	0x19, 0x17, 0xb4, 0x3f, 0xd0, 0x80, 0xbb, 0x11, 0x33, 0x9d, 0xe0, 0x62, 0x20, 0x7c, 0x89, 0xf5,
+	0x4f, 0x35, 0xbb, 0x1c, 0x61, 0x9f, 0xb1, 0x80, 0x3b, 0xbe, 0x17, 0xdd, 0xc7, 0x46, 0x65, 0x6a,
+	0x97, 0x67, 0xd4, 0x75, 0x6c, 0x2a, 0xfc, 0x40, 0x21, 0xaa, 0x3f, 0x83, 0xc2, 0x31, 0x0d, 0x44,
+	0x8b, 0x89, 0xc7, 0x8c, 0xda, 0x2c, 0x20, 0x2b, 0x90, 0x14, 0xbe, 0xa0, 0x6e, 0xc9, 0xa8, 0x18,
+	0x5b, 0x05, 0x53, 0x2d, 0x08, 0x81, 0x44, 0x8f, 0xf2, 0x5e, 0x29, 0x56, 0x31, 0xb6, 0xf2, 0x26,
+	0x7e, 0x57, 0x7b, 0x90, 0x90, 0xa2, 0x52, 0xc2, 0xf1, 0x6c, 0xf6, 0x2c, 0x94, 0xc0, 0x85, 0xa4,
+	0xb6, 0x2f, 0x04, 0xe3, 0x5a, 0x44, 0x2d, 0xc8, 0xa7, 0x90, 0xc4, 0xfd, 0x97, 0xe2, 0x15, 0x63,
+	0x2b, 0x77, 0xbf, 0x54, 0x8b, 0x04, 0x4a, 0xf9, 0x57, 0x3b, 0x96, 0xfc, 0x46, 0xe2, 0xe5, 0xab,
+	0xcd, 0x05, 0x53, 0x81, 0xab, 0x2e, 0xa4, 0x1b, 0xae, 0xdf, 0x79, 0xda, 0xdc, 0x1d, 0x6d, 0xc4,
+	0x18, 0x6f, 0x84, 0x1c, 0xc0, 0xd2, 0x80, 0x06, 0xc2, 0xe2, 0x4c, 0x58, 0x3d, 0xf4, 0x02, 0x8d,
+	0xe6, 0xee, 0x6f, 0xd6, 0xae, 0x9f, 0x43, 0x6d, 0xc2, 0x59, 0x6d, 0xa5, 0x30, 0x88, 0x12, 0xab,
+	0xbf, 0x4f, 0x42, 0x4a, 0x07, 0xe3, 0xe7, 0x90, 0xd6, 0x61, 0x45, 0x83, 0xb9, 0xfb, 0x77, 0xa3,
+	0x1a, 0x35, 0xab, 0xb6, 0xe3, 0x7b, 0x9c, 0x79, 0x7c, 0xc8, 0xb5, 0xbe, 0x50, 0x86, 0xfc, 0x10,
+	0x32, 0x9d, 0x1e, 0x75, 0x3c, 0xcb, 0xb1, 0x71, 0x47, 0xd9, 0x46, 0xee, 0xf5, 0xab, 0xcd, 0xf4,
+	0x8e, 0xa4, 0x35, 0x77, 0xcd, 0x34, 0x32, 0x9b, 0x36, 0x59, 0x83, 0x54, 0x8f, 0x39, 0xdd, 0x9e,
+	0xc0, 0xb0, 0xc4, 0x4d, 0xbd, 0x22, 0x3f, 0x85, 0x84, 0x4c, 0x88, 0x52, 0x02, 0x6d, 0x6f, 0xd4,
+	0x54, 0xb6, 0xd4, 0xc2, 0x6c, 0xa9, 0x9d, 0x84, 0xd9, 0xd2, 0xc8, 0x48, 0xc3, 0x2f, 0xfe, 0xb9,
+	0x69, 0x98, 0x28, 0x41, 0x76, 0xa0, 0xe0, 0x52, 0x2e, 0xac, 0xb6, 0x0c, 0x9b, 0x34, 0x9f, 0x44,
+	0x15, 0xeb, 0xd3, 0x01, 0xd1, 0x81, 0xd5, 0x5b, 0xcf, 0x49, 0x29, 0x45, 0xb2, 0xc9, 0x16, 0x14,
+	0x51, 0x49, 0xc7, 0xef, 0xf7, 0x1d, 0x61, 0x61, 0xdc, 0x53, 0x18, 0xf7, 0x45, 0x49, 0xdf, 0x41,
+	0xf2, 0x63, 0x79, 0x02, 0x3f, 0x81, 0x92, 0x37, 0xec, 0x5b, 0x7e, 0xe0, 0x74, 0x1d, 0x8f, 0xba,
+	0x96, 0x4d, 0x05, 0xb5, 0x78, 0x8f, 0x06, 0x8c, 0x97, 0xd2, 0x15, 0x63, 0x2b, 0x61, 0xae, 0x7a,
+	0xc3, 0xfe, 0x91, 0x66, 0xef, 0x52, 0x41, 0x5b, 0xc8, 0x24, 0xb7, 0x21, 0x8b, 0x58, 0xd4, 0x9d,
+	0x41, 0xdd, 0x19, 0x49, 0x40, 0xad, 0xef, 0xc1, 0xd2, 0x28, 0x5d, 0xb9, 0x82, 0x64, 0x95, 0xf9,
+	0x31, 0x19, 0x81, 0xf7, 0x60, 0xc5, 0x63, 0xcf, 0x84, 0x75, 0x1d, 0x0d, 0x88, 0x26, 0x92, 0xf7,
+	0x64, 0x52, 0xe2, 0x07, 0xb0, 0xd8, 0x09, 0x4f, 0x4d, 0x61, 0x73, 0x88, 0x2d, 0x8c, 0xa8, 0x08,
+	0x5b, 0x87, 0x0c, 0x1d, 0x0c, 0x14, 0x20, 0x8f, 0x80, 0x34, 0x1d, 0x0c, 0x90, 0xf5, 0x01, 0x2c,
+	0x63, 0x70, 0x02, 0xc6, 0x87, 0xae, 0xd0, 0x4a, 0x0a, 0x88, 0x59, 0x92, 0x0c, 0x53, 0xd1, 0x11,
+	0xfb, 0x3d, 0x28, 0xb0, 0x33, 0xc7, 0x66, 0x5e, 0x87, 0x29, 0xdc, 0x22, 0xe2, 0xf2, 0x21, 0x11,
+	0x41, 0xef, 0x43, 0x71, 0x10, 0xf8, 0x03, 0x9f, 0xb3, 0xc0, 0xa2, 0xb6, 0x1d, 0x30, 0xce, 0x4b,
+	0x4b, 0x4a, 0x5f, 0x48, 0xaf, 0x2b, 0x72, 0xf5, 0xb7, 0x31, 0x48, 0xc8, 0x20, 0x92, 0x22, 0xc4,
+	0xc5, 0x33, 0x5e, 0x32, 0x2a, 0xf1, 0xad, 0xbc, 0x29, 0x3f, 0x49, 0x0f, 0x4a, 0x8e, 0x27, 0x58,
+	0xd0, 0x67, 0xb6, 0x43, 0x05, 0xb3, 0xb8, 0x90, 0xbf, 0x81, 0xef, 0x0b, 0xae, 0x2f, 0xc5, 0xd6,
+	0x74, 0x0e, 0x34, 0x23, 0x12, 0x2d, 0x29, 0x60, 0x4a, 0xbc, 0x4e, 0x89, 0x35, 0x67, 0x26, 0x97,
+	0x7c, 0x0e, 0x99, 0x70, 0xff, 0xfa, 0x36, 0x97, 0xa7, 0x35, 0xef, 0x69, 0xc4, 0xbe, 0xc3, 0x85,
+	0xd6, 0x37, 0x92, 0x22, 0x9f, 0x41, 0xa6, 0xcf, 0x38, 0xa7, 0x5d, 0xc6, 0x47, 0x29, 0x3e, 0xa5,
+	0xe1, 0x40, 0x23, 0x42, 0xe9, 0x50, 0xa2, 0xfa, 0x32, 0x06, 0x99, 0x50, 0x3d, 0xa1, 0x70, 0xcb,
+	0x1e, 0x0e, 0x5c, 0xa7, 0x23, 0xbd, 0x3d, 0xf3, 0x05, 0xb3, 0x46, 0x7b, 0x53, 0x17, 0xf7, 0xbd,
+	0x69, 0xcd, 0xbb, 0xa1, 0xc0, 0x13, 0x5f, 0xb0, 0x50, 0xd3, 0xe3, 0x05, 0x73, 0xd5, 0x9e, 0xc5,
+	0x20, 0x1e, 0xdc, 0x71, 0xe5, 0xad, 0xb4, 0x3a, 0xae, 0xc3, 0x3c, 0x61, 0x51, 0x21, 0x68, 0xe7,
+	0xe9, 0xd8, 0x8e, 0x8a, 0xee, 0x87, 0xd3, 0x76, 0xf6, 0xa5, 0xd4, 0x0e, 0x0a, 0xd5, 0x51, 0x26,
+	0x62, 0x6b, 0xdd, 0x9d, 0xc7, 0x24, 0x6d, 0x28, 0xb5, 0xa9, 0x6d, 0x31, 0xaf, 0xe3, 0xdb, 0x8e,
+	0xd7, 0xb5, 0x4e, 0x03, 0x3a, 0xb4, 0xad, 0x68, 0xf5, 0x9c, 0xe1, 0x53, 0x83, 0xda, 0x7b, 0x5a,
+	0xe0, 0xa1, 0xc4, 0x63, 0x31, 0x95, 0x3e, 0xb5, 0x67, 0x31, 0x1a, 0x49, 0x88, 0xf3, 0x61, 0xbf,
+	0xfa, 0x22, 0x06, 0xab, 0x33, 0xa3, 0x41, 0x3e, 0x86, 0x14, 0x46, 0x93, 0xea, 0x30, 0xae, 0x4d,
+	0x9b, 0x94, 0x78, 0x33, 0x29, 0x51, 0xf5, 0x11, 0xbc, 0x5d, 0x8a, 0x7d, 0x33, 0xbc, 0x41, 0x3e,
+	0x02, 0x82, 0xed, 0x45, 0x9e, 0x98, 0x74, 0x71, 0xe0, 0x9f, 0xb3, 0x40, 0xd7, 0xc0, 0x22, 0x72,
+	0x9e, 0x20, 0xe3, 0x58, 0xd2, 0x27, 0xca, 0x81, 0x86, 0x26, 0x10, 0x3a, 0x2e, 0x07, 0x0a, 0xd8,
+	0x80, 0xec, 0xa8, 0x8f, 0x96, 0x92, 0x6f, 0x51, 0x3b, 0xc7, 0x62, 0xd5, 0xbf, 0xc4, 0x60, 0x7d,
+	0xee, 0xc1, 0x91, 0x26, 0x2c, 0x77, 0x7c, 0xef, 0xd4, 0x75, 0x3a, 0xb8, 0x6f, 0xac, 0xb2, 0x3a,
+	0x42, 0x77, 0xe6, 0x24, 0x00, 0x16, 0x55, 0xb3, 0x18, 0x11, 0x43, 0x8a, 0xac, 0x0d, 0xb2, 0xbe,
+	0xfa, 0x9e, 0xa5, 0x5b, 0x40, 0x0c, 0x7d, 0xca, 0x2b, 0xe2, 0x63, 0xa4, 0x91, 0x43, 0x58, 0x69,
+	0x5f, 0x3c, 0xa7, 0x9e, 0x70, 0x3c, 0x16, 0xa9, 0x72, 0xa5, 0x78, 0x25, 0xbe, 0x95, 0xbb, 0x7f,
+	0x7b, 0x46, 0x94, 0x43, 0x8c, 0xf9, 0x9d, 0x91, 0xe0, 0x88, 0xc6, 0xe7, 0x04, 0x3e, 0x31, 0x27,
+	0xf0, 0x37, 0x11, 0xcf, 0xbf, 0x1a, 0xb0, 0x3a, 0x33, 0x39, 0x23, 0xcd, 0xcf, 0x98, 0x68, 0x7e,
+	0xab, 0x90, 0x72, 0xb8, 0x15, 0xf8, 0xe7, 0x18, 0x91, 0x8c, 0x99, 0x74, 0xb8, 0xe9, 0x9f, 0x8f,
+	0xa7, 0x8d, 0x78, 0x74, 0xda, 0xf8, 0x14, 0x52, 0xba, 0xdd, 0x24, 0x2a, 0xf1, 0xd9, 0xa7, 0x80,
+	0x1d, 0x07, 0x4d, 0x9a, 0x1a, 0x7b, 0x23, 0x8e, 0x0d, 0x00, 0xc6, 0x9a, 0xe7, 0xcf, 0x42, 0x68,
+	0x31, 0x9c, 0x85, 0x70, 0x41, 0x7e, 0x3c, 0x39, 0x0b, 0xcd, 0xa8, 0x7d, 0x87, 0x07, 0x27, 0x33,
+	0xa6, 0x21, 0x06, 0x99, 0x90, 0x81, 0x9a, 0x05, 0x0d, 0x54, 0xec, 0x92, 0xa6, 0x5a, 0xc8, 0xb6,
+	0xc0, 0x3c, 0x35, 0x72, 0x24, 0x4d, 0xf9, 0x29, 0x71, 0x9e, 0x6f, 0x33, 0x95, 0x31, 0x79, 0x53,
+	0x2d, 0x64, 0xf7, 0x75, 0x19, 0x3d, 0x55, 0x3d, 0x29, 0xa1, 0xba, 0xaf, 0x24, 0xc8, 0x7e, 0x54,
+	0xdd, 0x87, 0x7c, 0xb4, 0x7a, 0xcb, 0x6a, 0x1d, 0xa9, 0xa9, 0xf1, 0xd9, 0x3b, 0x0e, 0x25, 0xae,
+	0xd7, 0xfa, 0xea, 0x03, 0x58, 0x9b, 0xdd, 0x65, 0xc8, 0xf7, 0x61, 0x31, 0xa0, 0xe7, 0xaa, 0x45,
+	0x59, 0xae, 0xc3, 0x85, 0x6e, 0x67, 0xf9, 0x80, 0x9e, 0x23, 0x42, 0x5a, 0xaf, 0xfe, 0x02, 0x32,
+	0x61, 0x27, 0x20, 0x0f, 0xa0, 0x10, 0x76, 0x81, 0xb1, 0xc0, 0xcc, 0xe1, 0x46, 0x8b, 0x98, 0xf9,
+	0x10, 0x8f, 0xba, 0x3e, 0x87, 0xb4, 0x66, 0x90, 0xef, 0x42, 0xde, 0xa3, 0x7d, 0xc6, 0x07, 0xb4,
+	0xc3, 0xe4, 0x98, 0xa4, 0xc6, 0xca, 0xdc, 0x88, 0xd6, 0xb4, 0xe5, 0xc4, 0x69, 0x53, 0x41, 0xc3,
+	0xd1, 0x57, 0x7e, 0x57, 0x7f, 0x05, 0x6b, 0xb2, 0xff, 0xd6, 0xcf, 0xa8, 0xe3, 0xd2, 0xb6, 0xe3,
+	0x3a, 0xe2, 0x42, 0x4f, 0x8c, 0xb7, 0x21, 0x1b, 0xf8, 0xda, 0x1b, 0xed, 0x48, 0x26, 0xf0, 0x95,
+	0x23, 0xd2, 0x5a, 0xc7, 0x77, 0x87, 0x7d, 0x6f, 0xd4, 0x90, 0x25, 0x3f, 0xa7, 0x68, 0x08, 0xa9,
+	0xfe, 0x27, 0x06, 0x09, 0x59, 0x22, 0xc9, 0x27, 0x90, 0x90, 0x3e, 0xe0, 0x8e, 0x16, 0x67, 0x4d,
+	0xb2, 0x2d, 0xa7, 0xeb, 0x31, 0xfb, 0x80, 0x77, 0x4f, 0x2e, 0x06, 0xcc, 0x44, 0x70, 0xe4, 0x2e,
+	0xc5, 0x26, 0xee, 0xd2, 0x0a, 0x24, 0x03, 0x7f, 0xe8, 0xd9, 0x98, 0x6a, 0x49, 0x53, 0x2d, 0xc8,
+	0x1e, 0x64, 0x46, 0xf3, 0x61, 0xe2, 0x9b, 0xe6, 0xc3, 0x25, 0x79, 0xa0, 0x72, 0x7a, 0xd5, 0x04,
+	0x33, 0xdd, 0xd6, 0x63, 0xe2, 0x0d, 0xdc, 0x22, 0xf2, 0x21, 0x2c, 0x8f, 0x6b, 0x7b, 0x38, 0xfd,
+	0xa8, 0x59, 0xb3, 0x38, 0x62, 0xe8, 0xf1, 0x67, 0xb2, 0x11, 0xa8, 0xeb, 0x96, 0x46, 0xbf, 0xc6,
+	0x8d, 0xa0, 0x89, 0xf7, 0xee, 0x0e, 0x64, 0xb9, 0xd3, 0xf5, 0xa8, 0x18, 0x06, 0x4c, 0x4f, 0x97,
+	0x63, 0x42, 0xf5, 0xdf, 0x06, 0xa4, 0xd4, 0x0c, 0x3b, 0xb7, 0x06, 0x8d, 0xe2, 0x16, 0x9b, 0x17,
+	0xb7, 0xf8, 0xbb, 0xc7, 0xad, 0x0e, 0x30, 0xda, 0x4c, 0x58, 0xb7, 0x66, 0x94, 0x72, 0xb5, 0xc5,
+	0x96, 0xd3, 0xd5, 0x77, 0x2a, 0x22, 0x44, 0x36, 0x21, 0xa7, 0x1e, 0x3c, 0xea, 0x0a, 0x27, 0xd1,
+	0x45, 0x50, 0x24, 0xbc, 0xc4, 0xff, 0x30, 0x20, 0x3b, 0x52, 0x40, 0xea, 0x50, 0x08, 0x37, 0x6e,
+	0x9d, 0xba, 0xb4, 0xab, 0x93, 0xeb, 0xee, 0xdc, 0xdd, 0x3f, 0x74, 0x69, 0xd7, 0xcc, 0xe9, 0x0d,
+	0xcb, 0xc5, 0xec, 0x83, 0x8a, 0xcd, 0x39, 0xa8, 0x89, 0xcc, 0x88, 0xbf, 0x5b, 0x66, 0x4c, 0x9c,
+	0x61, 0xe2, 0xfa, 0x19, 0x7e, 0x15, 0x87, 0xcc, 0x31, 0x4e, 0xc7, 0xd4, 0xfd, 0x36, 0xae, 0xcc,
+	0x6d, 0xc8, 0x0e, 0x7c, 0xd7, 0x52, 0x9c, 0x04, 0x72, 0x32, 0x03, 0xdf, 0x35, 0xa7, 0xf2, 0x22,
+	0x79, 0x43, 0xf7, 0x29, 0x75, 0x03, 0x51, 0x4b, 0x5f, 0x8b, 0x1a, 0x69, 0xc9, 0x57, 0x57, 0xf8,
+	0x54, 0xce, 0xcc, 0x7b, 0x15, 0xcc, 0xae, 0x70, 0x8d, 0xfc, 0xeb, 0x57, 0x9b, 0x99, 0xdd, 0xba,
+	0x5a, 0xc9, 0xd7, 0x9a, 0xfa, 0xaa, 0x06, 0x90, 0x57, 0xf1, 0x55, 0x6b, 0x72, 0x4f, 0x06, 0x16,
+	0x2d, 0x18, 0xd3, 0x6f, 0x7d, 0x65, 0x41, 0xeb, 0x48, 0xf5, 0x46, 0x12, 0xea, 0xa9, 0x59, 0x8a,
+	0xcd, 0x93, 0x50, 0xb9, 0x6c, 0x6a, 0x5c, 0xf5, 0xbf, 0x06, 0xc0, 0x78, 0xba, 0x92, 0xaf, 0x5e,
+	0x8e, 0x5b, 0xb0, 0x26, 0x2c, 0x97, 0xe7, 0x65, 0x82, 0xb6, 0x9f, 0xe7, 0xd1, 0x7d, 0xef, 0x40,
+	0x61, 0x9c, 0xe1, 0x9c, 0x85, 0x9b, 0x29, 0xff, 0x9f, 0x21, 0xab, 0xc5, 0x84, 0x99, 0x3f, 0x8b,
+	0xac, 0x26, 0x23, 0x1c, 0xbf, 0xa1, 0x08, 0xff, 0x2e, 0x06, 0x59, 0x74, 0xf4, 0x80, 0x09, 0x3a,
+	0x91, 0x6d, 0xc6, 0xbb, 0x67, 0xdb, 0x5d, 0x00, 0xa5, 0x86, 0x3b, 0xcf, 0x99, 0xbe, 0x03, 0x59,
+	0xa4, 0xb4, 0x9c, 0xe7, 0x72, 0x48, 0x49, 0x4d, 0x78, 0x31, 0xf7, 0x14, 0x75, 0x75, 0x0a, 0xcf,
+	0xf2, 0x16, 0xa4, 0xe5, 0x3f, 0x02, 0xf2, 0x75, 0xaa, 0xc6, 0xca, 0x94, 0x37, 0xec, 0x9f, 0x3c,
+	0xe3, 0x64, 0x2f, 0x1a, 0x99, 0xe4, 0xdb, 0x45, 0x26, 0x12, 0x8b, 0x36, 0xe4, 0x4f, 0x9e, 0x45,
+	0x06, 0xaf, 0xb0, 0x4b, 0x1b, 0xe3, 0x2e, 0x4d, 0x1e, 0x00, 0xc8, 0x5e, 0x8c, 0x53, 0x93, 0x6a,
+	0xb6, 0x33, 0xff, 0x12, 0x32, 0xfd, 0xf3, 0xb1, 0x22, 0x53, 0xb6, 0x6f, 0xfc, 0xe2, 0xd5, 0x3f,
+	0x1b, 0x50, 0x98, 0x60, 0x86, 0xdd, 0x3d, 0x3a, 0xe2, 0xc9, 0xee, 0xae, 0xba, 0xcd, 0x3a, 0x64,
+	0xc2, 0xd6, 0xaf, 0x2b, 0x62, 0x5a, 0x77, 0x7e, 0xb2, 0x0b, 0x8b, 0x21, 0xcb, 0x7a, 0x9b, 0xff,
+	0xbf, 0xf2, 0x5a, 0x81, 0xb2, 0xfe, 0x4e, 0x43, 0xee, 0x07, 0x7f, 0x33, 0x20, 0x17, 0x29, 0xe7,
+	0xe4, 0x47, 0xb0, 0xda, 0xd8, 0x3f, 0xda, 0xf9, 0xc2, 0x6a, 0xee, 0x5a, 0x0f, 0xf7, 0xeb, 0x8f,
+	0xac, 0x2f, 0x0f, 0xbf, 0x38, 0x3c, 0xfa, 0xe5, 0x61, 0x71, 0x61, 0x63, 0xed, 0xf2, 0xaa, 0x42,
+	0x22, 0xd8, 0x2f, 0xbd, 0xa7, 0x9e, 0x7f, 0xee, 0x91, 0x6d, 0x58, 0x99, 0x14, 0xa9, 0x37, 0x5a,
+	0x7b, 0x87, 0x27, 0x45, 0x63, 0x63, 0xf5, 0xf2, 0xaa, 0xb2, 0x1c, 0x91, 0xa8, 0xb7, 0x39, 0xf3,
+	0xc4, 0xb4, 0xc0, 0xce, 0xd1, 0xc1, 0x41, 0xf3, 0xa4, 0x18, 0x9b, 0x12, 0xd0, 0x0d, 0xf8, 0x7d,
+	0x58, 0x9e, 0x14, 0x38, 0x6c, 0xee, 0x17, 0xe3, 0x1b, 0xe4, 0xf2, 0xaa, 0xb2, 0x18, 0x41, 0x1f,
+	0x3a, 0xee, 0x46, 0xe6, 0xab, 0x3f, 0x94, 0x17, 0xfe, 0xf4, 0xc7, 0xb2, 0x21, 0x3d, 0x2b, 0x4c,
+	0x94, 0x74, 0xf2, 0x11, 0xdc, 0x6a, 0x35, 0x1f, 0x1d, 0xee, 0xed, 0x5a, 0x07, 0xad, 0x47, 0xd6,
+	0xc9, 0xaf, 0x8f, 0xf7, 0x22, 0xde, 0x2d, 0x5d, 0x5e, 0x55, 0x72, 0xda, 0xa5, 0x79, 0xe8, 0x63,
+	0x73, 0xef, 0xc9, 0xd1, 0xc9, 0x5e, 0xd1, 0x50, 0xe8, 0xe3, 0x80, 0xc9, 0x97, 0x2a, 0xa2, 0xef,
+	0xc1, 0xfa, 0x0c, 0xf4, 0xc8, 0xb1, 0xe5, 0xcb, 0xab, 0x4a, 0xe1, 0x38, 0x60, 0xaa, 0x32, 0xa1,
+	0x44, 0x0d, 0x4a, 0xd3, 0x12, 0x47, 0xc7, 0x47, 0xad, 0xfa, 0x7e, 0xb1, 0xb2, 0x51, 0xbc, 0xbc,
+	0xaa, 0xe4, 0xc3, 0xde, 0x25, 0xf1, 0x63, 0xcf, 0x1a, 0x4f, 0x5e, 0xbe, 0x2e, 0x1b, 0x5f, 0xbf,
+	0x2e, 0x1b, 0xff, 0x7a, 0x5d, 0x36, 0x5e, 0xbc, 0x29, 0x2f, 0x7c, 0xfd, 0xa6, 0xbc, 0xf0, 0xf7,
+	0x37, 0xe5, 0x85, 0xdf, 0x7c, 0xd6, 0x75, 0x44, 0x6f, 0xd8, 0xae, 0x75, 0xfc, 0xfe, 0xb6, 0x4b,
+	0x9f, 0x5f, 0xb8, 0xcc, 0xee, 0xb2, 0x20, 0xf2, 0xf9, 0x71, 0xc7, 0x0f, 0xf4, 0x1f, 0xca, 0xdb,
+	0xd7, 0xff, 0xfd, 0x6d, 0xa7, 0x90, 0xfe, 0xc9, 0xff, 0x06, 0x00, 0x5e, 0xde, 0x1b, 0xb6, 0xbe,
+	0x16, 0x00, 0x00,
 }
 
 func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
@@ -2116,6 +2417,27 @@ func (m *Evidence_LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Evidence_BadEncodingFraudProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence_BadEncodingFraudProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BadEncodingFraudProof != nil {
+		{
+			size, err := m.BadEncodingFraudProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DuplicateVoteEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2136,12 +2458,12 @@ func (m *DuplicateVoteEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err11 != nil {
-		return 0, err11
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n11
-	i = encodeVarintTypes(dAtA, i, uint64(n11))
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x2a
 	if m.ValidatorPower != 0 {
@@ -2201,12 +2523,12 @@ func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
-	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err14 != nil {
-		return 0, err14
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n14
-	i = encodeVarintTypes(dAtA, i, uint64(n14))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x2a
 	if m.TotalVotingPower != 0 {
@@ -2248,7 +2570,7 @@ func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *EvidenceList) Marshal() (dAtA []byte, err error) {
+func (m *BadEncodingFraudProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2258,20 +2580,28 @@ func (m *EvidenceList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EvidenceList) MarshalTo(dAtA []byte) (int, error) {
+func (m *BadEncodingFraudProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EvidenceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BadEncodingFraudProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Evidence) > 0 {
-		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintTypes(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2279,13 +2609,33 @@ func (m *EvidenceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTypes(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x22
 		}
 	}
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsRow {
+		i--
+		if m.IsRow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *IntermediateStateRoots) Marshal() (dAtA []byte, err error) {
+func (m *ShareProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2295,26 +2645,157 @@ func (m *IntermediateStateRoots) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IntermediateStateRoots) MarshalTo(dAtA []byte) (int, error) {
+func (m *ShareProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IntermediateStateRoots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ShareProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RawRootsList) > 0 {
-		for iNdEx := len(m.RawRootsList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RawRootsList[iNdEx])
-			copy(dAtA[i:], m.RawRootsList[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.RawRootsList[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NMTProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NMTProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NMTProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeafHash) > 0 {
+		i -= len(m.LeafHash)
+		copy(dAtA[i:], m.LeafHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LeafHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Nodes[iNdEx])
+			copy(dAtA[i:], m.Nodes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Nodes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.End != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvidenceList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidenceList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvidenceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntermediateStateRoots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntermediateStateRoots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntermediateStateRoots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RawRootsList) > 0 {
+		for iNdEx := len(m.RawRootsList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RawRootsList[iNdEx])
+			copy(dAtA[i:], m.RawRootsList[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.RawRootsList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Messages) Marshal() (dAtA []byte, err error) {
@@ -2471,12 +2952,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
-	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err16 != nil {
-		return 0, err16
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err19 != nil {
+		return 0, err19
 	}
-	i -= n16
-	i = encodeVarintTypes(dAtA, i, uint64(n16))
+	i -= n19
+	i = encodeVarintTypes(dAtA, i, uint64(n19))
 	i--
 	dAtA[i] = 0x2a
 	{
@@ -2598,12 +3079,12 @@ func (m *CommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err19 != nil {
-		return 0, err19
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err22 != nil {
+		return 0, err22
 	}
-	i -= n19
-	i = encodeVarintTypes(dAtA, i, uint64(n19))
+	i -= n22
+	i = encodeVarintTypes(dAtA, i, uint64(n22))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.ValidatorAddress) > 0 {
@@ -2660,12 +3141,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err21 != nil {
-		return 0, err21
+	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err24 != nil {
+		return 0, err24
 	}
-	i -= n21
-	i = encodeVarintTypes(dAtA, i, uint64(n21))
+	i -= n24
+	i = encodeVarintTypes(dAtA, i, uint64(n24))
 	i--
 	dAtA[i] = 0x32
 	{
@@ -2872,7 +3353,7 @@ func (m *BlockMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TxProof) Marshal() (dAtA []byte, err error) {
+func (m *TxShareProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2882,41 +3363,95 @@ func (m *TxProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxShareProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxShareProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Proof != nil {
-		{
-			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.RowProofs) > 0 {
+		for iNdEx := len(m.RowProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RowProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
 		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RowShareProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RowShareProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RowShareProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.RowRootProof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RowRoot) > 0 {
+		i -= len(m.RowRoot)
+		copy(dAtA[i:], m.RowRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RowRoot)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.RootHash) > 0 {
-		i -= len(m.RootHash)
-		copy(dAtA[i:], m.RootHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.RootHash)))
+	if m.RowIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RowIndex))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
@@ -3099,6 +3634,18 @@ func (m *Evidence_LightClientAttackEvidence) Size() (n int) {
 	}
 	return n
 }
+func (m *Evidence_BadEncodingFraudProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BadEncodingFraudProof != nil {
+		l = m.BadEncodingFraudProof.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *DuplicateVoteEvidence) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3151,6 +3698,75 @@ func (m *LightClientAttackEvidence) Size() (n int) {
 	return n
 }
 
+func (m *BadEncodingFraudProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.IsRow {
+		n += 2
+	}
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ShareProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *NMTProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovTypes(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovTypes(uint64(m.End))
+	}
+	if len(m.Nodes) > 0 {
+		for _, b := range m.Nodes {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.LeafHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *EvidenceList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3410,30 +4026,52 @@ func (m *BlockMeta) Size() (n int) {
 	return n
 }
 
-func (m *TxProof) Size() (n int) {
+func (m *TxShareProof) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RootHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
 	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.RowProofs) > 0 {
+		for _, e := range m.RowProofs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
 	}
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *RowShareProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RowIndex != 0 {
+		n += 1 + sovTypes(uint64(m.RowIndex))
+	}
+	l = len(m.RowRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.RowRootProof.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozTypes(x uint64) (n int) {
 	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -4613,6 +5251,41 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Evidence_LightClientAttackEvidence{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadEncodingFraudProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BadEncodingFraudProof{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_BadEncodingFraudProof{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4737,12 +5410,508 @@ func (m *DuplicateVoteEvidence) Unmarshal(dAtA []byte) error {
 			if err := m.VoteB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+			}
+			m.ValidatorPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttackEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttackEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConflictingBlock == nil {
+				m.ConflictingBlock = &LightBlock{}
+			}
+			if err := m.ConflictingBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonHeight", wireType)
+			}
+			m.CommonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommonHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByzantineValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByzantineValidators = append(m.ByzantineValidators, &Validator{})
+			if err := m.ByzantineValidators[len(m.ByzantineValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BadEncodingFraudProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BadEncodingFraudProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BadEncodingFraudProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRow = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, &ShareProof{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.TotalVotingPower = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4752,16 +5921,16 @@ func (m *DuplicateVoteEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalVotingPower |= int64(b&0x7F) << shift
+				m.Index |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
 			}
-			m.ValidatorPower = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4771,14 +5940,29 @@ func (m *DuplicateVoteEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorPower |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4805,7 +5989,7 @@ func (m *DuplicateVoteEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4833,7 +6017,7 @@ func (m *DuplicateVoteEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
+func (m *NMTProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4856,17 +6040,17 @@ func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LightClientAttackEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: NMTProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LightClientAttackEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NMTProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingBlock", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var msglen int
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4876,33 +6060,16 @@ func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Start |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConflictingBlock == nil {
-				m.ConflictingBlock = &LightBlock{}
-			}
-			if err := m.ConflictingBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			m.CommonHeight = 0
+			m.End = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4912,16 +6079,16 @@ func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommonHeight |= int64(b&0x7F) << shift
+				m.End |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByzantineValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4931,50 +6098,29 @@ func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ByzantineValidators = append(m.ByzantineValidators, &Validator{})
-			if err := m.ByzantineValidators[len(m.ByzantineValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Nodes = append(m.Nodes, make([]byte, postIndex-iNdEx))
+			copy(m.Nodes[len(m.Nodes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
-			}
-			m.TotalVotingPower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalVotingPower |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4984,23 +6130,24 @@ func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LeafHash = append(m.LeafHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LeafHash == nil {
+				m.LeafHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6896,7 +8043,7 @@ func (m *BlockMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxProof) Unmarshal(dAtA []byte) error {
+func (m *TxShareProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6919,15 +8066,15 @@ func (m *TxProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxShareProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxShareProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6954,14 +8101,120 @@ func (m *TxProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.RootHash == nil {
-				m.RootHash = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RowProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RowProofs = append(m.RowProofs, &RowShareProof{})
+			if err := m.RowProofs[len(m.RowProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RowShareProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RowShareProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RowShareProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowIndex", wireType)
+			}
+			m.RowIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6988,14 +8241,14 @@ func (m *TxProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.RowRoot = append(m.RowRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.RowRoot == nil {
+				m.RowRoot = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RowRootProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7022,10 +8275,41 @@ func (m *TxProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proof == nil {
-				m.Proof = &crypto.Proof{}
+			if err := m.RowRootProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, &ShareProof{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -465,6 +465,25 @@ func (c *baseRPCClient) DataAvailabilityHeader(
 	return result, nil
 }
 
+func (c *baseRPCClient) NamespacedShares(
+	ctx context.Context,
+	height *int64,
+	namespaceID []byte,
+) (*ctypes.ResultNamespacedShares, error) {
+	result := new(ctypes.ResultNamespacedShares)
+	params := map[string]interface{}{
+		"namespace_id": namespaceID,
+	}
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "namespaced_shares", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	result := new(ctypes.ResultTx)
 	params := map[string]interface{}{
@@ -176,6 +176,14 @@ func (c *Local) DataAvailabilityHeader(
 	return core.DataAvailabilityHeader(c.ctx, height)
 }
 
+func (c *Local) NamespacedShares(
+	ctx context.Context,
+	height *int64,
+	namespaceID []byte,
+) (*ctypes.ResultNamespacedShares, error) {
+	return core.NamespacedShares(c.ctx, height, namespaceID)
+}
+
 func (c *Local) Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
 	return core.Validators(c.ctx, height, page, perPage)
 }
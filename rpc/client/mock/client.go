@@ -177,6 +177,14 @@ func (c Client) DataAvailabilityHeader(
 	return core.DataAvailabilityHeader(&rpctypes.Context{}, height)
 }
 
+func (c Client) NamespacedShares(
+	ctx context.Context,
+	height *int64,
+	namespaceID []byte,
+) (*ctypes.ResultNamespacedShares, error) {
+	return core.NamespacedShares(&rpctypes.Context{}, height, namespaceID)
+}
+
 func (c Client) Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
 	return core.Validators(&rpctypes.Context{}, height, page, perPage)
 }
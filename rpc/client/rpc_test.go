@@ -489,7 +489,9 @@ func TestTx(t *testing.T) {
 				// time to verify the proof
 				proof := ptx.Proof
 				if tc.prove && assert.EqualValues(t, tx, proof.Data) {
-					assert.NoError(t, proof.Proof.Verify(proof.RootHash, txHash))
+					block, err := c.Block(context.Background(), &ptx.Height)
+					require.NoError(t, err)
+					assert.NoError(t, proof.Validate(block.Block.DataHash))
 				}
 			}
 		}
@@ -548,7 +550,9 @@ func TestTxSearch(t *testing.T) {
 
 		// time to verify the proof
 		if assert.EqualValues(t, find.Tx, ptx.Proof.Data) {
-			assert.NoError(t, ptx.Proof.Proof.Verify(ptx.Proof.RootHash, find.Hash))
+			block, err := c.Block(context.Background(), &ptx.Height)
+			require.NoError(t, err)
+			assert.NoError(t, ptx.Proof.Validate(block.Block.DataHash))
 		}
 
 		// query by height
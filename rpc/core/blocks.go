@@ -1,12 +1,16 @@
 package core
 
 import (
+	"bytes"
 	"fmt"
 
+	tmbytes "github.com/lazyledger/lazyledger-core/libs/bytes"
 	tmmath "github.com/lazyledger/lazyledger-core/libs/math"
+	"github.com/lazyledger/lazyledger-core/p2p/ipld"
 	ctypes "github.com/lazyledger/lazyledger-core/rpc/core/types"
 	rpctypes "github.com/lazyledger/lazyledger-core/rpc/jsonrpc/types"
 	"github.com/lazyledger/lazyledger-core/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
 )
 
 // BlockchainInfo gets block headers for minHeight <= height <= maxHeight.
@@ -142,27 +146,86 @@ func Commit(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, erro
 	return ctypes.NewResultCommit(&header, commit, true), nil
 }
 
-// DataAvailabilityHeader TODO
+// DataAvailabilityHeader gets the DataAvailabilityHeader of the block at a
+// given height. It is served from the block store without retrieving any
+// block data.
+// If no height is provided, it will fetch the header of the latest block.
 func DataAvailabilityHeader(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultDataAvailabilityHeader, error) {
 	height, err := getHeight(env.BlockStore.Height(), heightPtr)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: store DAHeader to avoid loading the full block each time
-	// depends on either:
-	// - https://github.com/lazyledger/lazyledger-core/pull/312, or
-	// - https://github.com/lazyledger/lazyledger-core/pull/218
-	block, err := env.BlockStore.LoadBlock(ctx.Context(), height)
-	if err != nil {
-		return &ctypes.ResultDataAvailabilityHeader{
-			DataAvailabilityHeader: types.DataAvailabilityHeader{},
-		}, err
+	dah := env.BlockStore.LoadDAHeader(height)
+	if dah == nil {
+		return nil, fmt.Errorf("data availability header at height %d is not available", height)
 	}
-	_ = block.Hash()
-	dah := block.DataAvailabilityHeader
 	return &ctypes.ResultDataAvailabilityHeader{
-		DataAvailabilityHeader: dah,
+		DataAvailabilityHeader: *dah,
+	}, nil
+}
+
+// NamespacedShares gets all shares and messages of the given namespace at a
+// given height together with NMT proofs against the row roots of the block's
+// DataAvailabilityHeader. Rows whose namespace range covers the namespace but
+// do not contain it are returned with a proof of absence.
+// If no height is provided, it will fetch the shares of the latest block.
+func NamespacedShares(
+	ctx *rpctypes.Context,
+	heightPtr *int64,
+	namespaceID []byte,
+) (*ctypes.ResultNamespacedShares, error) {
+	height, err := getHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(namespaceID) != consts.NamespaceSize {
+		return nil, fmt.Errorf("namespace ID must be %d bytes long, got %d", consts.NamespaceSize, len(namespaceID))
+	}
+
+	blockMeta := env.BlockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return nil, fmt.Errorf("block meta not found for height %d", height)
+	}
+
+	rows, err := ipld.GetSharesByNamespace(ctx.Context(), env.DAG, &blockMeta.DAHeader, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		shares    [][]byte
+		rowShares = make([]types.NamespacedRowShares, len(rows))
+	)
+	for i, row := range rows {
+		hexShares := make([]tmbytes.HexBytes, len(row.Shares))
+		for j, share := range row.Shares {
+			hexShares[j] = share
+		}
+
+		rowShares[i] = types.NamespacedRowShares{
+			RowIndex: row.RowIndex,
+			Shares:   hexShares,
+			Proof:    types.NewNMTProof(row.Proof),
+		}
+		shares = append(shares, row.Shares...)
+	}
+
+	// only shares of non-reserved namespaces are laid out as messages
+	msgs := types.MessagesEmpty
+	if bytes.Compare(namespaceID, consts.MaxReservedNamespace) > 0 {
+		msgs, err = types.ParseMessages(shares)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ctypes.ResultNamespacedShares{
+		Height:      height,
+		NamespaceID: namespaceID,
+		Rows:        rowShares,
+		Messages:    msgs.MessagesList,
 	}, nil
 }
 
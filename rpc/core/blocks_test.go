@@ -124,6 +124,9 @@ func (store mockBlockStore) Height() int64                         { return stor
 func (store mockBlockStore) Size() int64                           { return store.height }
 func (mockBlockStore) LoadBaseMeta() *types.BlockMeta              { return nil }
 func (mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta { return nil }
+func (mockBlockStore) LoadDAHeader(height int64) *types.DataAvailabilityHeader {
+	return nil
+}
 func (mockBlockStore) LoadBlock(ctx context.Context, height int64) (*types.Block, error) {
 	return nil, nil
 }
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	format "github.com/ipfs/go-ipld-format"
+
 	cfg "github.com/lazyledger/lazyledger-core/config"
 	"github.com/lazyledger/lazyledger-core/consensus"
 	"github.com/lazyledger/lazyledger-core/crypto"
@@ -62,6 +64,11 @@ type peers interface {
 	Peers() p2p.IPeerSet
 }
 
+type publisher interface {
+	QueueDepth() int
+	QueueSize() int
+}
+
 //----------------------------------------------
 // Environment contains objects and interfaces used by the RPC. It is expected
 // to be setup once during startup.
@@ -69,6 +76,7 @@ type Environment struct {
 	// external, thread safe interfaces
 	ProxyAppQuery   proxy.AppConnQuery
 	ProxyAppMempool proxy.AppConnMempool
+	DAG             format.DAGService
 
 	// interfaces defined in types and above
 	StateStore     sm.Store
@@ -77,6 +85,7 @@ type Environment struct {
 	ConsensusState Consensus
 	P2PPeers       peers
 	P2PTransport   transport
+	Publisher      publisher
 
 	// objects
 	PubKey           crypto.PubKey
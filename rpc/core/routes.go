@@ -25,6 +25,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"commit":                   rpc.NewRPCFunc(Commit, "height"),
 	"check_tx":                 rpc.NewRPCFunc(CheckTx, "tx"),
 	"data_availability_header": rpc.NewRPCFunc(DataAvailabilityHeader, "height"),
+	"namespaced_shares":        rpc.NewRPCFunc(NamespacedShares, "height,namespace_id"),
 	"tx":                       rpc.NewRPCFunc(Tx, "hash,prove"),
 	"tx_search":                rpc.NewRPCFunc(TxSearch, "query,prove,page,per_page,order_by"),
 	"validators":               rpc.NewRPCFunc(Validators, "height,page,per_page"),
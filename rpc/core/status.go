@@ -70,6 +70,12 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 			VotingPower: votingPower,
 		},
 	}
+	if env.Publisher != nil {
+		result.PublishInfo = ctypes.PublishInfo{
+			QueueDepth: env.Publisher.QueueDepth(),
+			QueueSize:  env.Publisher.QueueSize(),
+		}
+	}
 
 	return result, nil
 }
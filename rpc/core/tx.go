@@ -35,13 +35,12 @@ func Tx(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error
 	height := r.Height
 	index := r.Index
 
-	var proof types.TxProof
+	var proof types.TxShareProof
 	if prove {
-		block, err := env.BlockStore.LoadBlock(ctx.Context(), height)
+		proof, err = txShareProof(ctx, height, index)
 		if err != nil {
 			return nil, err
 		}
-		proof = block.Data.Txs.Proof(int(index)) // XXX: overflow on 32-bit machines
 	}
 
 	return &ctypes.ResultTx{
@@ -108,13 +107,12 @@ func TxSearch(ctx *rpctypes.Context, query string, prove bool, pagePtr, perPageP
 	for i := skipCount; i < skipCount+pageSize; i++ {
 		r := results[i]
 
-		var proof types.TxProof
+		var proof types.TxShareProof
 		if prove {
-			block, err := env.BlockStore.LoadBlock(ctx.Context(), r.Height)
+			proof, err = txShareProof(ctx, r.Height, r.Index)
 			if err != nil {
 				return nil, err
 			}
-			proof = block.Data.Txs.Proof(int(r.Index)) // XXX: overflow on 32-bit machines
 		}
 
 		apiResults = append(apiResults, &ctypes.ResultTx{
@@ -129,3 +127,18 @@ func TxSearch(ctx *rpctypes.Context, query string, prove bool, pagePtr, perPageP
 
 	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
 }
+
+// txShareProof computes the TxShareProof of the tx with the given index in the
+// block at the given height from the extended data square committed to by the
+// block.
+func txShareProof(ctx *rpctypes.Context, height int64, index uint32) (types.TxShareProof, error) {
+	block, err := env.BlockStore.LoadBlock(ctx.Context(), height)
+	if err != nil {
+		return types.TxShareProof{}, err
+	}
+	if block == nil {
+		return types.TxShareProof{}, fmt.Errorf("block at height %d is not available", height)
+	}
+
+	return block.TxShareProof(int(index)) // XXX: overflow on 32-bit machines
+}
@@ -39,6 +39,15 @@ type ResultDataAvailabilityHeader struct {
 	types.DataAvailabilityHeader `json:"data_availability_header"`
 }
 
+// Shares and messages of a single namespace at a height together with their
+// proofs against the row roots of the block's DataAvailabilityHeader
+type ResultNamespacedShares struct {
+	Height      int64                       `json:"height"`
+	NamespaceID bytes.HexBytes              `json:"namespace_id"`
+	Rows        []types.NamespacedRowShares `json:"rows"`
+	Messages    []types.Message             `json:"messages"`
+}
+
 // ABCI results from a block
 type ResultBlockResults struct {
 	Height                int64                     `json:"height"`
@@ -85,11 +94,18 @@ type ValidatorInfo struct {
 	VotingPower int64          `json:"voting_power"`
 }
 
+// Info about the backlog of block data to be put to IPFS
+type PublishInfo struct {
+	QueueDepth int `json:"queue_depth"`
+	QueueSize  int `json:"queue_size"`
+}
+
 // Node Status
 type ResultStatus struct {
 	NodeInfo      p2p.DefaultNodeInfo `json:"node_info"`
 	SyncInfo      SyncInfo            `json:"sync_info"`
 	ValidatorInfo ValidatorInfo       `json:"validator_info"`
+	PublishInfo   PublishInfo         `json:"publish_info"`
 }
 
 // Is TxIndexing enabled
@@ -190,7 +206,7 @@ type ResultTx struct {
 	Index    uint32                 `json:"index"`
 	TxResult abci.ResponseDeliverTx `json:"tx_result"`
 	Tx       types.Tx               `json:"tx"`
-	Proof    types.TxProof          `json:"proof,omitempty"`
+	Proof    types.TxShareProof     `json:"proof,omitempty"`
 }
 
 // Result of searching for txs
@@ -48,6 +48,13 @@ type (
 	ErrNoABCIResponsesForHeight struct {
 		Height int64
 	}
+
+	ErrIntermediateStateRootMismatch struct {
+		Height   int64
+		TxIndex  int
+		Expected []byte
+		Got      []byte
+	}
 )
 
 func (e ErrUnknownBlock) Error() string {
@@ -99,3 +106,13 @@ func (e ErrNoConsensusParamsForHeight) Error() string {
 func (e ErrNoABCIResponsesForHeight) Error() string {
 	return fmt.Sprintf("could not find results for height #%d", e.Height)
 }
+
+func (e ErrIntermediateStateRootMismatch) Error() string {
+	return fmt.Sprintf(
+		"intermediate state root (%X) of tx #%d at height %d does not match the one computed by the app (%X)",
+		e.Expected,
+		e.TxIndex,
+		e.Height,
+		e.Got,
+	)
+}
@@ -1,11 +1,14 @@
 package state
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"github.com/lazyledger/nmt/namespace"
+
 	abci "github.com/lazyledger/lazyledger-core/abci/types"
 	cryptoenc "github.com/lazyledger/lazyledger-core/crypto/encoding"
 	"github.com/lazyledger/lazyledger-core/libs/fail"
@@ -119,13 +122,6 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 		bzs[i] = txs[i]
 	}
 
-	// TODO(ismail):
-	//  1. get those intermediate state roots & messages either from the
-	//     mempool or from the abci-app
-	//  1.1 at this point we should now the square / block size:
-	//      https://github.com/lazyledger/lazyledger-specs/blob/53e5f350838f1e0785ad670704bf91dac2f4f5a3/specs/block_proposer.md#deciding-on-a-block-size
-	//      Here, we instead assume a fixed (max) square size instead.
-	//  2. feed them into MakeBlock below:
 	processedBlockTxs, err := blockExec.proxyApp.PreprocessTxsSync(
 		context.Background(),
 		abci.RequestPreprocessTxs{Txs: bzs},
@@ -145,31 +141,89 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 	pbmessages := processedBlockTxs.GetMessages()
 
-	lp := len(ppt)
-	processedTxs := make(types.Txs, lp)
-	if lp > 0 {
-		for i := 0; i < l; i++ {
-			processedTxs[i] = ppt[i]
-		}
-	}
-
-	messages := types.MessagesFromProto(pbmessages)
-
-	return state.MakeBlock(height, processedTxs, evidence, nil, messages, commit, proposerAddr)
+	processedTxs := make(types.Txs, len(ppt))
+	for i := range ppt {
+		processedTxs[i] = ppt[i]
+	}
+
+	// lay out the block data according to the spec and drop whatever does not
+	// fit into the max square size:
+	// https://github.com/lazyledger/lazyledger-specs/blob/master/specs/block_proposer.md#deciding-on-a-block-size
+	data := types.Data{
+		Txs:                    processedTxs,
+		IntermediateStateRoots: types.IntermediateStateRootsFromProto(processedBlockTxs.GetIntermediateStateRoots()),
+		Evidence:               types.EvidenceData{Evidence: evidence},
+		Messages:               types.MessagesFromProto(pbmessages),
+	}
+	// The app either returns no intermediate state roots at all or one for
+	// each transaction, as Block.ValidateBasic rejects blocks with any other
+	// number of roots. Instead of proposing such an invalid block, the roots
+	// of a misbehaving app are dropped.
+	if n := len(data.IntermediateStateRoots.RawRootsList); n > 0 && n != len(data.Txs) {
+		blockExec.logger.Error("App returned a wrong number of intermediate state roots, proposing without them",
+			"height", height, "roots", n, "txs", len(data.Txs))
+		data.IntermediateStateRoots = types.IntermediateStateRoots{}
+	}
+	maxSquareSize := state.ConsensusParams.DataAvailability.MaxSquareSize
+	if droppedTxs, droppedMsgs := data.FitIntoSquare(maxSquareSize); droppedTxs > 0 || droppedMsgs > 0 {
+		blockExec.logger.Info("Dropped block data not fitting into the max square size",
+			"height", height, "maxSquareSize", maxSquareSize, "txs", droppedTxs, "msgs", droppedMsgs)
+	}
+
+	return state.MakeBlock(height, data.Txs, evidence, data.IntermediateStateRoots.RawRootsList, data.Messages,
+		commit, proposerAddr)
 }
 
 // ValidateBlock validates the given block against the given state.
 // If the block is invalid, it returns an error.
 // Validation does not mutate state, but does require historical information from the stateDB,
 // ie. to verify evidence from a validator at an old height.
+// Unlike ApplyBlock, it validates that the DataAvailabilityHeader commits to the
+// block data, which erasure codes the data unless the extended data square of
+// the block is cached, see types.Block.ExtendedDataSquare.
 func (blockExec *BlockExecutor) ValidateBlock(state State, block *types.Block) error {
 	err := validateBlock(state, block)
 	if err != nil {
 		return err
 	}
+	if err := validateBlockData(state, block); err != nil {
+		return err
+	}
 	return blockExec.evpool.CheckEvidence(block.Evidence.Evidence)
 }
 
+// ValidateIntermediateStateRoots checks that the app reproduces the
+// intermediate state roots of the block when preprocessing its transactions on
+// top of the latest committed state. Once the block is committed, a mismatch
+// can not be rejected anymore, hence it must be checked before voting for the
+// block. Blocks without intermediate state roots are not checked.
+func (blockExec *BlockExecutor) ValidateIntermediateStateRoots(block *types.Block) error {
+	if len(block.Data.IntermediateStateRoots.RawRootsList) == 0 {
+		return nil
+	}
+
+	txs := make([][]byte, len(block.Txs))
+	for i, tx := range block.Txs {
+		txs[i] = tx
+	}
+	res, err := blockExec.proxyApp.PreprocessTxsSync(context.Background(), abci.RequestPreprocessTxs{Txs: txs})
+	if err != nil {
+		return err
+	}
+	return validateIntermediateStateRoots(block, res.GetIntermediateStateRoots().GetRawRootsList())
+}
+
+// hasMessagesSubscribers returns true if the messages of any namespace of the
+// block are subscribed to.
+func (blockExec *BlockExecutor) hasMessagesSubscribers(block *types.Block) bool {
+	for _, msg := range block.Data.Messages.MessagesList {
+		if blockExec.eventBus.HasNewBlockMessagesSubscribers(block.Height, msg.NamespaceID) {
+			return true
+		}
+	}
+	return false
+}
+
 // ApplyBlock validates the block against the state, executes it against the app,
 // fires the relevant events, commits the app, and saves the new state and responses.
 // It returns the new state and the block height to retain (pruning older blocks).
@@ -183,6 +237,14 @@ func (blockExec *BlockExecutor) ApplyBlock(
 	if err := validateBlock(state, block); err != nil {
 		return state, 0, ErrInvalidBlock(err)
 	}
+	// The messages published along with the block are proven against its
+	// extended data square, which is only computed when validating the block
+	// before voting for it, but not when fast syncing or replaying blocks.
+	if block.ExtendedDataSquare() == nil && blockExec.hasMessagesSubscribers(block) {
+		if err := validateBlockData(state, block); err != nil {
+			return state, 0, ErrInvalidBlock(err)
+		}
+	}
 
 	startTime := time.Now().UnixNano()
 	abciResponses, err := execBlockOnProxyApp(blockExec.logger, blockExec.proxyApp, block,
@@ -383,9 +445,48 @@ func execBlockOnProxyApp(
 
 	logger.Info("Executed block", "height", block.Height, "validTxs", validTxs, "invalidTxs", invalidTxs)
 
+	// The block is committed already, thus a mismatch of the intermediate
+	// state roots, which validators check before voting, see
+	// ValidateIntermediateStateRoots, can only be caused by an app executing
+	// the txs differently than preprocessing them.
+	deliveredRoots := make([][]byte, len(abciResponses.DeliverTxs))
+	for i, res := range abciResponses.DeliverTxs {
+		deliveredRoots[i] = res.IntermediateStateRoot
+	}
+	if err := validateIntermediateStateRoots(block, deliveredRoots); err != nil {
+		logger.Error("Executing the block did not reproduce its intermediate state roots",
+			"height", block.Height, "err", err)
+	}
+
 	return abciResponses, nil
 }
 
+// validateIntermediateStateRoots checks that the intermediate state roots of
+// the block match the ones computed by the app, one for each transaction.
+// Blocks without intermediate state roots are not checked.
+func validateIntermediateStateRoots(block *types.Block, got [][]byte) error {
+	roots := block.Data.IntermediateStateRoots.RawRootsList
+	if len(roots) == 0 {
+		return nil
+	}
+	if len(roots) != len(got) {
+		return fmt.Errorf("app computed %d intermediate state roots for the %d of the block",
+			len(got), len(roots))
+	}
+
+	for i := range roots {
+		if !bytes.Equal(roots[i], got[i]) {
+			return ErrIntermediateStateRootMismatch{
+				Height:   block.Height,
+				TxIndex:  i,
+				Expected: roots[i],
+				Got:      got[i],
+			}
+		}
+	}
+	return nil
+}
+
 func getBeginBlockValidatorInfo(block *types.Block, store Store,
 	initialHeight int64) abci.LastCommitInfo {
 	voteInfos := make([]abci.VoteInfo, block.LastCommit.Size())
@@ -541,6 +642,22 @@ func fireEvents(
 		logger.Error("Error publishing new block header", "err", err)
 	}
 
+	if len(block.Data.Messages.MessagesList) != 0 {
+		// proving the messages is expensive, hence only the namespaces
+		// subscribed to are proven
+		msgsByNamespace, err := block.MessagesByNamespace(func(nID namespace.ID) bool {
+			return eventBus.HasNewBlockMessagesSubscribers(block.Height, nID)
+		})
+		if err != nil {
+			logger.Error("Error proving new block messages", "err", err)
+		}
+		for _, msgs := range msgsByNamespace {
+			if err := eventBus.PublishEventNewBlockMessages(msgs); err != nil {
+				logger.Error("Error publishing new block messages", "err", err)
+			}
+		}
+	}
+
 	if len(block.Evidence.Evidence) != 0 {
 		for _, ev := range block.Evidence.Evidence {
 			if err := eventBus.PublishEventNewEvidence(types.EventDataNewEvidence{
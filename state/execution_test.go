@@ -2,6 +2,7 @@ package state_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/lazyledger/lazyledger-core/crypto/ed25519"
 	cryptoenc "github.com/lazyledger/lazyledger-core/crypto/encoding"
 	"github.com/lazyledger/lazyledger-core/crypto/tmhash"
+	tmbytes "github.com/lazyledger/lazyledger-core/libs/bytes"
 	"github.com/lazyledger/lazyledger-core/libs/log"
 	mmock "github.com/lazyledger/lazyledger-core/mempool/mock"
 	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
@@ -57,6 +59,87 @@ func TestApplyBlock(t *testing.T) {
 	assert.EqualValues(t, 1, state.Version.Consensus.App, "App version wasn't updated")
 }
 
+// txsMempool returns the given txs when reaped.
+type txsMempool struct {
+	mmock.Mempool
+	txs types.Txs
+}
+
+func (mem txsMempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs { return mem.txs }
+
+// TestApplyBlockIntermediateStateRoots ensures the intermediate state roots of
+// proposed blocks are the ones returned by the app and that blocks whose roots
+// the app does not reproduce are invalid.
+func TestApplyBlockIntermediateStateRoots(t *testing.T) {
+	cc := proxy.NewLocalClientCreator(&isrApp{})
+	proxyApp := proxy.NewAppConns(cc)
+	err := proxyApp.Start()
+	require.Nil(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	txs := makeTxs(1)
+	roots := make([]tmbytes.HexBytes, len(txs))
+	for i, tx := range txs {
+		roots[i] = tmhash.Sum(tx)
+	}
+
+	t.Run("proposed block", func(t *testing.T) {
+		state, stateDB, _ := makeState(1, 1)
+		blockExec := sm.NewBlockExecutor(sm.NewStore(stateDB), log.TestingLogger(), proxyApp.Consensus(),
+			txsMempool{txs: txs}, sm.EmptyEvidencePool{})
+
+		block, partSet := blockExec.CreateProposalBlock(1, state, new(types.Commit),
+			state.Validators.GetProposer().Address)
+		assert.Equal(t, roots, block.Data.IntermediateStateRoots.RawRootsList)
+		require.NoError(t, blockExec.ValidateIntermediateStateRoots(block))
+
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+		_, _, err := blockExec.ApplyBlock(state, blockID, block)
+		require.NoError(t, err)
+	})
+
+	t.Run("app returning a wrong number of roots", func(t *testing.T) {
+		cc := proxy.NewLocalClientCreator(&shortISRApp{})
+		proxyApp := proxy.NewAppConns(cc)
+		require.NoError(t, proxyApp.Start())
+		defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+		state, stateDB, _ := makeState(1, 1)
+		blockExec := sm.NewBlockExecutor(sm.NewStore(stateDB), log.TestingLogger(), proxyApp.Consensus(),
+			txsMempool{txs: txs}, sm.EmptyEvidencePool{})
+
+		// the block is proposed without the roots instead of being invalid
+		block, _ := blockExec.CreateProposalBlock(1, state, new(types.Commit),
+			state.Validators.GetProposer().Address)
+		assert.EqualValues(t, txs, block.Data.Txs)
+		assert.Empty(t, block.Data.IntermediateStateRoots.RawRootsList)
+		assert.NoError(t, block.ValidateBasic())
+	})
+
+	t.Run("block with wrong intermediate state root", func(t *testing.T) {
+		state, stateDB, _ := makeState(1, 1)
+		blockExec := sm.NewBlockExecutor(sm.NewStore(stateDB), log.TestingLogger(), proxyApp.Consensus(),
+			mmock.Mempool{}, sm.EmptyEvidencePool{})
+
+		wrongRoots := append([]tmbytes.HexBytes(nil), roots...)
+		wrongRoots[3] = tmhash.Sum([]byte("wrong"))
+		block, partSet := state.MakeBlock(1, txs, nil, wrongRoots, types.Messages{},
+			new(types.Commit), state.Validators.GetProposer().Address)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+
+		err := blockExec.ValidateIntermediateStateRoots(block)
+		var mismatch sm.ErrIntermediateStateRootMismatch
+		require.True(t, errors.As(err, &mismatch), "unexpected error: %v", err)
+		assert.Equal(t, 3, mismatch.TxIndex)
+		assert.EqualValues(t, wrongRoots[3], mismatch.Expected)
+		assert.EqualValues(t, roots[3], mismatch.Got)
+
+		// once committed, the block is executed regardless
+		_, _, err = blockExec.ApplyBlock(state, blockID, block)
+		require.NoError(t, err)
+	})
+}
+
 // TestBeginBlockValidators ensures we send absent validators list.
 func TestBeginBlockValidators(t *testing.T) {
 	app := &testApp{}
@@ -8,6 +8,7 @@ import (
 	abci "github.com/lazyledger/lazyledger-core/abci/types"
 	"github.com/lazyledger/lazyledger-core/crypto"
 	"github.com/lazyledger/lazyledger-core/crypto/ed25519"
+	"github.com/lazyledger/lazyledger-core/crypto/tmhash"
 	dbm "github.com/lazyledger/lazyledger-core/libs/db"
 	"github.com/lazyledger/lazyledger-core/libs/db/memdb"
 	tmrand "github.com/lazyledger/lazyledger-core/libs/rand"
@@ -283,3 +284,39 @@ func (app *testApp) Commit() abci.ResponseCommit {
 func (app *testApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuery) {
 	return
 }
+
+// isrApp returns the hash of each tx as the intermediate state root after
+// executing it.
+type isrApp struct {
+	testApp
+}
+
+var _ abci.Application = (*isrApp)(nil)
+
+func (app *isrApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	return abci.ResponseDeliverTx{IntermediateStateRoot: tmhash.Sum(req.Tx)}
+}
+
+func (app *isrApp) PreprocessTxs(req abci.RequestPreprocessTxs) abci.ResponsePreprocessTxs {
+	roots := make([][]byte, len(req.Txs))
+	for i, tx := range req.Txs {
+		roots[i] = tmhash.Sum(tx)
+	}
+	return abci.ResponsePreprocessTxs{
+		Txs:                    req.Txs,
+		IntermediateStateRoots: &tmproto.IntermediateStateRoots{RawRootsList: roots},
+	}
+}
+
+// shortISRApp returns one intermediate state root less than there are txs
+// when preprocessing them.
+type shortISRApp struct {
+	isrApp
+}
+
+func (app *shortISRApp) PreprocessTxs(req abci.RequestPreprocessTxs) abci.ResponsePreprocessTxs {
+	res := app.isrApp.PreprocessTxs(req)
+	roots := res.IntermediateStateRoots.RawRootsList
+	res.IntermediateStateRoots.RawRootsList = roots[:len(roots)-1]
+	return res
+}
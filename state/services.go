@@ -22,6 +22,7 @@ type BlockStore interface {
 
 	LoadBaseMeta() *types.BlockMeta
 	LoadBlockMeta(height int64) *types.BlockMeta
+	LoadDAHeader(height int64) *types.DataAvailabilityHeader
 	LoadBlock(ctx context.Context, height int64) (*types.Block, error)
 
 	SaveBlock(ctx context.Context, block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) error
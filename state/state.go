@@ -220,6 +220,9 @@ func StateFromProto(pb *tmstate.State) (*State, error) { //nolint:golint
 
 	state.LastHeightValidatorsChanged = pb.LastHeightValidatorsChanged
 	state.ConsensusParams = pb.ConsensusParams
+	// states saved before the square sizes were added decode them as zero
+	state.ConsensusParams.DataAvailability = types.CompleteDataAvailabilityParams(
+		pb.ConsensusParams.DataAvailability)
 	state.LastHeightConsensusParamsChanged = pb.LastHeightConsensusParamsChanged
 	state.LastResultsHash = pb.LastResultsHash
 	state.AppHash = pb.AppHash
@@ -243,8 +246,17 @@ func (state State) MakeBlock(
 	proposerAddress []byte,
 ) (*types.Block, *types.PartSet) {
 
-	// Build base block with block data.
-	block := types.MakeBlock(height, txs, evidence, intermediateStateRoots, messages, commit)
+	// The erasure codec is fixed at genesis and validated with the consensus
+	// params, thus it can only be missing if this build does not support it.
+	codec, err := types.Codec(state.ConsensusParams.DataAvailability.Codec)
+	if err != nil {
+		panic(err)
+	}
+
+	// Build base block with block data of the version of the chain, which
+	// determines the layout of the data.
+	block := types.MakeBlockWithCodec(height, txs, evidence, intermediateStateRoots, messages, commit,
+		state.Version.Consensus.Block, codec, state.ConsensusParams.DataAvailability.MinSquareSize)
 
 	// Set time.
 	var timestamp time.Time
@@ -545,7 +545,10 @@ func (store dbStore) LoadConsensusParams(height int64) (tmproto.ConsensusParams,
 		paramsInfo = paramsInfo2
 	}
 
-	return paramsInfo.ConsensusParams, nil
+	// params saved before the square sizes were added decode them as zero
+	params := paramsInfo.ConsensusParams
+	params.DataAvailability = types.CompleteDataAvailabilityParams(params.DataAvailability)
+	return params, nil
 }
 
 func (store dbStore) loadConsensusParamsInfo(height int64) (*tmstate.ConsensusParamsInfo, error) {
@@ -46,6 +46,23 @@ func TestStoreLoadValidators(t *testing.T) {
 	assert.NotZero(t, loadedVals.Size())
 }
 
+func TestStoreLoadWithoutDataAvailabilityParams(t *testing.T) {
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB)
+	// states saved before the square sizes were added decode them as zero
+	state.ConsensusParams.DataAvailability = tmproto.DataAvailabilityParams{}
+	require.NoError(t, stateStore.Save(state))
+
+	loaded, err := stateStore.Load()
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultDataAvailabilityParams(), loaded.ConsensusParams.DataAvailability)
+	assert.NoError(t, types.ValidateConsensusParams(loaded.ConsensusParams))
+
+	params, err := stateStore.LoadConsensusParams(state.InitialHeight)
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultDataAvailabilityParams(), params.DataAvailability)
+}
+
 func BenchmarkLoadValidators(b *testing.B) {
 	const valSetSize = 100
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/lazyledger/lazyledger-core/types"
 )
@@ -17,6 +18,28 @@ func validateBlock(state State, block *types.Block) error {
 		return err
 	}
 
+	// Validate the DataAvailabilityHeader, which ValidateBasic checked against
+	// the DataHash. Whether it commits to the block data is only validated
+	// before voting for the block, see validateBlockData, as the data of
+	// committed blocks is authenticated by their commit.
+	if err := block.DataAvailabilityHeader.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid DataAvailabilityHeader: %w", err)
+	}
+	daParams := state.ConsensusParams.DataAvailability
+	squareSize := uint32(len(block.DataAvailabilityHeader.RowsRoots) / 2)
+	if squareSize > daParams.MaxSquareSize {
+		return fmt.Errorf("block square size is larger than the max square size. %d > %d",
+			squareSize,
+			daParams.MaxSquareSize,
+		)
+	}
+	if squareSize < daParams.MinSquareSize {
+		return fmt.Errorf("block square size is smaller than the min square size. %d < %d",
+			squareSize,
+			daParams.MinSquareSize,
+		)
+	}
+
 	// Validate basic info.
 	if block.Version.App != state.Version.Consensus.App ||
 		block.Version.Block != state.Version.Consensus.Block {
@@ -143,3 +166,63 @@ func validateBlock(state State, block *types.Block) error {
 
 	return nil
 }
+
+// validateBlockData validates that the DataAvailabilityHeader of the block
+// commits to the block data laid out the way blocks of its version are and
+// extended with the erasure codec of the chain. The data is only erasure coded
+// if the extended data square of the block is not cached yet, e.g. for blocks
+// made by this node, otherwise the cached square is compared to the laid out
+// data. The square is kept for putting the block data to IPFS.
+func validateBlockData(state State, block *types.Block) error {
+	daParams := state.ConsensusParams.DataAvailability
+	shares, dataSharesLen := block.Data.ComputeSharesForBlockVersion(block.Version.Block, daParams.MinSquareSize)
+	// the data must not be erasure coded in a square larger than the one of
+	// the header, which validateBlock checked against the max square size
+	squareSize := uint32(math.Sqrt(float64(len(shares))))
+	if headerSize := uint32(len(block.DataAvailabilityHeader.RowsRoots) / 2); squareSize != headerSize {
+		return fmt.Errorf("wrong block square size. Expected %d, got %d",
+			squareSize,
+			headerSize,
+		)
+	}
+	if uint64(dataSharesLen) != block.NumOriginalDataShares {
+		return fmt.Errorf("wrong Block.Header.NumOriginalDataShares. Expected %v, got %v",
+			dataSharesLen,
+			block.NumOriginalDataShares,
+		)
+	}
+
+	if eds := block.ExtendedDataSquare(); eds != nil {
+		// the cached square is the one the DataAvailabilityHeader commits to
+		width := uint(squareSize)
+		for i, share := range shares {
+			if !bytes.Equal(share.Share, eds.Cell(uint(i)/width, uint(i)%width)) {
+				return fmt.Errorf("block data does not match the extended data square at share %d", i)
+			}
+		}
+		return nil
+	}
+
+	codec, err := types.Codec(daParams.Codec)
+	if err != nil {
+		return err
+	}
+	eds, err := types.ExtendShares(shares, codec)
+	if err != nil {
+		return err
+	}
+	dah, err := types.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(dah.Hash(), block.DataHash) {
+		return fmt.Errorf("wrong Block.Header.DataHash. Expected %X, got %X",
+			dah.Hash(),
+			block.DataHash,
+		)
+	}
+	// the square was verified against the header, keep it for putting the
+	// block data to IPFS
+	block.SetExtendedDataSquare(eds)
+	return nil
+}
@@ -113,6 +113,94 @@ func TestValidateBlockHeader(t *testing.T) {
 	assert.Contains(t, err.Error(), "lower than initial height")
 }
 
+func TestValidateBlockSquareSize(t *testing.T) {
+	proxyApp := newTestApp()
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB)
+	blockExec := sm.NewBlockExecutor(
+		stateStore,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		memmock.Mempool{},
+		sm.EmptyEvidencePool{},
+	)
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+	proposerAddr := state.Validators.GetProposer().Address
+	// two txs filling more than a single share
+	txs := []types.Tx{make(types.Tx, 200), make(types.Tx, 200)}
+
+	// blocks are laid out in a square of at least the min square size
+	state.ConsensusParams.DataAvailability.MinSquareSize = 4
+	block, _ := state.MakeBlock(1, txs, nil, nil, types.Messages{}, lastCommit, proposerAddr)
+	assert.Len(t, block.DataAvailabilityHeader.RowsRoots, 2*4)
+	require.NoError(t, blockExec.ValidateBlock(state, block))
+
+	// the square of the block is smaller than the min square size
+	smallerMin := state
+	smallerMin.ConsensusParams.DataAvailability.MinSquareSize = 8
+	err := blockExec.ValidateBlock(smallerMin, block)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "min square size")
+
+	// the data does not fit into the max square size
+	smallerMax := state
+	smallerMax.ConsensusParams.DataAvailability.MinSquareSize = 1
+	smallerMax.ConsensusParams.DataAvailability.MaxSquareSize = 1
+	block, _ = smallerMax.MakeBlock(1, txs, nil, nil, types.Messages{}, lastCommit, proposerAddr)
+	err = blockExec.ValidateBlock(smallerMax, block)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "max square size")
+}
+
+func TestValidateBlockData(t *testing.T) {
+	proxyApp := newTestApp()
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB)
+	blockExec := sm.NewBlockExecutor(
+		stateStore,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		memmock.Mempool{},
+		sm.EmptyEvidencePool{},
+	)
+	lastCommit := types.NewCommit(0, 0, types.BlockID{}, nil)
+	proposerAddr := state.Validators.GetProposer().Address
+
+	// the extended data square of an own block is reused
+	block, _ := state.MakeBlock(1, makeTxs(1), nil, nil, types.Messages{}, lastCommit, proposerAddr)
+	require.NotNil(t, block.ExtendedDataSquare())
+	require.NoError(t, blockExec.ValidateBlock(state, block))
+
+	// the square of a received block is computed and kept
+	pb, err := block.ToProto()
+	require.NoError(t, err)
+	received, err := types.BlockFromProto(pb)
+	require.NoError(t, err)
+	require.Nil(t, received.ExtendedDataSquare())
+	require.NoError(t, blockExec.ValidateBlock(state, received))
+	assert.NotNil(t, received.ExtendedDataSquare())
+
+	// data not committed to by the DataAvailabilityHeader is rejected with
+	// and without a cached square
+	block.Data.Txs[0] = types.Tx{0xff, 0xff}
+	err = blockExec.ValidateBlock(state, block)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not match the extended data square")
+
+	received, err = types.BlockFromProto(pb)
+	require.NoError(t, err)
+	received.Data.Txs[0] = types.Tx{0xff, 0xff}
+	err = blockExec.ValidateBlock(state, received)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "DataHash")
+}
+
 func TestValidateBlockCommit(t *testing.T) {
 	proxyApp := newTestApp()
 	require.NoError(t, proxyApp.Start())
@@ -0,0 +1,143 @@
+package store
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+	"github.com/lazyledger/lazyledger-core/types"
+)
+
+// daHeaderMigrationKey marks block stores whose block metas all contain the
+// DataAvailabilityHeader of their block.
+var daHeaderMigrationKey = []byte("migration:DAH")
+
+// MinSquareSizeFunc returns the MinSquareSize of the DataAvailability params
+// the block at the given height was made with.
+type MinSquareSizeFunc func(height int64) (uint32, error)
+
+// MigrateDAHeaders backfills the DataAvailabilityHeader of block metas which
+// were saved before it was persisted along with them. The header is
+// recomputed from the locally stored block parts using the codec of the
+// BlockStore, hence SetCodec must be called beforehand, and the min square
+// size of each height returned by minSquareSize. Once the migration completed,
+// it is recorded in the database and subsequent calls are no-ops. It returns
+// the number of migrated block metas.
+func (bs *BlockStore) MigrateDAHeaders(minSquareSize MinSquareSizeFunc) (uint64, error) {
+	done, err := bs.db.Has(daHeaderMigrationKey)
+	if err != nil {
+		return 0, err
+	}
+	if done {
+		return 0, nil
+	}
+
+	migrated := uint64(0)
+	batch := bs.db.NewBatch()
+	// flushed batches are closed when they are written, only the last one is
+	// closed here
+	defer func() { batch.Close() }()
+
+	base, height := bs.Base(), bs.Height()
+	for h := base; h > 0 && h <= height; h++ {
+		bz, err := bs.db.Get(calcBlockMetaKey(h))
+		if err != nil {
+			return 0, err
+		}
+		if len(bz) == 0 { // assume pruned
+			continue
+		}
+
+		pbbm := new(tmproto.BlockMeta)
+		if err := proto.Unmarshal(bz, pbbm); err != nil {
+			return 0, fmt.Errorf("unmarshal to tmproto.BlockMeta at height %v: %w", h, err)
+		}
+		if pbbm.DaHeader != nil {
+			continue
+		}
+
+		size, err := minSquareSize(h)
+		if err != nil {
+			return 0, fmt.Errorf("failed to load min square size at height %v: %w", h, err)
+		}
+		dah, err := bs.computeDAHeader(h, pbbm, size)
+		if err != nil {
+			return 0, fmt.Errorf("failed to compute DataAvailabilityHeader at height %v: %w", h, err)
+		}
+		pbbm.DaHeader, err = dah.ToProto()
+		if err != nil {
+			return 0, err
+		}
+		if err := batch.Set(calcBlockMetaKey(h), mustEncode(pbbm)); err != nil {
+			return 0, err
+		}
+		migrated++
+
+		// flush every 1000 blocks to avoid batches becoming too large
+		if migrated%1000 == 0 {
+			if err := batch.WriteSync(); err != nil {
+				return 0, err
+			}
+			batch.Close()
+			batch = bs.db.NewBatch()
+		}
+	}
+
+	if err := batch.Set(daHeaderMigrationKey, []byte{1}); err != nil {
+		return 0, err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return 0, err
+	}
+	return migrated, nil
+}
+
+// computeDAHeader reassembles the block data at the given height from its
+// parts and computes its DataAvailabilityHeader laid out in a square of at
+// least minSquareSize the way blocks of its version are. The header is checked
+// against the DataHash committed to in the block header.
+func (bs *BlockStore) computeDAHeader(
+	height int64,
+	pbbm *tmproto.BlockMeta,
+	minSquareSize uint32,
+) (*types.DataAvailabilityHeader, error) {
+	var buf []byte
+	for i := 0; i < int(pbbm.BlockID.PartSetHeader.Total); i++ {
+		part := bs.LoadBlockPart(height, i)
+		if part == nil {
+			return nil, fmt.Errorf("missing block part %v", i)
+		}
+		buf = append(buf, part.Bytes...)
+	}
+
+	pbb := new(tmproto.Block)
+	if err := proto.Unmarshal(buf, pbb); err != nil {
+		return nil, fmt.Errorf("unmarshal to tmproto.Block: %w", err)
+	}
+	// the DataAvailabilityHeader of the block might be missing too, thus only
+	// the block data is decoded
+	data, err := types.DataFromProto(&pbb.Data)
+	if err != nil {
+		return nil, err
+	}
+	if err := data.Evidence.FromProto(&pbb.Data.Evidence); err != nil {
+		return nil, err
+	}
+
+	shares, _ := data.ComputeSharesForBlockVersion(pbbm.Header.Version.Block, minSquareSize)
+	eds, err := types.ExtendShares(shares, bs.codec)
+	if err != nil {
+		return nil, err
+	}
+	dah, err := types.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(dah.Hash(), pbbm.Header.DataHash) {
+		return nil, fmt.Errorf("computed DataAvailabilityHeader hash %X does not match the block's DataHash %X",
+			dah.Hash(), pbbm.Header.DataHash)
+	}
+	return &dah, nil
+}
@@ -0,0 +1,127 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lazyledger/lazyledger-core/libs/log"
+	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+	sm "github.com/lazyledger/lazyledger-core/state"
+	"github.com/lazyledger/lazyledger-core/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+	tmtime "github.com/lazyledger/lazyledger-core/types/time"
+)
+
+func TestMigrateDAHeaders(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+	// the blocks are laid out in squares wider than the smallest one they fit
+	// into, which the migration has to recompute
+	state.ConsensusParams.DataAvailability.MinSquareSize = 4
+
+	blocks := make([]*types.Block, 0, 5)
+	for h := int64(1); h <= 5; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		err := bs.SaveBlock(context.TODO(), block, block.MakePartSet(2), makeTestCommit(h, tmtime.Now()))
+		require.NoError(t, err)
+		blocks = append(blocks, block)
+	}
+
+	// strip the DataAvailabilityHeader from the metas of some blocks to
+	// mimic a block store created before it was persisted
+	for _, h := range []int64{2, 4} {
+		bz, err := bs.db.Get(calcBlockMetaKey(h))
+		require.NoError(t, err)
+		pbbm := new(tmproto.BlockMeta)
+		require.NoError(t, proto.Unmarshal(bz, pbbm))
+		pbbm.DaHeader = nil
+		require.NoError(t, bs.db.Set(calcBlockMetaKey(h), mustEncode(pbbm)))
+	}
+
+	migrated, err := bs.MigrateDAHeaders(stateMinSquareSize(state))
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, migrated)
+
+	for _, block := range blocks {
+		dah := bs.LoadDAHeader(block.Height)
+		require.NotNil(t, dah)
+		assert.Equal(t, block.DataAvailabilityHeader, *dah)
+	}
+
+	// the migration only runs once
+	migrated, err = bs.MigrateDAHeaders(stateMinSquareSize(state))
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, migrated)
+}
+
+func TestMigrateDAHeadersLegacyLayout(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+	// a chain started before the messages were aligned
+	state.Version.Consensus.Block = types.LegacyLayoutBlockVersion
+
+	msgs := types.Messages{MessagesList: []types.Message{
+		{NamespaceID: []byte{1, 1, 1, 1, 1, 1, 1, 1}, Data: bytes.Repeat([]byte{0x1}, consts.MsgShareSize)},
+		{NamespaceID: []byte{2, 2, 2, 2, 2, 2, 2, 2}, Data: []byte{0x2}},
+	}}
+	block, _ := state.MakeBlock(1, makeTxs(1), nil, nil, msgs, new(types.Commit),
+		state.Validators.GetProposer().Address)
+	// the messages would be aligned in the current layout
+	currentDAH, _, err := block.Data.ComputeDataAvailabilityHeader(types.DefaultCodec(), consts.MinSquareSize)
+	require.NoError(t, err)
+	require.NotEqual(t, currentDAH.Hash(), block.DataHash)
+
+	err = bs.SaveBlock(context.TODO(), block, block.MakePartSet(2), makeTestCommit(1, tmtime.Now()))
+	require.NoError(t, err)
+	bz, err := bs.db.Get(calcBlockMetaKey(1))
+	require.NoError(t, err)
+	pbbm := new(tmproto.BlockMeta)
+	require.NoError(t, proto.Unmarshal(bz, pbbm))
+	pbbm.DaHeader = nil
+	require.NoError(t, bs.db.Set(calcBlockMetaKey(1), mustEncode(pbbm)))
+
+	migrated, err := bs.MigrateDAHeaders(stateMinSquareSize(state))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, migrated)
+	dah := bs.LoadDAHeader(1)
+	require.NotNil(t, dah)
+	assert.Equal(t, block.DataAvailabilityHeader, *dah)
+}
+
+func TestMigrateDAHeadersDataHashMismatch(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+
+	block := makeBlock(1, state, new(types.Commit))
+	err := bs.SaveBlock(context.TODO(), block, block.MakePartSet(2), makeTestCommit(1, tmtime.Now()))
+	require.NoError(t, err)
+
+	bz, err := bs.db.Get(calcBlockMetaKey(1))
+	require.NoError(t, err)
+	pbbm := new(tmproto.BlockMeta)
+	require.NoError(t, proto.Unmarshal(bz, pbbm))
+	pbbm.DaHeader = nil
+	pbbm.Header.DataHash = []byte("corrupted data hash")
+	require.NoError(t, bs.db.Set(calcBlockMetaKey(1), mustEncode(pbbm)))
+
+	_, err = bs.MigrateDAHeaders(stateMinSquareSize(state))
+	require.Error(t, err)
+
+	// a failed migration is not recorded
+	done, err := bs.db.Has(daHeaderMigrationKey)
+	require.NoError(t, err)
+	assert.False(t, done)
+}
+
+// stateMinSquareSize returns the min square size of the given state for all
+// heights.
+func stateMinSquareSize(state sm.State) MinSquareSizeFunc {
+	return func(int64) (uint32, error) {
+		return state.ConsensusParams.DataAvailability.MinSquareSize, nil
+	}
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -14,7 +15,6 @@ import (
 	format "github.com/ipfs/go-ipld-format"
 	"github.com/ipfs/go-merkledag"
 
-	"github.com/lazyledger/lazyledger-core/ipfs"
 	dbm "github.com/lazyledger/lazyledger-core/libs/db"
 	"github.com/lazyledger/lazyledger-core/libs/log"
 	tmsync "github.com/lazyledger/lazyledger-core/libs/sync"
@@ -29,10 +29,16 @@ import (
 BlockStore is a simple low level store for blocks.
 
 There are three types of information stored:
- - BlockMeta:   Meta information about each block
+ - BlockMeta:   Meta information about each block, including its DataAvailabilityHeader
  - Block part:  Parts of each block, aggregated w/ PartSet
  - Commit:      The commit part of each block, for gossiping precommit votes
 
+Additionally, the erasure coded block data is stored in the IPFS repo. It can
+be retained for longer than the blocks themselves, in which case the
+DataAvailabilityHeader of pruned blocks is kept until their data is pruned too.
+As blocks with equal data share it in the IPFS repo, the blocks referencing
+each node of the data are counted before any data is pruned, see SetPrunerDB.
+
 Currently the precommit signatures are duplicated in the Block parts as
 well as the Commit.  In the future this may change, perhaps by moving
 the Commit data outside the Block. (TODO)
@@ -53,24 +59,104 @@ type BlockStore struct {
 	mtx    tmsync.RWMutex
 	base   int64
 	height int64
+	// daBase is the lowest height whose block data may still be stored in the DAG
+	daBase int64
 
 	dag    format.DAGService
 	logger log.Logger
+
+	// codec is the erasure codec blocks are extended with
+	codec rsmt2d.Codec
+	// pruner removes the block data of pruned heights from the DAG
+	pruner *ipld.Pruner
+	// daRetainBlocks is the minimum number of recent heights whose block data
+	// is kept in the DAG when pruning, 0 to prune it together with the blocks
+	daRetainBlocks int64
+
+	// evpool receives fraud proofs generated when loading badly encoded blocks
+	evpool EvidencePool
+	// provider announces the block data of retained heights to the DHT
+	provider Provider
+
+	metrics *ipld.Metrics
+}
+
+// EvidencePool is the interface the BlockStore uses to submit the fraud
+// proofs it generates.
+type EvidencePool interface {
+	AddEvidence(types.Evidence) error
+}
+
+// Provider is the interface the BlockStore uses to announce the block data it
+// stores to the network and to stop doing so once the data is pruned.
+type Provider interface {
+	Provide(height int64, dah *types.DataAvailabilityHeader) error
+	Cancel(height int64) error
 }
 
 // NewBlockStore returns a new BlockStore with the given DB,
 // initialized to the last height that was committed to the DB.
 func NewBlockStore(db dbm.DB, bstore blockstore.Blockstore, logger log.Logger) *BlockStore {
 	bs := LoadBlockStoreState(db)
+	// Backwards compatibility with persisted data from before DaBase existed.
+	daBase := bs.DaBase
+	if daBase == 0 {
+		daBase = bs.Base
+	}
+	dag := merkledag.NewDAGService(blockservice.New(bstore, offline.Exchange(bstore)))
 	return &BlockStore{
-		base:   bs.Base,
-		height: bs.Height,
-		db:     db,
-		dag:    merkledag.NewDAGService(blockservice.New(bstore, offline.Exchange(bstore))),
-		logger: logger,
+		base:    bs.Base,
+		height:  bs.Height,
+		daBase:  daBase,
+		db:      db,
+		dag:     dag,
+		logger:  logger,
+		codec:   types.DefaultCodec(),
+		pruner:  ipld.NewPruner(dag, db),
+		metrics: ipld.NopMetrics(),
 	}
 }
 
+// SetCodec sets the erasure codec used to store and repair block data. It
+// must match the codec in the consensus params of the chain. Defaults to
+// types.DefaultCodec.
+func (bs *BlockStore) SetCodec(codec rsmt2d.Codec) {
+	bs.codec = codec
+}
+
+// SetDARetainBlocks sets the minimum number of recent heights whose block
+// data is kept in the IPFS repo when blocks are pruned. This allows serving
+// samples for longer than the blocks are retained. 0, the default, prunes the
+// block data together with the blocks.
+func (bs *BlockStore) SetDARetainBlocks(retainBlocks int64) {
+	bs.daRetainBlocks = retainBlocks
+}
+
+// SetPrunerDB sets the DB storing the number of blocks referencing each node of
+// the block data in the IPFS repo, which are counted when pruning. Defaults to
+// the DB of the BlockStore.
+func (bs *BlockStore) SetPrunerDB(db dbm.DB) {
+	bs.pruner = ipld.NewPruner(bs.dag, db)
+}
+
+// SetMetrics sets the metrics reported when storing and retrieving block data.
+func (bs *BlockStore) SetMetrics(metrics *ipld.Metrics) {
+	bs.metrics = metrics
+}
+
+// SetEvidencePool sets the pool to which fraud proofs for badly encoded blocks
+// are submitted, so they can be gossiped to other nodes.
+func (bs *BlockStore) SetEvidencePool(evpool EvidencePool) {
+	bs.evpool = evpool
+}
+
+// SetProvider sets the provider which announces the block data of the saved
+// blocks until it is pruned. Without a provider, the block data is stored
+// without being announced.
+func (bs *BlockStore) SetProvider(provider Provider) {
+	bs.provider = provider
+}
+
 // Base returns the first known contiguous block height, or 0 for empty block stores.
 func (bs *BlockStore) Base() int64 {
 	bs.mtx.RLock()
@@ -85,6 +171,14 @@ func (bs *BlockStore) Height() int64 {
 	return bs.height
 }
 
+// DABase returns the first height whose block data may still be stored in the
+// IPFS repo, or 0 for empty block stores. It is never greater than Base.
+func (bs *BlockStore) DABase() int64 {
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+	return bs.daBase
+}
+
 // Size returns the number of blocks in the block store.
 func (bs *BlockStore) Size() int64 {
 	bs.mtx.RLock()
@@ -115,14 +209,22 @@ func (bs *BlockStore) LoadBlock(ctx context.Context, height int64) (*types.Block
 
 	lastCommit := bs.LoadBlockCommit(height - 1)
 
-	data, err := ipld.RetrieveBlockData(ctx, &blockMeta.DAHeader, bs.dag, rsmt2d.NewRSGF8Codec())
+	eds, err := ipld.RetrieveExtendedDataSquare(ctx, &blockMeta.DAHeader, bs.dag, bs.codec, bs.metrics)
 	if err != nil {
 		if strings.Contains(err.Error(), format.ErrNotFound.Error()) {
 			return nil, fmt.Errorf("failure to retrieve block data from local ipfs store: %w", err)
 		}
+		var byzErr *ipld.ErrByzantineData
+		if errors.As(err, &byzErr) {
+			bs.reportBadEncoding(ctx, blockMeta, byzErr)
+		}
 		bs.logger.Info("failure to retrieve block data", err)
 		return nil, err
 	}
+	data, err := types.DataFromSquare(eds)
+	if err != nil {
+		return nil, err
+	}
 
 	block := types.Block{
 		Header:                 blockMeta.Header,
@@ -130,10 +232,37 @@ func (bs *BlockStore) LoadBlock(ctx context.Context, height int64) (*types.Block
 		DataAvailabilityHeader: blockMeta.DAHeader,
 		LastCommit:             lastCommit,
 	}
+	// the repaired square is the one committed to by the DataAvailabilityHeader
+	block.SetExtendedDataSquare(eds)
 
 	return &block, nil
 }
 
+// reportBadEncoding builds a fraud proof for the badly encoded block and
+// submits it to the evidence pool.
+func (bs *BlockStore) reportBadEncoding(ctx context.Context, blockMeta *types.BlockMeta, byzErr *ipld.ErrByzantineData) {
+	if bs.evpool == nil {
+		return
+	}
+
+	befp, err := ipld.NewBadEncodingFraudProof(
+		ctx,
+		bs.dag,
+		&blockMeta.DAHeader,
+		byzErr,
+		blockMeta.Header.Height,
+		blockMeta.Header.Time,
+	)
+	if err != nil {
+		bs.logger.Error("failure to build bad encoding fraud proof", "height", blockMeta.Header.Height, "err", err)
+		return
+	}
+
+	if err := bs.evpool.AddEvidence(befp); err != nil {
+		bs.logger.Error("failure to add bad encoding fraud proof", "height", blockMeta.Header.Height, "err", err)
+	}
+}
+
 // LoadBlockByHash returns the block with the given hash.
 // If no block is found for that hash, it returns nil.
 // Panics if it fails to parse height associated with the given hash.
@@ -208,6 +337,45 @@ func (bs *BlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 	return blockMeta
 }
 
+// LoadDAHeader returns the DataAvailabilityHeader of the block at the given
+// height. Unlike LoadBlock, it only reads the block meta and thus does not
+// retrieve any block data from IPFS. Headers of pruned blocks whose data is
+// still retained are returned too.
+// If no block is found for the given height, it returns nil.
+func (bs *BlockStore) LoadDAHeader(height int64) *types.DataAvailabilityHeader {
+	blockMeta := bs.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return bs.loadPrunedDAHeader(height)
+	}
+	return &blockMeta.DAHeader
+}
+
+// loadPrunedDAHeader returns the DataAvailabilityHeader kept for a pruned
+// block whose data has not been pruned yet, or nil if there is none.
+func (bs *BlockStore) loadPrunedDAHeader(height int64) *types.DataAvailabilityHeader {
+	var pbdah = new(tmproto.DataAvailabilityHeader)
+	bz, err := bs.db.Get(calcDAHeaderKey(height))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return nil
+	}
+
+	err = proto.Unmarshal(bz, pbdah)
+	if err != nil {
+		panic(fmt.Errorf("unmarshal to tmproto.DataAvailabilityHeader: %w", err))
+	}
+
+	dah, err := types.DataAvailabilityHeaderFromProto(pbdah)
+	if err != nil {
+		panic(fmt.Errorf("error from proto DataAvailabilityHeader: %w", err))
+	}
+
+	return dah
+}
+
 // LoadBlockCommit returns the Commit for the given height.
 // This commit consists of the +2/3 and other Precommit-votes for block at `height`,
 // and it comes from the block.LastCommit for `height+1`.
@@ -257,6 +425,8 @@ func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 }
 
 // PruneBlocks removes block up to (but not including) a height. It returns number of blocks pruned.
+// The block data is removed from the IPFS repo as well, unless it is retained
+// for longer, see SetDARetainBlocks.
 func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 	if height <= 0 {
 		return 0, fmt.Errorf("height must be greater than 0")
@@ -267,6 +437,11 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 		return 0, fmt.Errorf("cannot prune beyond the latest height %v", bs.height)
 	}
 	base := bs.base
+	// the block data is kept at least as long as the blocks
+	daHeight := height
+	if bs.daRetainBlocks > 0 && bs.height-bs.daRetainBlocks+1 < daHeight {
+		daHeight = bs.height - bs.daRetainBlocks + 1
+	}
 	bs.mtx.RUnlock()
 	if height < base {
 		return 0, fmt.Errorf("cannot prune to height %v, it is lower than base height %v",
@@ -314,6 +489,14 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 				return 0, err
 			}
 		}
+		// keep the DataAvailabilityHeader until the block data is pruned
+		pdah, err := meta.DAHeader.ToProto()
+		if err != nil {
+			return 0, err
+		}
+		if err := batch.Set(calcDAHeaderKey(h), mustEncode(pdah)); err != nil {
+			return 0, err
+		}
 		pruned++
 
 		// flush every 1000 blocks to avoid batches becoming too large
@@ -331,9 +514,93 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 	if err != nil {
 		return 0, err
 	}
+
+	if err := bs.pruneDAData(daHeight); err != nil {
+		return 0, err
+	}
 	return pruned, nil
 }
 
+// pruneDAData removes the block data of all pruned heights below the given
+// one from the IPFS repo.
+func (bs *BlockStore) pruneDAData(height int64) error {
+	bs.mtx.RLock()
+	daBase, base := bs.daBase, bs.base
+	bs.mtx.RUnlock()
+	// the data of blocks which are not pruned is always kept
+	if height > base {
+		height = base
+	}
+	if height <= daBase {
+		return nil
+	}
+
+	if err := bs.referenceDAData(); err != nil {
+		return err
+	}
+	for h := daBase; h < height; h++ {
+		dah := bs.loadPrunedDAHeader(h)
+		if dah == nil { // assume already deleted
+			continue
+		}
+		if err := bs.pruneDAHeader(h, dah); err != nil {
+			return err
+		}
+	}
+
+	bs.mtx.Lock()
+	bs.daBase = height
+	bs.mtx.Unlock()
+	bs.saveState()
+	return nil
+}
+
+// referenceDAData counts the references of the blocks whose data is stored to
+// the nodes of their data, unless they are counted already, so that nodes
+// shared with them are kept when pruning. This happens when pruning instead of
+// when saving blocks, so that saving does not need to walk all the nodes.
+func (bs *BlockStore) referenceDAData() error {
+	referenced, err := bs.pruner.ReferencedHeight()
+	if err != nil {
+		return err
+	}
+	bs.mtx.RLock()
+	daBase, height := bs.daBase, bs.height
+	bs.mtx.RUnlock()
+	if referenced < daBase-1 {
+		referenced = daBase - 1
+	}
+
+	for h := referenced + 1; h <= height; h++ {
+		dah := bs.LoadDAHeader(h)
+		if dah == nil { // assume already deleted
+			continue
+		}
+		if err := bs.pruner.Reference(context.Background(), h, dah); err != nil {
+			return fmt.Errorf("failed to count references to block data at height %v: %w", h, err)
+		}
+	}
+	return nil
+}
+
+// pruneDAHeader releases the references of the pruned block at the given
+// height to the nodes of its data and removes the nodes from the IPFS repo
+// which are not referenced by any other block.
+func (bs *BlockStore) pruneDAHeader(height int64, dah *types.DataAvailabilityHeader) error {
+	if bs.provider != nil {
+		if err := bs.provider.Cancel(height); err != nil {
+			return fmt.Errorf("failed to stop providing block data at height %v: %w", height, err)
+		}
+	}
+
+	// pruning a height again is a no-op, thus the header is only deleted
+	// once its references are released
+	if _, err := bs.pruner.Prune(context.Background(), height, dah); err != nil {
+		return fmt.Errorf("failed to prune block data at height %v: %w", height, err)
+	}
+	return bs.db.Delete(calcDAHeaderKey(height))
+}
+
 // SaveBlock persists the given block, blockParts, and seenCommit to the underlying db.
 // blockParts: Must be parts of the block
 // seenCommit: The +2/3 precommits that were seen which committed at height.
@@ -369,10 +636,15 @@ func (bs *BlockStore) SaveBlock(
 		bs.saveBlockPart(height, i, part)
 	}
 
-	err := ipld.PutBlock(ctx, bs.dag, block, ipfs.MockRouting(), bs.logger)
+	err := ipld.PutBlock(ctx, bs.dag, block, bs.codec, bs.metrics, bs.logger)
 	if err != nil {
 		return err
 	}
+	if bs.provider != nil {
+		if err := bs.provider.Provide(height, &block.DataAvailabilityHeader); err != nil {
+			bs.logger.Error("Failed to queue block data for providing", "height", height, "err", err)
+		}
+	}
 
 	// Save block meta
 	blockMeta := types.NewBlockMeta(block, blockParts)
@@ -411,6 +683,7 @@ func (bs *BlockStore) SaveBlock(
 	bs.height = height
 	if bs.base == 0 {
 		bs.base = height
+		bs.daBase = height
 	}
 	bs.mtx.Unlock()
 
@@ -436,6 +709,7 @@ func (bs *BlockStore) saveState() {
 	bss := tmstore.BlockStoreState{
 		Base:   bs.base,
 		Height: bs.height,
+		DaBase: bs.daBase,
 	}
 	bs.mtx.RUnlock()
 	SaveBlockStoreState(&bss, bs.db)
@@ -473,6 +747,10 @@ func calcBlockHashKey(hash []byte) []byte {
 	return []byte(fmt.Sprintf("BH:%x", hash))
 }
 
+func calcDAHeaderKey(height int64) []byte {
+	return []byte(fmt.Sprintf("DAH:%v", height))
+}
+
 //-----------------------------------------------------------------------------
 
 var blockStoreKey = []byte("blockStore")
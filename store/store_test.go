@@ -17,6 +17,7 @@ import (
 
 	cfg "github.com/lazyledger/lazyledger-core/config"
 	"github.com/lazyledger/lazyledger-core/crypto"
+	"github.com/lazyledger/lazyledger-core/ipfs/plugin"
 	dbm "github.com/lazyledger/lazyledger-core/libs/db"
 	"github.com/lazyledger/lazyledger-core/libs/db/memdb"
 	"github.com/lazyledger/lazyledger-core/libs/log"
@@ -486,6 +487,7 @@ func TestPruneBlocks(t *testing.T) {
 	assert.EqualValues(t, tmstore.BlockStoreState{
 		Base:   1200,
 		Height: 1500,
+		DaBase: 1200,
 	}, LoadBlockStoreState(db))
 
 	b, err := bs.LoadBlock(ctx, 1200)
@@ -547,6 +549,124 @@ func TestPruneBlocks(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestPruneBlocksRetainDAData(t *testing.T) {
+	ctx := context.TODO()
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+	bs.SetDARetainBlocks(5)
+
+	dahs := make(map[int64]types.DataAvailabilityHeader)
+	for h := int64(1); h <= 10; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		err := bs.SaveBlock(ctx, block, block.MakePartSet(2), makeTestCommit(h, tmtime.Now()))
+		require.NoError(t, err)
+		dahs[h] = block.DataAvailabilityHeader
+	}
+	hasData := func(h int64) bool {
+		dah := dahs[h]
+		_, err := bs.dag.Get(ctx, plugin.MustCidFromNamespacedSha256(dah.RowsRoots.Bytes()[0]))
+		return err == nil
+	}
+
+	// the block data of the last 5 heights is retained
+	pruned, err := bs.PruneBlocks(8)
+	require.NoError(t, err)
+	assert.EqualValues(t, 7, pruned)
+	assert.EqualValues(t, 8, bs.Base())
+	assert.EqualValues(t, 6, bs.DABase())
+
+	for h := int64(1); h <= 10; h++ {
+		assert.Equal(t, h >= 6, hasData(h), "height %d", h)
+		if h >= 6 {
+			dah := bs.LoadDAHeader(h)
+			require.NotNil(t, dah)
+			assert.Equal(t, dahs[h], *dah)
+		} else {
+			assert.Nil(t, bs.LoadDAHeader(h))
+		}
+	}
+
+	// without retaining, the block data is pruned together with the blocks
+	bs.SetDARetainBlocks(0)
+	_, err = bs.PruneBlocks(10)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, bs.DABase())
+	for h := int64(6); h < 10; h++ {
+		assert.False(t, hasData(h), "height %d", h)
+		assert.Nil(t, bs.LoadDAHeader(h))
+	}
+	assert.True(t, hasData(10))
+	assert.EqualValues(t, 10, LoadBlockStoreState(bs.db).DaBase)
+}
+
+func TestPruneBlocksSharedData(t *testing.T) {
+	ctx := context.TODO()
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+
+	prunerDB := memdb.NewDB()
+	bs.SetPrunerDB(prunerDB)
+
+	// blocks with equal data share it in the IPFS repo
+	txs := makeTxs(1)
+	for h := int64(1); h <= 3; h++ {
+		block, _ := state.MakeBlock(h, txs, nil, nil, types.Messages{}, new(types.Commit),
+			state.Validators.GetProposer().Address)
+		err := bs.SaveBlock(ctx, block, block.MakePartSet(2), makeTestCommit(h, tmtime.Now()))
+		require.NoError(t, err)
+	}
+	// the references to the data are only counted when pruning
+	iter, err := prunerDB.Iterator(nil, nil)
+	require.NoError(t, err)
+	assert.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	pruned, err := bs.PruneBlocks(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+
+	block, err := bs.LoadBlock(ctx, 3)
+	require.NoError(t, err)
+	require.NotNil(t, block)
+	assert.Equal(t, txs, block.Data.Txs)
+}
+
+func TestProvideBlockData(t *testing.T) {
+	ctx := context.TODO()
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+	provider := &mockProvider{provided: make(map[int64]types.DataAvailabilityHeader)}
+	bs.SetProvider(provider)
+	bs.SetDARetainBlocks(2)
+
+	for h := int64(1); h <= 5; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		err := bs.SaveBlock(ctx, block, block.MakePartSet(2), makeTestCommit(h, tmtime.Now()))
+		require.NoError(t, err)
+		assert.Equal(t, block.DataAvailabilityHeader, provider.provided[h])
+	}
+
+	// providing stops once the block data is pruned, not with the blocks
+	_, err := bs.PruneBlocks(5)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3}, provider.canceled)
+}
+
+type mockProvider struct {
+	provided map[int64]types.DataAvailabilityHeader
+	canceled []int64
+}
+
+func (p *mockProvider) Provide(height int64, dah *types.DataAvailabilityHeader) error {
+	p.provided[height] = *dah
+	return nil
+}
+
+func (p *mockProvider) Cancel(height int64) error {
+	p.canceled = append(p.canceled, height)
+	return nil
+}
+
 func TestLoadBlockMeta(t *testing.T) {
 	bs, db := freshBlockStore()
 	height := int64(10)
@@ -589,6 +709,22 @@ func TestLoadBlockMeta(t *testing.T) {
 	}
 }
 
+func TestLoadDAHeader(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
+	defer cleanup()
+
+	require.Nil(t, bs.LoadDAHeader(1), "a non-existent block should return nil")
+
+	block := makeBlock(1, state, new(types.Commit))
+	err := bs.SaveBlock(context.TODO(), block, block.MakePartSet(2), makeTestCommit(1, tmtime.Now()))
+	require.NoError(t, err)
+
+	dah := bs.LoadDAHeader(1)
+	require.NotNil(t, dah)
+	assert.Equal(t, block.DataAvailabilityHeader, *dah)
+	assert.EqualValues(t, block.DataHash, dah.Hash())
+}
+
 func TestBlockFetchAtHeight(t *testing.T) {
 	ctx := context.TODO()
 	state, bs, cleanup := makeStateAndBlockStore(log.NewTMLogger(new(bytes.Buffer)))
@@ -98,6 +98,26 @@ func (dah *DataAvailabilityHeader) Equals(to *DataAvailabilityHeader) bool {
 	return bytes.Equal(dah.Hash(), to.Hash())
 }
 
+// ValidateBasic checks that the DAHeader describes an extended data square of
+// a width allowed by the protocol. The width allowed at a specific height
+// depends on the DataAvailabilityParams and is checked during block
+// validation.
+func (dah *DataAvailabilityHeader) ValidateBasic() error {
+	if dah == nil {
+		return errors.New("nil DAHeader")
+	}
+	if len(dah.RowsRoots) != len(dah.ColumnRoots) {
+		return fmt.Errorf("unequal number of row and column roots: %d != %d",
+			len(dah.RowsRoots), len(dah.ColumnRoots))
+	}
+	width := len(dah.RowsRoots)
+	if width < 2*consts.MinSquareSize || width > 2*consts.MaxSquareSize || !isPowerOf2(uint32(width)) {
+		return fmt.Errorf("invalid extended square width %d, must be a power of 2 between %d and %d",
+			width, 2*consts.MinSquareSize, 2*consts.MaxSquareSize)
+	}
+	return nil
+}
+
 // Hash computes and caches the merkle root of the row and column roots.
 func (dah *DataAvailabilityHeader) Hash() []byte {
 	if dah == nil {
@@ -160,6 +180,10 @@ type Block struct {
 	Data                   `json:"data"`
 	DataAvailabilityHeader DataAvailabilityHeader `json:"availability_header"`
 	LastCommit             *Commit                `json:"last_commit"`
+
+	// eds caches the extended data square the DataAvailabilityHeader was
+	// computed from, so it is not recomputed when putting the block to IPFS
+	eds *rsmt2d.ExtendedDataSquare
 }
 
 // ValidateBasic performs basic validation that doesn't involve state data.
@@ -205,6 +229,19 @@ func (b *Block) ValidateBasic() error {
 		return fmt.Errorf("wrong Header.EvidenceHash. Expected %X, got %X", w, g)
 	}
 
+	// Intermediate state roots are optional, but if the app provides them,
+	// there is one for each transaction.
+	if n := len(b.Data.IntermediateStateRoots.RawRootsList); n > 0 && n != len(b.Data.Txs) {
+		return fmt.Errorf("expected an intermediate state root for each of the %d txs, got %d",
+			len(b.Data.Txs), n)
+	}
+
+	// Messages not sorted by namespace or with a reserved namespace could not
+	// be retrieved reliably from the data square.
+	if err := b.Data.Messages.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid messages: %w", err)
+	}
+
 	return nil
 }
 
@@ -214,7 +251,13 @@ func (b *Block) fillHeader() {
 		b.LastCommitHash = b.LastCommit.Hash()
 	}
 	if b.DataHash == nil || b.DataAvailabilityHeader.hash == nil {
-		b.fillDataAvailabilityHeader()
+		// blocks without a DataAvailabilityHeader are laid out in the
+		// smallest square and extended with the default codec, see
+		// MakeBlockWithCodec for using other data availability params
+		if len(b.DataAvailabilityHeader.RowsRoots) == 0 {
+			b.fillDataAvailabilityHeader(DefaultCodec(), consts.MinSquareSize)
+		}
+		b.DataHash = b.DataAvailabilityHeader.Hash()
 	}
 	if b.EvidenceHash == nil {
 		b.EvidenceHash = b.Evidence.Hash()
@@ -224,48 +267,88 @@ func (b *Block) fillHeader() {
 // TODO: Move out from 'types' package
 // fillDataAvailabilityHeader fills in any remaining DataAvailabilityHeader fields
 // that are a function of the block data.
-func (b *Block) fillDataAvailabilityHeader() {
-	namespacedShares, dataSharesLen := b.Data.ComputeShares()
-	shares := namespacedShares.RawShares()
-
-	// create the nmt wrapper to generate row and col commitments
-	squareSize := uint32(math.Sqrt(float64(len(shares))))
-	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize))
-
-	// TODO(ismail): for better efficiency and a larger number shares
-	// we should switch to the rsmt2d.LeopardFF16 codec:
-	extendedDataSquare, err := rsmt2d.ComputeExtendedDataSquare(shares, rsmt2d.NewRSGF8Codec(), tree.Constructor)
+func (b *Block) fillDataAvailabilityHeader(codec rsmt2d.Codec, minSquareSize uint32) {
+	shares, dataSharesLen := b.Data.ComputeSharesForBlockVersion(b.Version.Block, minSquareSize)
+	eds, err := ExtendShares(shares, codec)
+	if err != nil {
+		panic(fmt.Sprintf("unexpected error: %v", err))
+	}
+	dah, err := NewDataAvailabilityHeader(eds)
 	if err != nil {
 		panic(fmt.Sprintf("unexpected error: %v", err))
 	}
 
-	// generate the row and col roots using the EDS and nmt wrapper
-	rowRoots := extendedDataSquare.RowRoots()
-	colRoots := extendedDataSquare.ColumnRoots()
+	b.eds = eds
+	b.DataAvailabilityHeader = dah
+	// return the root hash of DA Header
+	b.DataHash = b.DataAvailabilityHeader.Hash()
+	b.NumOriginalDataShares = uint64(dataSharesLen)
+}
 
-	b.DataAvailabilityHeader = DataAvailabilityHeader{
-		RowsRoots:   make([]namespace.IntervalDigest, extendedDataSquare.Width()),
-		ColumnRoots: make([]namespace.IntervalDigest, extendedDataSquare.Width()),
+// ExtendedDataSquare returns the extended data square the
+// DataAvailabilityHeader of the block was computed from. It returns nil if the
+// square was neither computed nor set for this instance, e.g. for blocks
+// decoded from protobuf.
+func (b *Block) ExtendedDataSquare() *rsmt2d.ExtendedDataSquare {
+	if b == nil {
+		return nil
 	}
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.eds
+}
 
-	// todo(evan): remove interval digests
-	// convert the roots to interval digests
-	for i := 0; i < len(rowRoots); i++ {
-		rowRoot, err := namespace.IntervalDigestFromBytes(consts.NamespaceSize, rowRoots[i])
-		if err != nil {
-			panic(err)
+// SetExtendedDataSquare caches the extended data square of the block data,
+// which must be the one the DataAvailabilityHeader commits to.
+func (b *Block) SetExtendedDataSquare(eds *rsmt2d.ExtendedDataSquare) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.eds = eds
+}
+
+// MessagesByNamespace groups the messages of the block by namespace. The
+// messages of each namespace are returned along with the shares of the
+// namespace proven against the row roots of the DataAvailabilityHeader, see
+// DataAvailabilityHeader.NamespacedRows. As proving the shares is expensive,
+// only the namespaces include returns true for are returned, or all of them
+// if include is nil. The extended data square of the block must be available,
+// see ExtendedDataSquare.
+func (b *Block) MessagesByNamespace(include func(nID namespace.ID) bool) ([]EventDataNewBlockMessages, error) {
+	msgs := b.Data.Messages.MessagesList
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+	eds := b.ExtendedDataSquare()
+	if eds == nil {
+		return nil, errors.New("extended data square of the block is not available")
+	}
+
+	var byNamespace []EventDataNewBlockMessages
+	// messages are sorted by namespace, see Messages.ValidateBasic
+	for start := 0; start < len(msgs); {
+		nID := msgs[start].NamespaceID
+		end := start + 1
+		for end < len(msgs) && bytes.Equal(msgs[end].NamespaceID, nID) {
+			end++
 		}
-		colRoot, err := namespace.IntervalDigestFromBytes(consts.NamespaceSize, colRoots[i])
+		if include != nil && !include(nID) {
+			start = end
+			continue
+		}
+
+		rows, err := b.DataAvailabilityHeader.NamespacedRows(eds, nID)
 		if err != nil {
-			panic(err)
+			return nil, err
 		}
-		b.DataAvailabilityHeader.RowsRoots[i] = rowRoot
-		b.DataAvailabilityHeader.ColumnRoots[i] = colRoot
+		byNamespace = append(byNamespace, EventDataNewBlockMessages{
+			Height:      b.Height,
+			NamespaceID: tmbytes.HexBytes(nID),
+			Messages:    msgs[start:end],
+			Rows:        rows,
+		})
+		start = end
 	}
-
-	// return the root hash of DA Header
-	b.DataHash = b.DataAvailabilityHeader.Hash()
-	b.NumOriginalDataShares = uint64(dataSharesLen)
+	return byNamespace, nil
 }
 
 // Hash computes and returns the block hash.
@@ -475,15 +558,26 @@ func MaxDataBytesNoEvidence(maxBytes int64, valsCount int) int64 {
 }
 
 // MakeBlock returns a new block with an empty header, except what can be
-// computed from itself.
+// computed from itself. The block data is extended with the default codec.
 // It populates the same set of fields validated by ValidateBasic.
 func MakeBlock(
 	height int64,
 	txs []Tx, evidence []Evidence, intermediateStateRoots []tmbytes.HexBytes, messages Messages,
 	lastCommit *Commit) *Block {
+	return MakeBlockWithCodec(height, txs, evidence, intermediateStateRoots, messages, lastCommit,
+		version.BlockProtocol, DefaultCodec(), consts.MinSquareSize)
+}
+
+// MakeBlockWithCodec is like MakeBlock but makes a block of the given version
+// of the block protocol, which lays out the block data in a square of at least
+// minSquareSize and extends it with the given erasure codec.
+func MakeBlockWithCodec(
+	height int64,
+	txs []Tx, evidence []Evidence, intermediateStateRoots []tmbytes.HexBytes, messages Messages,
+	lastCommit *Commit, blockVersion uint64, codec rsmt2d.Codec, minSquareSize uint32) *Block {
 	block := &Block{
 		Header: Header{
-			Version: tmversion.Consensus{Block: version.BlockProtocol, App: 0},
+			Version: tmversion.Consensus{Block: blockVersion, App: 0},
 			Height:  height,
 		},
 		Data: Data{
@@ -494,6 +588,7 @@ func MakeBlock(
 		},
 		LastCommit: lastCommit,
 	}
+	block.fillDataAvailabilityHeader(codec, minSquareSize)
 	block.fillHeader()
 	return block
 }
@@ -562,7 +657,9 @@ func (h *Header) Populate(
 //
 // NOTE: Timestamp validation is subtle and handled elsewhere.
 func (h Header) ValidateBasic() error {
-	if h.Version.Block != version.BlockProtocol {
+	// blocks of chains started before the current version keep the version
+	// they were started with, see LegacyLayoutBlockVersion
+	if h.Version.Block != version.BlockProtocol && h.Version.Block != LegacyLayoutBlockVersion {
 		return fmt.Errorf("block protocol is incorrect: got: %d, want: %d ", h.Version.Block, version.BlockProtocol)
 	}
 	if len(h.ChainID) > MaxChainIDLen {
@@ -1215,6 +1312,22 @@ type Messages struct {
 	MessagesList []Message `json:"msgs"`
 }
 
+// ValidateBasic checks that the messages are sorted by namespace and that
+// each message is valid, see Message.ValidateBasic. Messages of the same
+// namespace may appear in any order.
+func (msgs Messages) ValidateBasic() error {
+	for i, msg := range msgs.MessagesList {
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid message (#%d): %w", i, err)
+		}
+		if i > 0 && bytes.Compare(msgs.MessagesList[i-1].NamespaceID, msg.NamespaceID) > 0 {
+			return fmt.Errorf("message (#%d) with namespace %X is not sorted after namespace %X",
+				i, msg.NamespaceID, msgs.MessagesList[i-1].NamespaceID)
+		}
+	}
+	return nil
+}
+
 type IntermediateStateRoots struct {
 	RawRootsList []tmbytes.HexBytes `json:"intermediate_roots"`
 }
@@ -1245,37 +1358,119 @@ func (msgs Messages) splitIntoShares() NamespacedShares {
 }
 
 // ComputeShares splits block data into shares of an original data square and
-// returns them along with an amount of non-redundant shares.
+// returns them along with an amount of non-redundant shares. The data is laid
+// out in the smallest square it fits into according to the non-interactive
+// default rules, see SquareSize.
 func (data *Data) ComputeShares() (NamespacedShares, int) {
-	// TODO(ismail): splitting into shares should depend on the block size and layout
-	// see: https://github.com/lazyledger/lazyledger-specs/blob/master/specs/block_proposer.md#laying-out-transactions-and-messages
+	return data.ComputeSharesWithMinSquareSize(consts.MinSquareSize)
+}
+
+// ComputeSharesWithMinSquareSize is like ComputeShares but lays out the data in
+// a square with a width of at least minSquareSize, see
+// DataAvailabilityParams.MinSquareSize.
+func (data *Data) ComputeSharesWithMinSquareSize(minSquareSize uint32) (NamespacedShares, int) {
+	return data.ComputeSharesForBlockVersion(version.BlockProtocol, minSquareSize)
+}
 
+// ComputeSharesForBlockVersion is like ComputeSharesWithMinSquareSize but lays
+// out the data the way blocks of the given version of the block protocol do,
+// see LegacyLayoutBlockVersion.
+func (data *Data) ComputeSharesForBlockVersion(blockVersion uint64, minSquareSize uint32) (NamespacedShares, int) {
 	// reserved shares:
-	txShares := data.Txs.splitIntoShares()
-	intermRootsShares := data.IntermediateStateRoots.splitIntoShares()
-	evidenceShares := data.Evidence.splitIntoShares()
+	reservedShares := data.reservedShares()
+	if blockVersion == LegacyLayoutBlockVersion {
+		return legacyLayoutShares(reservedShares, data.Messages.splitIntoShares(), minSquareSize)
+	}
 
 	// application data shares from messages:
-	msgShares := data.Messages.splitIntoShares()
-	curLen := len(txShares) + len(intermRootsShares) + len(evidenceShares) + len(msgShares)
+	msgShares := data.Messages.splitIntoShareGroups()
+	msgLens := make([]int, len(msgShares))
+	for i, shares := range msgShares {
+		msgLens[i] = len(shares)
+	}
+
+	return layoutShares(reservedShares, msgShares, squareSize(minSquareSize, len(reservedShares), msgLens))
+}
+
+// ComputeDataAvailabilityHeader erasure codes the shares of the block data
+// laid out in a square of at least minSquareSize with the given codec and
+// returns the row and column roots of the resulting extended data square along
+// with the amount of non-redundant shares.
+func (data *Data) ComputeDataAvailabilityHeader(
+	codec rsmt2d.Codec,
+	minSquareSize uint32,
+) (DataAvailabilityHeader, int, error) {
+	eds, dataSharesLen, err := data.ComputeExtendedDataSquare(codec, minSquareSize)
+	if err != nil {
+		return DataAvailabilityHeader{}, 0, err
+	}
+
+	dah, err := NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return DataAvailabilityHeader{}, 0, err
+	}
+	return dah, dataSharesLen, nil
+}
+
+// ComputeExtendedDataSquare erasure codes the shares of the block data laid
+// out in a square of at least minSquareSize with the given codec and returns
+// the resulting extended data square along with the amount of non-redundant
+// shares.
+func (data *Data) ComputeExtendedDataSquare(
+	codec rsmt2d.Codec,
+	minSquareSize uint32,
+) (*rsmt2d.ExtendedDataSquare, int, error) {
+	namespacedShares, dataSharesLen := data.ComputeSharesWithMinSquareSize(minSquareSize)
+	eds, err := ExtendShares(namespacedShares, codec)
+	if err != nil {
+		return nil, 0, err
+	}
+	return eds, dataSharesLen, nil
+}
+
+// ExtendShares erasure codes the shares of an original data square with the
+// given codec and returns the resulting extended data square.
+func ExtendShares(namespacedShares NamespacedShares, codec rsmt2d.Codec) (*rsmt2d.ExtendedDataSquare, error) {
+	shares := namespacedShares.RawShares()
+
+	// create the nmt wrapper to generate row and col commitments
+	squareSize := uint32(math.Sqrt(float64(len(shares))))
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize))
+
+	eds, err := rsmt2d.ComputeExtendedDataSquare(shares, codec, tree.Constructor)
+	if err != nil {
+		return nil, fmt.Errorf("failure to compute the extended data square: %w", err)
+	}
+	return eds, nil
+}
 
-	// find the number of shares needed to create a square that has a power of
-	// two width
-	wantLen := paddedLen(curLen)
+// NewDataAvailabilityHeader generates the row and column roots of the given
+// extended data square, which must have been computed using the NMT wrapper.
+func NewDataAvailabilityHeader(eds *rsmt2d.ExtendedDataSquare) (DataAvailabilityHeader, error) {
+	rowRoots := eds.RowRoots()
+	colRoots := eds.ColumnRoots()
 
-	// ensure that the min square size is used
-	if wantLen < consts.MinSharecount {
-		wantLen = consts.MinSharecount
+	dah := DataAvailabilityHeader{
+		RowsRoots:   make([]namespace.IntervalDigest, eds.Width()),
+		ColumnRoots: make([]namespace.IntervalDigest, eds.Width()),
 	}
 
-	tailShares := TailPaddingShares(wantLen - curLen)
+	// todo(evan): remove interval digests
+	// convert the roots to interval digests
+	for i := 0; i < len(rowRoots); i++ {
+		rowRoot, err := namespace.IntervalDigestFromBytes(consts.NamespaceSize, rowRoots[i])
+		if err != nil {
+			return DataAvailabilityHeader{}, err
+		}
+		colRoot, err := namespace.IntervalDigestFromBytes(consts.NamespaceSize, colRoots[i])
+		if err != nil {
+			return DataAvailabilityHeader{}, err
+		}
+		dah.RowsRoots[i] = rowRoot
+		dah.ColumnRoots[i] = colRoot
+	}
 
-	return append(append(append(append(
-		txShares,
-		intermRootsShares...),
-		evidenceShares...),
-		msgShares...),
-		tailShares...), curLen
+	return dah, nil
 }
 
 // paddedLen calculates the number of shares needed to make a power of 2 square
@@ -1306,6 +1501,11 @@ func nextHighestPowerOf2(v uint32) uint32 {
 	return v
 }
 
+// isPowerOf2 checks whether v is a power of 2.
+func isPowerOf2(v uint32) bool {
+	return v != 0 && v&(v-1) == 0
+}
+
 type Message struct {
 	// NamespaceID defines the namespace of this message, i.e. the
 	// namespace it will use in the namespaced Merkle tree.
@@ -1319,6 +1519,25 @@ type Message struct {
 	Data []byte
 }
 
+// ValidateBasic checks that the message has data and that its namespace is
+// neither reserved nor used for padding or parity shares.
+func (m Message) ValidateBasic() error {
+	if len(m.NamespaceID) != consts.NamespaceSize {
+		return fmt.Errorf("namespace must be %d bytes long, got %d", consts.NamespaceSize, len(m.NamespaceID))
+	}
+	if bytes.Compare(m.NamespaceID, consts.MaxReservedNamespace) <= 0 {
+		return fmt.Errorf("namespace %X is reserved", m.NamespaceID)
+	}
+	if bytes.Equal(m.NamespaceID, consts.TailPaddingNamespaceID) ||
+		bytes.Equal(m.NamespaceID, consts.ParitySharesNamespaceID) {
+		return fmt.Errorf("namespace %X is reserved for padding or parity shares", m.NamespaceID)
+	}
+	if len(m.Data) == 0 {
+		return errors.New("empty data")
+	}
+	return nil
+}
+
 var (
 	MessageEmpty  = Message{}
 	MessagesEmpty = Messages{}
@@ -1347,6 +1566,20 @@ func MessagesFromProto(p *tmproto.Messages) Messages {
 	return Messages{MessagesList: msgs}
 }
 
+// IntermediateStateRootsFromProto returns the intermediate state roots of the
+// given protobuf representation.
+func IntermediateStateRootsFromProto(p *tmproto.IntermediateStateRoots) IntermediateStateRoots {
+	if p == nil || len(p.RawRootsList) == 0 {
+		return IntermediateStateRoots{}
+	}
+
+	roots := make([]tmbytes.HexBytes, len(p.RawRootsList))
+	for i, r := range p.RawRootsList {
+		roots[i] = r
+	}
+	return IntermediateStateRoots{RawRootsList: roots}
+}
+
 // StringIndented returns an indented string representation of the transactions.
 func (data *Data) StringIndented(indent string) string {
 	if data == nil {
@@ -1421,15 +1654,7 @@ func DataFromProto(dp *tmproto.Data) (Data, error) {
 	} else {
 		data.Messages = Messages{}
 	}
-	if len(dp.IntermediateStateRoots.RawRootsList) > 0 {
-		roots := make([]tmbytes.HexBytes, len(dp.IntermediateStateRoots.RawRootsList))
-		for i, r := range dp.IntermediateStateRoots.RawRootsList {
-			roots[i] = r
-		}
-		data.IntermediateStateRoots = IntermediateStateRoots{RawRootsList: roots}
-	} else {
-		data.IntermediateStateRoots = IntermediateStateRoots{}
-	}
+	data.IntermediateStateRoots = IntermediateStateRootsFromProto(&dp.IntermediateStateRoots)
 
 	return *data, nil
 }
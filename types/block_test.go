@@ -14,6 +14,7 @@ import (
 	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/lazyledger/nmt/namespace"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -103,6 +104,27 @@ func TestBlockValidateBasic(t *testing.T) {
 			emptyEv := &DuplicateVoteEvidence{}
 			blk.Evidence = EvidenceData{Evidence: []Evidence{emptyEv}}
 		}, true},
+		{"ISR for each Tx", func(blk *Block) {
+			blk.Data.IntermediateStateRoots = IntermediateStateRoots{RawRootsList: []bytes.HexBytes{
+				tmrand.Bytes(32), tmrand.Bytes(32),
+			}}
+		}, false},
+		{"Missing ISR", func(blk *Block) {
+			blk.Data.IntermediateStateRoots = IntermediateStateRoots{RawRootsList: []bytes.HexBytes{
+				tmrand.Bytes(32),
+			}}
+		}, true},
+		{"Unsorted Messages", func(blk *Block) {
+			blk.Data.Messages = Messages{MessagesList: []Message{
+				{NamespaceID: []byte{2, 2, 2, 2, 2, 2, 2, 2}, Data: []byte{0x1}},
+				{NamespaceID: []byte{1, 1, 1, 1, 1, 1, 1, 1}, Data: []byte{0x2}},
+			}}
+		}, true},
+		{"Message with reserved namespace", func(blk *Block) {
+			blk.Data.Messages = Messages{MessagesList: []Message{
+				{NamespaceID: consts.TxNamespaceID, Data: []byte{0x1}},
+			}}
+		}, true},
 	}
 	for i, tc := range testCases {
 		tc := tc
@@ -118,6 +140,82 @@ func TestBlockValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMessagesValidateBasic(t *testing.T) {
+	nid1 := []byte{1, 1, 1, 1, 1, 1, 1, 1}
+	nid2 := []byte{2, 2, 2, 2, 2, 2, 2, 2}
+
+	testCases := []struct {
+		testName string
+		msgs     []Message
+		expErr   bool
+	}{
+		{"no messages", nil, false},
+		{"sorted", []Message{{nid1, []byte{0x1}}, {nid2, []byte{0x2}}}, false},
+		{"same namespace", []Message{{nid1, []byte{0x2}}, {nid1, []byte{0x1}}}, false},
+		{"unsorted", []Message{{nid2, []byte{0x1}}, {nid1, []byte{0x2}}}, true},
+		{"empty data", []Message{{nid1, nil}}, true},
+		{"short namespace", []Message{{nid1[1:], []byte{0x1}}}, true},
+		{"tx namespace", []Message{{consts.TxNamespaceID, []byte{0x1}}}, true},
+		{"max reserved namespace", []Message{{consts.MaxReservedNamespace, []byte{0x1}}}, true},
+		{"tail padding namespace", []Message{{consts.TailPaddingNamespaceID, []byte{0x1}}}, true},
+		{"parity shares namespace", []Message{{consts.ParitySharesNamespaceID, []byte{0x1}}}, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			err := Messages{MessagesList: tc.msgs}.ValidateBasic()
+			assert.Equal(t, tc.expErr, err != nil, "%v", err)
+		})
+	}
+}
+
+func TestBlockMessagesByNamespace(t *testing.T) {
+	nid1 := []byte{1, 1, 1, 1, 1, 1, 1, 1}
+	nid2 := []byte{2, 2, 2, 2, 2, 2, 2, 2}
+	msgs := []Message{
+		{nid1, []byte{0x1}},
+		{nid1, stdbytes.Repeat([]byte{0x2}, 3*consts.MsgShareSize)},
+		{nid2, []byte{0x3}},
+	}
+	block := MakeBlock(int64(3), []Tx{Tx("Hello World")}, nil, nil, Messages{MessagesList: msgs}, nil)
+
+	byNamespace, err := block.MessagesByNamespace(nil)
+	require.NoError(t, err)
+	require.Len(t, byNamespace, 2)
+	assert.Equal(t, msgs[:2], byNamespace[0].Messages)
+	assert.Equal(t, msgs[2:], byNamespace[1].Messages)
+
+	for i, nid := range [][]byte{nid1, nid2} {
+		assert.EqualValues(t, 3, byNamespace[i].Height)
+		assert.EqualValues(t, nid, byNamespace[i].NamespaceID)
+		require.NoError(t, block.DataAvailabilityHeader.VerifyNamespace(nid, byNamespace[i].Rows))
+
+		// the messages can be parsed from the proven shares
+		var shares [][]byte
+		for _, row := range byNamespace[i].Rows {
+			for _, share := range row.Shares {
+				shares = append(shares, share)
+			}
+		}
+		parsed, err := ParseMessages(shares)
+		require.NoError(t, err)
+		assert.Equal(t, byNamespace[i].Messages, parsed.MessagesList)
+	}
+
+	// only the included namespaces are proven
+	included, err := block.MessagesByNamespace(func(nID namespace.ID) bool {
+		return stdbytes.Equal(nID, nid2)
+	})
+	require.NoError(t, err)
+	require.Len(t, included, 1)
+	assert.Equal(t, byNamespace[1], included[0])
+
+	// the messages can not be proven without the extended data square
+	block.SetExtendedDataSquare(nil)
+	_, err = block.MessagesByNamespace(nil)
+	assert.Error(t, err)
+}
+
 func TestBlockHash(t *testing.T) {
 	assert.Nil(t, (*Block)(nil).Hash())
 	assert.Nil(t, MakeBlock(int64(3), []Tx{Tx("Hello World")}, nil, nil, Messages{}, nil).Hash())
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/lazyledger/rsmt2d"
+
+	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+)
+
+// erasureCodec describes an erasure codec supported by this build.
+type erasureCodec struct {
+	newCodec func() rsmt2d.Codec
+	// maxSquareSize is the max width of the original data square the codec
+	// can extend.
+	maxSquareSize uint32
+}
+
+// codecs contains all the erasure codecs supported by this build. Codecs
+// requiring cgo are only registered with their respective build tag.
+var codecs = map[tmproto.ErasureCodec]erasureCodec{
+	tmproto.ErasureCodecRSGF8: {
+		newCodec:      func() rsmt2d.Codec { return rsmt2d.NewRSGF8Codec() },
+		maxSquareSize: 128, // GF(2^8) supports up to 256 shares per row
+	},
+}
+
+// DefaultCodec returns the erasure codec used by chains that do not specify
+// one in their consensus params.
+func DefaultCodec() rsmt2d.Codec {
+	return rsmt2d.NewRSGF8Codec()
+}
+
+// Codec returns a new instance of the given erasure codec. It returns an
+// error if the codec is unknown or not supported by this build.
+func Codec(codec tmproto.ErasureCodec) (rsmt2d.Codec, error) {
+	c, ok := codecs[codec]
+	if !ok {
+		return nil, fmt.Errorf("unsupported erasure codec %v", codec)
+	}
+	return c.newCodec(), nil
+}
+
+// MaxSquareSize returns the max width of the original data square that can be
+// extended with the given erasure codec. It returns 0 if the codec is not
+// supported by this build.
+func MaxSquareSize(codec tmproto.ErasureCodec) uint32 {
+	c, ok := codecs[codec]
+	if !ok {
+		return 0
+	}
+	if c.maxSquareSize > consts.MaxSquareSize {
+		return consts.MaxSquareSize
+	}
+	return c.maxSquareSize
+}
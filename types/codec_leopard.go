@@ -0,0 +1,19 @@
+// +build leopard
+
+package types
+
+import (
+	"github.com/lazyledger/rsmt2d"
+
+	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+)
+
+// The Leopard codec is implemented in C and thus only available if built with
+// the leopard build tag and cgo enabled.
+func init() {
+	codecs[tmproto.ErasureCodecLeopardFF16] = erasureCodec{
+		newCodec:      func() rsmt2d.Codec { return rsmt2d.NewLeoRSFF16Codec() },
+		maxSquareSize: consts.MaxSquareSize,
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+	"github.com/lazyledger/lazyledger-core/version"
+)
+
+func TestCodec(t *testing.T) {
+	codec, err := Codec(DefaultDataAvailabilityParams().Codec)
+	require.NoError(t, err)
+	assert.NotNil(t, codec)
+	assert.EqualValues(t, 128, MaxSquareSize(tmproto.ErasureCodecRSGF8))
+
+	_, err = Codec(tmproto.ErasureCodec(100))
+	assert.Error(t, err)
+	assert.Zero(t, MaxSquareSize(tmproto.ErasureCodec(100)))
+}
+
+func TestMakeBlockWithCodec(t *testing.T) {
+	txs := []Tx{Tx("foo"), Tx("bar")}
+	block := MakeBlockWithCodec(1, txs, nil, nil, Messages{}, nil, version.BlockProtocol, DefaultCodec(),
+		consts.MinSquareSize)
+	assert.Equal(t, MakeBlock(1, txs, nil, nil, Messages{}, nil).DataHash, block.DataHash)
+
+	dah, _, err := block.Data.ComputeDataAvailabilityHeader(DefaultCodec(), consts.MinSquareSize)
+	require.NoError(t, err)
+	assert.Equal(t, dah.Hash(), block.DataAvailabilityHeader.Hash())
+}
@@ -27,11 +27,15 @@ const (
 	// MaxSquareSize is the maximum number of
 	// rows/columns of the original data shares in square layout.
 	// Corresponds to AVAILABLE_DATA_ORIGINAL_SQUARE_MAX in the spec.
-	// 128*128*256 = 4 Megabytes
+	// It is the upper bound of the max square size consensus parameter,
+	// which the erasure codec of a chain may further limit,
+	// e.g. to 128 for RSGF8 (128*128*256 = 4 Megabytes).
+	// 512*512*256 = 64 Megabytes
 	// TODO(ismail): settle on a proper max square
-	MaxSquareSize = 128
+	MaxSquareSize = 512
 
-	// MinSquareSize depicts the smallest original square width.
+	// MinSquareSize depicts the smallest original square width. It is the
+	// lower bound of the min square size consensus parameter.
 	MinSquareSize = 1
 	MinSharecount = MinSquareSize * MinSquareSize
 )
@@ -52,6 +56,10 @@ var (
 	// reserved for protocol use. It is derived from NAMESPACE_ID_MAX_RESERVED
 	// https://github.com/lazyledger/lazyledger-specs/blob/master/specs/consensus.md#constants
 	MaxReservedNamespace = namespace.ID{0, 0, 0, 0, 0, 0, 0, 255}
+	// ReservedPaddingNamespaceID is the namespace ID for the padding between
+	// the reserved data and the first message. As the namespace is reserved,
+	// the padding will be ignored
+	ReservedPaddingNamespaceID = MaxReservedNamespace
 	// TailPaddingNamespaceID is the namespace ID for tail padding. All data
 	// with this namespace will be ignored
 	TailPaddingNamespaceID = namespace.ID{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/lazyledger/nmt/namespace"
+
 	"github.com/lazyledger/lazyledger-core/abci/types"
 	"github.com/lazyledger/lazyledger-core/libs/log"
 	tmpubsub "github.com/lazyledger/lazyledger-core/libs/pubsub"
@@ -158,6 +160,34 @@ func (b *EventBus) PublishEventNewBlockHeader(data EventDataNewBlockHeader) erro
 	return b.pubsub.PublishWithEvents(ctx, data, events)
 }
 
+// PublishEventNewBlockMessages publishes the messages of a namespace of a new
+// block. Note it will add predefined keys (EventTypeKey, MessagesNamespaceKey,
+// MessagesHeightKey), which allow to subscribe to the messages of a single
+// namespace.
+func (b *EventBus) PublishEventNewBlockMessages(data EventDataNewBlockMessages) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	events := newBlockMessagesEvents(data.Height, namespace.ID(data.NamespaceID))
+
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
+// HasNewBlockMessagesSubscribers returns whether the messages of the given
+// namespace at the given height would be sent to any subscriber, see
+// PublishEventNewBlockMessages.
+func (b *EventBus) HasNewBlockMessagesSubscribers(height int64, nID namespace.ID) bool {
+	return b.pubsub.HasSubscribers(newBlockMessagesEvents(height, nID))
+}
+
+func newBlockMessagesEvents(height int64, nID namespace.ID) map[string][]string {
+	return map[string][]string{
+		EventTypeKey:         {EventNewBlockMessages},
+		MessagesNamespaceKey: {fmt.Sprintf("%X", []byte(nID))},
+		MessagesHeightKey:    {fmt.Sprintf("%d", height)},
+	}
+}
+
 func (b *EventBus) PublishEventNewEvidence(evidence EventDataNewEvidence) error {
 	return b.Publish(EventNewEvidence, evidence)
 }
@@ -255,6 +285,14 @@ func (NopEventBus) PublishEventNewBlockHeader(data EventDataNewBlockHeader) erro
 	return nil
 }
 
+func (NopEventBus) PublishEventNewBlockMessages(data EventDataNewBlockMessages) error {
+	return nil
+}
+
+func (NopEventBus) HasNewBlockMessagesSubscribers(height int64, nID namespace.ID) bool {
+	return false
+}
+
 func (NopEventBus) PublishEventNewEvidence(evidence EventDataNewEvidence) error {
 	return nil
 }
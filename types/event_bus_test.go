@@ -274,6 +274,55 @@ func TestEventBusPublishEventNewBlockHeader(t *testing.T) {
 	}
 }
 
+func TestEventBusPublishEventNewBlockMessages(t *testing.T) {
+	eventBus := NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	nID1 := []byte{1, 1, 1, 1, 1, 1, 1, 1}
+	nID2 := []byte{2, 2, 2, 2, 2, 2, 2, 2}
+	msgs := []Message{{NamespaceID: nID2, Data: []byte("foo")}}
+
+	// PublishEventNewBlockMessages adds the namespace compositeKey, so the
+	// query only matches the messages of nID2
+	msgsSub, err := eventBus.Subscribe(context.Background(), "test", EventQueryNewBlockMessagesFor(nID2))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		msg := <-msgsSub.Out()
+		edt := msg.Data().(EventDataNewBlockMessages)
+		assert.Equal(t, int64(3), edt.Height)
+		assert.EqualValues(t, nID2, edt.NamespaceID)
+		assert.Equal(t, msgs, edt.Messages)
+		close(done)
+	}()
+
+	err = eventBus.PublishEventNewBlockMessages(EventDataNewBlockMessages{
+		Height:      3,
+		NamespaceID: nID1,
+		Messages:    []Message{{NamespaceID: nID1, Data: []byte("bar")}},
+	})
+	assert.NoError(t, err)
+	err = eventBus.PublishEventNewBlockMessages(EventDataNewBlockMessages{
+		Height:      3,
+		NamespaceID: nID2,
+		Messages:    msgs,
+	})
+	assert.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(1 * time.Second):
+		t.Fatal("did not receive block messages after 1 sec.")
+	}
+}
+
 func TestEventBusPublishEventNewEvidence(t *testing.T) {
 	eventBus := NewEventBus()
 	err := eventBus.Start()
@@ -322,7 +371,7 @@ func TestEventBusPublish(t *testing.T) {
 		}
 	})
 
-	const numEventsExpected = 14
+	const numEventsExpected = 15
 
 	sub, err := eventBus.Subscribe(context.Background(), "test", tmquery.Empty{}, numEventsExpected)
 	require.NoError(t, err)
@@ -345,6 +394,8 @@ func TestEventBusPublish(t *testing.T) {
 	require.NoError(t, err)
 	err = eventBus.PublishEventNewBlockHeader(EventDataNewBlockHeader{})
 	require.NoError(t, err)
+	err = eventBus.PublishEventNewBlockMessages(EventDataNewBlockMessages{})
+	require.NoError(t, err)
 	err = eventBus.PublishEventVote(EventDataVote{})
 	require.NoError(t, err)
 	err = eventBus.PublishEventNewRoundStep(EventDataRoundState{})
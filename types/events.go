@@ -3,7 +3,10 @@ package types
 import (
 	"fmt"
 
+	"github.com/lazyledger/nmt/namespace"
+
 	abci "github.com/lazyledger/lazyledger-core/abci/types"
+	tmbytes "github.com/lazyledger/lazyledger-core/libs/bytes"
 	tmjson "github.com/lazyledger/lazyledger-core/libs/json"
 	tmpubsub "github.com/lazyledger/lazyledger-core/libs/pubsub"
 	tmquery "github.com/lazyledger/lazyledger-core/libs/pubsub/query"
@@ -18,6 +21,7 @@ const (
 	// All of this data can be fetched through the rpc.
 	EventNewBlock            = "NewBlock"
 	EventNewBlockHeader      = "NewBlockHeader"
+	EventNewBlockMessages    = "NewBlockMessages"
 	EventNewEvidence         = "NewEvidence"
 	EventTx                  = "Tx"
 	EventValidatorSetUpdates = "ValidatorSetUpdates"
@@ -48,6 +52,7 @@ type TMEventData interface {
 func init() {
 	tmjson.RegisterType(EventDataNewBlock{}, "tendermint/event/NewBlock")
 	tmjson.RegisterType(EventDataNewBlockHeader{}, "tendermint/event/NewBlockHeader")
+	tmjson.RegisterType(EventDataNewBlockMessages{}, "tendermint/event/NewBlockMessages")
 	tmjson.RegisterType(EventDataNewEvidence{}, "tendermint/event/NewEvidence")
 	tmjson.RegisterType(EventDataTx{}, "tendermint/event/Tx")
 	tmjson.RegisterType(EventDataRoundState{}, "tendermint/event/RoundState")
@@ -76,6 +81,18 @@ type EventDataNewBlockHeader struct {
 	ResultEndBlock   abci.ResponseEndBlock   `json:"result_end_block"`
 }
 
+// EventDataNewBlockMessages contains the messages of a single namespace of a
+// committed block. Rows holds the shares of the namespace together with NMT
+// proofs against the row roots of the block's DataAvailabilityHeader, which
+// allow to verify that no message of the namespace was withheld, see
+// DataAvailabilityHeader.VerifyNamespace.
+type EventDataNewBlockMessages struct {
+	Height      int64                 `json:"height"`
+	NamespaceID tmbytes.HexBytes      `json:"namespace_id"`
+	Messages    []Message             `json:"messages"`
+	Rows        []NamespacedRowShares `json:"rows"`
+}
+
 type EventDataNewEvidence struct {
 	Evidence Evidence `json:"evidence"`
 
@@ -136,6 +153,14 @@ const (
 	// TxHeightKey is a reserved key, used to specify transaction block's height.
 	// see EventBus#PublishEventTx
 	TxHeightKey = "tx.height"
+	// MessagesNamespaceKey is a reserved key, used to specify the namespace
+	// of the messages of a block.
+	// see EventBus#PublishEventNewBlockMessages
+	MessagesNamespaceKey = "messages.namespace"
+	// MessagesHeightKey is a reserved key, used to specify the height of the
+	// block containing the messages.
+	// see EventBus#PublishEventNewBlockMessages
+	MessagesHeightKey = "messages.height"
 )
 
 var (
@@ -143,6 +168,7 @@ var (
 	EventQueryLock                = QueryForEvent(EventLock)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlock)
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeader)
+	EventQueryNewBlockMessages    = QueryForEvent(EventNewBlockMessages)
 	EventQueryNewEvidence         = QueryForEvent(EventNewEvidence)
 	EventQueryNewRound            = QueryForEvent(EventNewRound)
 	EventQueryNewRoundStep        = QueryForEvent(EventNewRoundStep)
@@ -161,6 +187,13 @@ func EventQueryTxFor(tx Tx) tmpubsub.Query {
 	return tmquery.MustParse(fmt.Sprintf("%s='%s' AND %s='%X'", EventTypeKey, EventTx, TxHashKey, tx.Hash()))
 }
 
+// EventQueryNewBlockMessagesFor returns a query matching the messages of the
+// given namespace of every new block.
+func EventQueryNewBlockMessagesFor(nID namespace.ID) tmpubsub.Query {
+	return tmquery.MustParse(fmt.Sprintf("%s='%s' AND %s='%X'",
+		EventTypeKey, EventNewBlockMessages, MessagesNamespaceKey, []byte(nID)))
+}
+
 func QueryForEvent(eventType string) tmpubsub.Query {
 	return tmquery.MustParse(fmt.Sprintf("%s='%s'", EventTypeKey, eventType))
 }
@@ -169,6 +202,8 @@ func QueryForEvent(eventType string) tmpubsub.Query {
 type BlockEventPublisher interface {
 	PublishEventNewBlock(block EventDataNewBlock) error
 	PublishEventNewBlockHeader(header EventDataNewBlockHeader) error
+	PublishEventNewBlockMessages(msgs EventDataNewBlockMessages) error
+	HasNewBlockMessagesSubscribers(height int64, nID namespace.ID) bool
 	PublishEventNewEvidence(evidence EventDataNewEvidence) error
 	PublishEventTx(EventDataTx) error
 	PublishEventValidatorSetUpdates(EventDataValidatorSetUpdates) error
@@ -15,6 +15,7 @@ import (
 	tmjson "github.com/lazyledger/lazyledger-core/libs/json"
 	tmrand "github.com/lazyledger/lazyledger-core/libs/rand"
 	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
 )
 
 // Evidence represents any provable malicious activity by a validator.
@@ -409,6 +410,175 @@ func LightClientAttackEvidenceFromProto(lpb *tmproto.LightClientAttackEvidence)
 	return l, l.ValidateBasic()
 }
 
+//------------------------------- DATA AVAILABILITY EVIDENCE ------------------------------
+
+// BadEncodingFraudProof contains evidence that a row or column committed to in
+// the DataAvailabilityHeader of a block is not a valid Reed-Solomon extension
+// of its data. It carries at least half of the shares of the row or column,
+// each with a proof of inclusion in the root of its orthogonal column or row.
+// This is sufficient to reconstruct the whole row or column and show that its
+// root does not match the committed one.
+type BadEncodingFraudProof struct {
+	BlockHeight int64
+	IsRow       bool   // whether Index refers to a row or a column
+	Index       uint32 // index of the row or column in the extended data square
+	Shares      []ShareProof
+	Timestamp   time.Time // timestamp of the block with the bad encoding
+}
+
+// ShareProof is a share of a row or column of the extended data square
+// together with its proof of inclusion in the root of the orthogonal axis.
+type ShareProof struct {
+	Index uint32 // position of the share in the row or column
+	Share []byte
+	Proof NMTProof
+}
+
+// ToProto encodes ShareProof to protobuf
+func (sp ShareProof) ToProto() *tmproto.ShareProof {
+	return &tmproto.ShareProof{
+		Index: sp.Index,
+		Share: sp.Share,
+		Proof: sp.Proof.ToProto(),
+	}
+}
+
+// ShareProofFromProto decodes protobuf
+func ShareProofFromProto(pb *tmproto.ShareProof) (ShareProof, error) {
+	if pb == nil {
+		return ShareProof{}, errors.New("nil share proof")
+	}
+
+	return ShareProof{
+		Index: pb.Index,
+		Share: pb.Share,
+		Proof: NMTProofFromProto(pb.Proof),
+	}, nil
+}
+
+var _ Evidence = &BadEncodingFraudProof{}
+
+// ABCI returns no abci evidence as a bad encoding can not be attributed to a
+// single validator.
+func (befp *BadEncodingFraudProof) ABCI() []abci.Evidence {
+	return []abci.Evidence{}
+}
+
+// Bytes returns the proto-encoded evidence as a byte array
+func (befp *BadEncodingFraudProof) Bytes() []byte {
+	pbe := befp.ToProto()
+	bz, err := pbe.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// Hash returns the hash of the evidence.
+func (befp *BadEncodingFraudProof) Hash() []byte {
+	return tmhash.Sum(befp.Bytes())
+}
+
+// Height returns the height of the block with the bad encoding.
+func (befp *BadEncodingFraudProof) Height() int64 {
+	return befp.BlockHeight
+}
+
+// String returns a string representation of the evidence.
+func (befp *BadEncodingFraudProof) String() string {
+	axis := "column"
+	if befp.IsRow {
+		axis = "row"
+	}
+	return fmt.Sprintf("BadEncodingFraudProof{Height: %d, %s: %d, Shares: %d}",
+		befp.BlockHeight, axis, befp.Index, len(befp.Shares))
+}
+
+// Time returns the time of the block with the bad encoding.
+func (befp *BadEncodingFraudProof) Time() time.Time {
+	return befp.Timestamp
+}
+
+// ValidateBasic performs basic validation.
+func (befp *BadEncodingFraudProof) ValidateBasic() error {
+	if befp.BlockHeight <= 0 {
+		return errors.New("negative or zero height")
+	}
+	if befp.Index >= 2*consts.MaxSquareSize {
+		return fmt.Errorf("index %d is out of range of the max extended square width %d",
+			befp.Index, 2*consts.MaxSquareSize)
+	}
+	if len(befp.Shares) == 0 {
+		return errors.New("no shares")
+	}
+
+	for i, sp := range befp.Shares {
+		if i > 0 && sp.Index <= befp.Shares[i-1].Index {
+			return errors.New("shares are not sorted by index or contain duplicates")
+		}
+		if sp.Index >= 2*consts.MaxSquareSize {
+			return fmt.Errorf("share index %d is out of range of the max extended square width %d",
+				sp.Index, 2*consts.MaxSquareSize)
+		}
+		if len(sp.Share) != consts.ShareSize {
+			return fmt.Errorf("share %d has invalid size, got: %d, want: %d", sp.Index, len(sp.Share), consts.ShareSize)
+		}
+		if err := sp.Proof.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid proof of share %d: %w", sp.Index, err)
+		}
+		// the share is proven against the orthogonal axis, thus its position in
+		// there is the index of the row or column
+		if len(sp.Proof.LeafHash) != 0 || sp.Proof.Start != int(befp.Index) || sp.Proof.End != sp.Proof.Start+1 {
+			return fmt.Errorf("proof of share %d is not an inclusion proof of leaf %d", sp.Index, befp.Index)
+		}
+	}
+
+	return nil
+}
+
+// ToProto encodes BadEncodingFraudProof to protobuf
+func (befp *BadEncodingFraudProof) ToProto() *tmproto.BadEncodingFraudProof {
+	shares := make([]*tmproto.ShareProof, len(befp.Shares))
+	for i, sp := range befp.Shares {
+		shares[i] = sp.ToProto()
+	}
+
+	return &tmproto.BadEncodingFraudProof{
+		Height:    befp.BlockHeight,
+		IsRow:     befp.IsRow,
+		Index:     befp.Index,
+		Shares:    shares,
+		Timestamp: befp.Timestamp,
+	}
+}
+
+// BadEncodingFraudProofFromProto decodes protobuf
+func BadEncodingFraudProofFromProto(pb *tmproto.BadEncodingFraudProof) (*BadEncodingFraudProof, error) {
+	if pb == nil {
+		return nil, errors.New("nil bad encoding fraud proof")
+	}
+
+	shares := make([]ShareProof, len(pb.Shares))
+	for i, sp := range pb.Shares {
+		share, err := ShareProofFromProto(sp)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = share
+	}
+
+	befp := &BadEncodingFraudProof{
+		BlockHeight: pb.Height,
+		IsRow:       pb.IsRow,
+		Index:       pb.Index,
+		Shares:      shares,
+		Timestamp:   pb.Timestamp,
+	}
+
+	return befp, befp.ValidateBasic()
+}
+
 //------------------------------------------------------------------------------------------
 
 // EvidenceList is a list of Evidence. Evidences is not a word.
@@ -473,6 +643,13 @@ func EvidenceToProto(evidence Evidence) (*tmproto.Evidence, error) {
 			},
 		}, nil
 
+	case *BadEncodingFraudProof:
+		return &tmproto.Evidence{
+			Sum: &tmproto.Evidence_BadEncodingFraudProof{
+				BadEncodingFraudProof: evi.ToProto(),
+			},
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("toproto: evidence is not recognized: %T", evi)
 	}
@@ -490,6 +667,8 @@ func EvidenceFromProto(evidence *tmproto.Evidence) (Evidence, error) {
 		return DuplicateVoteEvidenceFromProto(evi.DuplicateVoteEvidence)
 	case *tmproto.Evidence_LightClientAttackEvidence:
 		return LightClientAttackEvidenceFromProto(evi.LightClientAttackEvidence)
+	case *tmproto.Evidence_BadEncodingFraudProof:
+		return BadEncodingFraudProofFromProto(evi.BadEncodingFraudProof)
 	default:
 		return nil, errors.New("evidence is not recognized")
 	}
@@ -498,6 +677,7 @@ func EvidenceFromProto(evidence *tmproto.Evidence) (Evidence, error) {
 func init() {
 	tmjson.RegisterType(&DuplicateVoteEvidence{}, "tendermint/DuplicateVoteEvidence")
 	tmjson.RegisterType(&LightClientAttackEvidence{}, "tendermint/LightClientAttackEvidence")
+	tmjson.RegisterType(&BadEncodingFraudProof{}, "tendermint/BadEncodingFraudProof")
 }
 
 //-------------------------------------------- ERRORS --------------------------------------
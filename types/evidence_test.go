@@ -11,9 +11,11 @@ import (
 
 	"github.com/lazyledger/lazyledger-core/crypto"
 	"github.com/lazyledger/lazyledger-core/crypto/tmhash"
+	tmbytes "github.com/lazyledger/lazyledger-core/libs/bytes"
 	tmrand "github.com/lazyledger/lazyledger-core/libs/rand"
 	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
 	tmversion "github.com/lazyledger/lazyledger-core/proto/tendermint/version"
+	"github.com/lazyledger/lazyledger-core/types/consts"
 	"github.com/lazyledger/lazyledger-core/version"
 )
 
@@ -214,6 +216,76 @@ func TestLightClientAttackEvidenceValidation(t *testing.T) {
 
 }
 
+func randomBadEncodingFraudProof() *BadEncodingFraudProof {
+	const index = 3
+	shares := make([]ShareProof, 4)
+	for i := range shares {
+		shares[i] = ShareProof{
+			Index: uint32(i),
+			Share: tmrand.Bytes(consts.ShareSize),
+			Proof: NMTProof{
+				Start: index,
+				End:   index + 1,
+				Nodes: []tmbytes.HexBytes{tmrand.Bytes(48), tmrand.Bytes(48)},
+			},
+		}
+	}
+	return &BadEncodingFraudProof{
+		BlockHeight: 10,
+		IsRow:       true,
+		Index:       index,
+		Shares:      shares,
+		Timestamp:   defaultVoteTime,
+	}
+}
+
+func TestBadEncodingFraudProof(t *testing.T) {
+	befp := randomBadEncodingFraudProof()
+	assert.Equal(t, befp.Hash(), tmhash.Sum(befp.Bytes()))
+	assert.NotNil(t, befp.String())
+	assert.Equal(t, int64(10), befp.Height())
+	assert.Equal(t, defaultVoteTime, befp.Time())
+	assert.Empty(t, befp.ABCI())
+}
+
+func TestBadEncodingFraudProofValidation(t *testing.T) {
+	testCases := []struct {
+		testName         string
+		malleateEvidence func(*BadEncodingFraudProof)
+		expectErr        bool
+	}{
+		{"Good BadEncodingFraudProof", func(ev *BadEncodingFraudProof) {}, false},
+		{"Zero height", func(ev *BadEncodingFraudProof) { ev.BlockHeight = 0 }, true},
+		{"Index out of range", func(ev *BadEncodingFraudProof) { ev.Index = 2 * consts.MaxSquareSize }, true},
+		{"No shares", func(ev *BadEncodingFraudProof) { ev.Shares = nil }, true},
+		{"Unsorted shares", func(ev *BadEncodingFraudProof) {
+			ev.Shares[0], ev.Shares[1] = ev.Shares[1], ev.Shares[0]
+		}, true},
+		{"Duplicate shares", func(ev *BadEncodingFraudProof) { ev.Shares[1].Index = ev.Shares[0].Index }, true},
+		{"Share index out of range", func(ev *BadEncodingFraudProof) {
+			ev.Shares[3].Index = 2 * consts.MaxSquareSize
+		}, true},
+		{"Invalid share size", func(ev *BadEncodingFraudProof) { ev.Shares[0].Share = ev.Shares[0].Share[1:] }, true},
+		{"Proof of other leaf", func(ev *BadEncodingFraudProof) {
+			ev.Shares[0].Proof.Start, ev.Shares[0].Proof.End = 0, 1
+		}, true},
+		{"Proof of range", func(ev *BadEncodingFraudProof) { ev.Shares[0].Proof.End++ }, true},
+		{"Proof of absence", func(ev *BadEncodingFraudProof) { ev.Shares[0].Proof.LeafHash = tmrand.Bytes(48) }, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			befp := randomBadEncodingFraudProof()
+			tc.malleateEvidence(befp)
+			if tc.expectErr {
+				assert.Error(t, befp.ValidateBasic(), tc.testName)
+			} else {
+				assert.NoError(t, befp.ValidateBasic(), tc.testName)
+			}
+		})
+	}
+}
+
 func TestMockEvidenceValidateBasic(t *testing.T) {
 	goodEvidence := NewMockDuplicateVoteEvidence(int64(1), time.Now(), "mock-chain-id")
 	assert.Nil(t, goodEvidence.ValidateBasic())
@@ -298,6 +370,8 @@ func TestEvidenceProto(t *testing.T) {
 		{"DuplicateVoteEvidence nil voteB", &DuplicateVoteEvidence{VoteA: v, VoteB: nil}, false, true},
 		{"DuplicateVoteEvidence nil voteA", &DuplicateVoteEvidence{VoteA: nil, VoteB: v}, false, true},
 		{"DuplicateVoteEvidence success", &DuplicateVoteEvidence{VoteA: v2, VoteB: v}, false, false},
+		{"BadEncodingFraudProof empty fail", &BadEncodingFraudProof{}, false, true},
+		{"BadEncodingFraudProof success", randomBadEncodingFraudProof(), false, false},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -83,8 +83,12 @@ func (genDoc *GenesisDoc) ValidateAndComplete() error {
 
 	if genDoc.ConsensusParams == nil {
 		genDoc.ConsensusParams = DefaultConsensusParams()
-	} else if err := ValidateConsensusParams(*genDoc.ConsensusParams); err != nil {
-		return err
+	} else {
+		genDoc.ConsensusParams.DataAvailability = CompleteDataAvailabilityParams(
+			genDoc.ConsensusParams.DataAvailability)
+		if err := ValidateConsensusParams(*genDoc.ConsensusParams); err != nil {
+			return err
+		}
 	}
 
 	for i, v := range genDoc.Validators {
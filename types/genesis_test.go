@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -121,6 +122,32 @@ func TestGenesisGood(t *testing.T) {
 	}
 }
 
+func TestGenesisWithoutDataAvailabilityParams(t *testing.T) {
+	pubkey := ed25519.GenPrivKey().PubKey()
+	genDoc := &GenesisDoc{
+		ChainID:         "abc",
+		ConsensusParams: DefaultConsensusParams(),
+		Validators:      []GenesisValidator{{pubkey.Address(), pubkey, 10, "myval"}},
+	}
+	genDocBytes, err := tmjson.Marshal(genDoc)
+	require.NoError(t, err)
+
+	// genesis files predating the DataAvailability params do not have them
+	var doc map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(genDocBytes, &doc))
+	var params map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(doc["consensus_params"], &params))
+	delete(params, "data_availability")
+	doc["consensus_params"], err = json.Marshal(params)
+	require.NoError(t, err)
+	genDocBytes, err = json.Marshal(doc)
+	require.NoError(t, err)
+
+	genDoc, err = GenesisDocFromJSON(genDocBytes)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultDataAvailabilityParams(), genDoc.ConsensusParams.DataAvailability)
+}
+
 func TestGenesisSaveAs(t *testing.T) {
 	tmpfile, err := ioutil.TempFile("", "genesis")
 	require.NoError(t, err)
@@ -0,0 +1,229 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/lazyledger/nmt"
+	"github.com/lazyledger/nmt/namespace"
+	"github.com/lazyledger/rsmt2d"
+
+	tmbytes "github.com/lazyledger/lazyledger-core/libs/bytes"
+	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+)
+
+// NMTProof is a serializable representation of an nmt.Proof. It proves the
+// inclusion of a range of leaves (or the absence of a namespace) in a
+// Namespaced Merkle Tree, e.g. a row or column of the extended data square.
+type NMTProof struct {
+	// Start index of the proven range of leaves (inclusive).
+	Start int `json:"start"`
+	// End index of the proven range of leaves (exclusive).
+	End int `json:"end"`
+	// Nodes are the subtree roots needed to recompute the tree root.
+	Nodes []tmbytes.HexBytes `json:"nodes"`
+	// LeafHash is only set for proofs of absence and contains the hash of the
+	// leaf following the absent namespace.
+	LeafHash tmbytes.HexBytes `json:"leaf_hash,omitempty"`
+}
+
+// NewNMTProof converts an nmt.Proof into its serializable form.
+func NewNMTProof(proof nmt.Proof) NMTProof {
+	nodes := make([]tmbytes.HexBytes, len(proof.Nodes()))
+	for i, node := range proof.Nodes() {
+		nodes[i] = node
+	}
+
+	p := NMTProof{
+		Start: proof.Start(),
+		End:   proof.End(),
+		Nodes: nodes,
+	}
+	if proof.IsOfAbsence() {
+		p.LeafHash = proof.LeafHash()
+	}
+	return p
+}
+
+// ToNMT converts the NMTProof back into an nmt.Proof that can be verified.
+func (p NMTProof) ToNMT() nmt.Proof {
+	nodes := make([][]byte, len(p.Nodes))
+	for i, node := range p.Nodes {
+		nodes[i] = node
+	}
+
+	if len(p.LeafHash) != 0 {
+		return nmt.NewAbsenceProof(p.Start, p.End, nodes, p.LeafHash, true)
+	}
+	return nmt.NewInclusionProof(p.Start, p.End, nodes, true)
+}
+
+// ValidateBasic performs basic sanity checks of the proof's structure.
+func (p NMTProof) ValidateBasic() error {
+	if p.Start < 0 {
+		return errors.New("negative proof start")
+	}
+	if p.End < p.Start {
+		return errors.New("proof end is smaller than its start")
+	}
+	if len(p.LeafHash) != 0 && p.End-p.Start != 1 {
+		return errors.New("proof of absence must cover exactly one leaf")
+	}
+	return nil
+}
+
+// NamespacedRowShares contains all the shares of a namespace found in a single
+// row of the extended data square together with their NMTProof against the row
+// root. If the namespace is absent from the row, Shares is empty and Proof is a
+// proof of absence.
+type NamespacedRowShares struct {
+	RowIndex uint32             `json:"row_index"`
+	Shares   []tmbytes.HexBytes `json:"shares"`
+	Proof    NMTProof           `json:"proof"`
+}
+
+// Verify checks the shares and the proof against the corresponding row root of
+// the given DataAvailabilityHeader.
+func (rs NamespacedRowShares) Verify(dah *DataAvailabilityHeader, nID namespace.ID) error {
+	if int(rs.RowIndex) >= len(dah.RowsRoots) {
+		return fmt.Errorf("row index %d is out of range of %d rows", rs.RowIndex, len(dah.RowsRoots))
+	}
+	if err := rs.Proof.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid proof: %w", err)
+	}
+	if len(rs.Shares) != rs.Proof.End-rs.Proof.Start && len(rs.Proof.LeafHash) == 0 {
+		return fmt.Errorf("proof covers %d shares, got %d", rs.Proof.End-rs.Proof.Start, len(rs.Shares))
+	}
+
+	leaves := make([][]byte, len(rs.Shares))
+	for i, share := range rs.Shares {
+		if len(share) < consts.NamespaceSize || !bytes.Equal(share[:consts.NamespaceSize], nID) {
+			return fmt.Errorf("share %d is not of namespace %X", i, nID)
+		}
+		// leaves are pushed to the tree prefixed with their namespace
+		leaf := make([]byte, 0, len(nID)+len(share))
+		leaves[i] = append(append(leaf, nID...), share...)
+	}
+
+	if !rs.Proof.ToNMT().VerifyNamespace(consts.NewBaseHashFunc(), nID, leaves, dah.RowsRoots[rs.RowIndex]) {
+		return fmt.Errorf("invalid namespace proof for row %d", rs.RowIndex)
+	}
+	return nil
+}
+
+// VerifyNamespace checks that the given rows contain a valid proof for every
+// row of the DataAvailabilityHeader whose namespace range covers nID. This
+// ensures that none of the namespace's shares were withheld.
+func (dah *DataAvailabilityHeader) VerifyNamespace(nID namespace.ID, rows []NamespacedRowShares) error {
+	proven := make(map[uint32]struct{}, len(rows))
+	for _, row := range rows {
+		if err := row.Verify(dah, nID); err != nil {
+			return err
+		}
+		proven[row.RowIndex] = struct{}{}
+	}
+
+	for i, root := range dah.RowsRoots {
+		if bytes.Compare(root.Min(), nID) > 0 || bytes.Compare(nID, root.Max()) > 0 {
+			continue
+		}
+		if _, ok := proven[uint32(i)]; !ok {
+			return fmt.Errorf("missing proof for row %d covering namespace %X", i, nID)
+		}
+	}
+	return nil
+}
+
+// NamespacedRows returns the shares of the given namespace in each row of the
+// extended data square whose namespace range, according to the
+// DataAvailabilityHeader, covers the namespace. The rows are proven against the
+// row roots of the DataAvailabilityHeader, as expected by VerifyNamespace.
+func (dah *DataAvailabilityHeader) NamespacedRows(
+	eds *rsmt2d.ExtendedDataSquare,
+	nID namespace.ID,
+) ([]NamespacedRowShares, error) {
+	if uint(len(dah.RowsRoots)) != eds.Width() {
+		return nil, fmt.Errorf("extended data square of width %d does not match %d row roots",
+			eds.Width(), len(dah.RowsRoots))
+	}
+
+	rows := make([]NamespacedRowShares, 0)
+	for i, root := range dah.RowsRoots {
+		if bytes.Compare(root.Min(), nID) > 0 || bytes.Compare(nID, root.Max()) > 0 {
+			continue
+		}
+
+		rowShares := eds.Row(uint(i))
+		tree, err := extendedRowTree(rowShares)
+		if err != nil {
+			return nil, err
+		}
+		proof, err := tree.ProveNamespace(nID)
+		if err != nil {
+			return nil, fmt.Errorf("failure to prove namespace %X in row %d: %w", nID, i, err)
+		}
+
+		row := NamespacedRowShares{
+			RowIndex: uint32(i),
+			Shares:   []tmbytes.HexBytes{},
+			Proof:    NewNMTProof(proof),
+		}
+		if !proof.IsOfAbsence() {
+			for _, share := range rowShares[proof.Start():proof.End()] {
+				row.Shares = append(row.Shares, share)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// extendedRowTree returns the Namespaced Merkle Tree of the given row of the
+// extended data square. The shares of the second half of the row are parity
+// shares and pushed with the ParitySharesNamespaceID.
+func extendedRowTree(rowShares [][]byte) (*nmt.NamespacedMerkleTree, error) {
+	tree := nmt.New(consts.NewBaseHashFunc, nmt.NamespaceIDSize(consts.NamespaceSize))
+	squareSize := len(rowShares) / 2
+	for col, share := range rowShares {
+		nID := share[:consts.NamespaceSize]
+		if col >= squareSize {
+			nID = consts.ParitySharesNamespaceID
+		}
+		if err := tree.Push(append(append(make([]byte, 0, len(nID)+len(share)), nID...), share...)); err != nil {
+			return nil, err
+		}
+	}
+	return tree, nil
+}
+
+// ToProto converts the NMTProof into its protobuf representation.
+func (p NMTProof) ToProto() tmproto.NMTProof {
+	nodes := make([][]byte, len(p.Nodes))
+	for i, node := range p.Nodes {
+		nodes[i] = node
+	}
+
+	return tmproto.NMTProof{
+		Start:    int32(p.Start),
+		End:      int32(p.End),
+		Nodes:    nodes,
+		LeafHash: p.LeafHash,
+	}
+}
+
+// NMTProofFromProto converts a protobuf NMTProof into an NMTProof.
+func NMTProofFromProto(pb tmproto.NMTProof) NMTProof {
+	nodes := make([]tmbytes.HexBytes, len(pb.Nodes))
+	for i, node := range pb.Nodes {
+		nodes[i] = node
+	}
+
+	return NMTProof{
+		Start:    int(pb.Start),
+		End:      int(pb.End),
+		Nodes:    nodes,
+		LeafHash: pb.LeafHash,
+	}
+}
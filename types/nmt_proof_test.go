@@ -0,0 +1,77 @@
+package types
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/lazyledger/nmt"
+	"github.com/lazyledger/nmt/namespace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lazyledger/lazyledger-core/types/consts"
+)
+
+func TestNMTProofRoundTrip(t *testing.T) {
+	tree := nmt.New(sha256.New, nmt.NamespaceIDSize(consts.NamespaceSize))
+	nids := []namespace.ID{
+		{0, 0, 0, 0, 0, 0, 1, 0},
+		{0, 0, 0, 0, 0, 0, 1, 0},
+		{0, 0, 0, 0, 0, 0, 3, 0},
+		{0, 0, 0, 0, 0, 0, 4, 0},
+	}
+	for i, nid := range nids {
+		require.NoError(t, tree.Push(append(append([]byte{}, nid...), byte(i))))
+	}
+
+	testCases := []struct {
+		name    string
+		nID     namespace.ID
+		absence bool
+	}{
+		{"inclusion", nids[0], false},
+		{"absence", namespace.ID{0, 0, 0, 0, 0, 0, 2, 0}, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			proof, err := tree.ProveNamespace(tc.nID)
+			require.NoError(t, err)
+
+			p := NewNMTProof(proof)
+			require.NoError(t, p.ValidateBasic())
+			assert.Equal(t, tc.absence, len(p.LeafHash) != 0)
+
+			got := p.ToNMT()
+			assert.Equal(t, proof.Start(), got.Start())
+			assert.Equal(t, proof.End(), got.End())
+			assert.Equal(t, proof.Nodes(), got.Nodes())
+			assert.Equal(t, proof.IsOfAbsence(), got.IsOfAbsence())
+		})
+	}
+}
+
+func TestNMTProofValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		proof   NMTProof
+		wantErr bool
+	}{
+		{"valid inclusion", NMTProof{Start: 1, End: 3}, false},
+		{"valid absence", NMTProof{Start: 1, End: 2, LeafHash: []byte{1}}, false},
+		{"negative start", NMTProof{Start: -1, End: 3}, true},
+		{"end before start", NMTProof{Start: 3, End: 1}, true},
+		{"absence over range", NMTProof{Start: 1, End: 3, LeafHash: []byte{1}}, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proof.ValidateBasic()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
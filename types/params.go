@@ -8,6 +8,7 @@ import (
 	abci "github.com/lazyledger/lazyledger-core/abci/types"
 	"github.com/lazyledger/lazyledger-core/crypto/tmhash"
 	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
 )
 
 const (
@@ -24,10 +25,11 @@ const (
 // DefaultConsensusParams returns a default ConsensusParams.
 func DefaultConsensusParams() *tmproto.ConsensusParams {
 	return &tmproto.ConsensusParams{
-		Block:     DefaultBlockParams(),
-		Evidence:  DefaultEvidenceParams(),
-		Validator: DefaultValidatorParams(),
-		Version:   DefaultVersionParams(),
+		Block:            DefaultBlockParams(),
+		Evidence:         DefaultEvidenceParams(),
+		Validator:        DefaultValidatorParams(),
+		Version:          DefaultVersionParams(),
+		DataAvailability: DefaultDataAvailabilityParams(),
 	}
 }
 
@@ -63,6 +65,30 @@ func DefaultVersionParams() tmproto.VersionParams {
 	}
 }
 
+// DefaultDataAvailabilityParams returns a default DataAvailabilityParams.
+func DefaultDataAvailabilityParams() tmproto.DataAvailabilityParams {
+	return tmproto.DataAvailabilityParams{
+		Codec:         tmproto.ErasureCodecRSGF8,
+		MaxSquareSize: MaxSquareSize(tmproto.ErasureCodecRSGF8),
+		MinSquareSize: consts.MinSquareSize,
+	}
+}
+
+// CompleteDataAvailabilityParams returns the DataAvailability params with the
+// zero square sizes set to their defaults. State and genesis files predating
+// the square sizes decode them as zero, since the field is not nullable, and
+// are thus treated as unset. The max square size defaults to the max of the
+// erasure codec, which is fixed at genesis.
+func CompleteDataAvailabilityParams(da tmproto.DataAvailabilityParams) tmproto.DataAvailabilityParams {
+	if da.MinSquareSize == 0 {
+		da.MinSquareSize = consts.MinSquareSize
+	}
+	if da.MaxSquareSize == 0 {
+		da.MaxSquareSize = MaxSquareSize(da.Codec)
+	}
+	return da
+}
+
 func IsValidPubkeyType(params tmproto.ValidatorParams, pubkeyType string) bool {
 	for i := 0; i < len(params.PubKeyTypes); i++ {
 		if params.PubKeyTypes[i] == pubkeyType {
@@ -127,6 +153,34 @@ func ValidateConsensusParams(params tmproto.ConsensusParams) error {
 		}
 	}
 
+	return ValidateDataAvailabilityParams(params.DataAvailability)
+}
+
+// ValidateDataAvailabilityParams validates the DataAvailability params. The
+// erasure codec must be supported by this build and the max square size must
+// not exceed the max width of the original data square the codec can extend.
+func ValidateDataAvailabilityParams(da tmproto.DataAvailabilityParams) error {
+	if _, err := Codec(da.Codec); err != nil {
+		return fmt.Errorf("dataAvailability.Codec is invalid: %w", err)
+	}
+
+	if da.MinSquareSize < consts.MinSquareSize || !isPowerOf2(da.MinSquareSize) {
+		return fmt.Errorf("dataAvailability.MinSquareSize must be a power of 2 of at least %d. Got %d",
+			consts.MinSquareSize, da.MinSquareSize)
+	}
+	if !isPowerOf2(da.MaxSquareSize) {
+		return fmt.Errorf("dataAvailability.MaxSquareSize must be a power of 2. Got %d",
+			da.MaxSquareSize)
+	}
+	if da.MaxSquareSize < da.MinSquareSize {
+		return fmt.Errorf("dataAvailability.MaxSquareSize is less than dataAvailability.MinSquareSize, %d < %d",
+			da.MaxSquareSize, da.MinSquareSize)
+	}
+	if codecMax := MaxSquareSize(da.Codec); da.MaxSquareSize > codecMax {
+		return fmt.Errorf("dataAvailability.MaxSquareSize is too big for the erasure codec %v. %d > %d",
+			da.Codec, da.MaxSquareSize, codecMax)
+	}
+
 	return nil
 }
 
@@ -134,6 +188,9 @@ func ValidateConsensusParams(params tmproto.ConsensusParams) error {
 // Only the Block.MaxBytes and Block.MaxGas are included in the hash.
 // This allows the ConsensusParams to evolve more without breaking the block
 // protocol. No need for a Merkle tree here, just a small struct to hash.
+// NOTE: the DataAvailability params are not included either, hence validators
+// disagreeing on the square sizes are not caught by the ConsensusHash of the
+// header, but only once they reject the blocks of each other.
 func HashConsensusParams(params tmproto.ConsensusParams) []byte {
 	hasher := tmhash.New()
 
@@ -155,6 +212,8 @@ func HashConsensusParams(params tmproto.ConsensusParams) []byte {
 }
 
 // Update returns a copy of the params with updates from the non-zero fields of p2.
+// The erasure codec of the DataAvailability params is fixed at genesis and can
+// not be updated. Square sizes left zero keep their current value.
 // NOTE: note: must not modify the original
 func UpdateConsensusParams(params tmproto.ConsensusParams, params2 *abci.ConsensusParams) tmproto.ConsensusParams {
 	res := params // explicit copy
@@ -181,5 +240,14 @@ func UpdateConsensusParams(params tmproto.ConsensusParams, params2 *abci.Consens
 	if params2.Version != nil {
 		res.Version.AppVersion = params2.Version.AppVersion
 	}
+	if params2.DataAvailability != nil {
+		// an app may update only one of the square sizes
+		if params2.DataAvailability.MaxSquareSize != 0 {
+			res.DataAvailability.MaxSquareSize = params2.DataAvailability.MaxSquareSize
+		}
+		if params2.DataAvailability.MinSquareSize != 0 {
+			res.DataAvailability.MinSquareSize = params2.DataAvailability.MinSquareSize
+		}
+	}
 	return res
 }
@@ -41,6 +41,18 @@ func TestConsensusParamsValidation(t *testing.T) {
 		13: {makeParams(1, 0, 10, 2, 0, []string{}), false},
 		// test invalid pubkey type provided
 		14: {makeParams(1, 0, 10, 2, 0, []string{"potatoes make good pubkeys"}), false},
+		// test unknown erasure codec
+		15: {withCodec(makeParams(1, 0, 10, 2, 0, valEd25519), tmproto.ErasureCodec(100)), false},
+		// test square sizes
+		16: {withSquareSizes(makeParams(1, 0, 10, 2, 0, valEd25519), 64, 4), true},
+		17: {withSquareSizes(makeParams(1, 0, 10, 2, 0, valEd25519), 4, 4), true},
+		18: {withSquareSizes(makeParams(1, 0, 10, 2, 0, valEd25519), 2, 4), false},
+		19: {withSquareSizes(makeParams(1, 0, 10, 2, 0, valEd25519), 64, 0), false},
+		20: {withSquareSizes(makeParams(1, 0, 10, 2, 0, valEd25519), 0, 1), false},
+		21: {withSquareSizes(makeParams(1, 0, 10, 2, 0, valEd25519), 48, 1), false},
+		22: {withSquareSizes(makeParams(1, 0, 10, 2, 0, valEd25519), 64, 3), false},
+		// the RSGF8 codec supports at most 128
+		23: {withSquareSizes(makeParams(1, 0, 10, 2, 0, valEd25519), 256, 1), false},
 	}
 	for i, tc := range testCases {
 		if tc.valid {
@@ -72,9 +84,21 @@ func makeParams(
 		Validator: tmproto.ValidatorParams{
 			PubKeyTypes: pubkeyTypes,
 		},
+		DataAvailability: DefaultDataAvailabilityParams(),
 	}
 }
 
+func withCodec(params tmproto.ConsensusParams, codec tmproto.ErasureCodec) tmproto.ConsensusParams {
+	params.DataAvailability.Codec = codec
+	return params
+}
+
+func withSquareSizes(params tmproto.ConsensusParams, max, min uint32) tmproto.ConsensusParams {
+	params.DataAvailability.MaxSquareSize = max
+	params.DataAvailability.MinSquareSize = min
+	return params
+}
+
 func TestConsensusParamsHash(t *testing.T) {
 	params := []tmproto.ConsensusParams{
 		makeParams(4, 2, 10, 3, 1, valEd25519),
@@ -133,6 +157,36 @@ func TestConsensusParamsUpdate(t *testing.T) {
 			},
 			makeParams(100, 200, 10, 300, 50, valSecp256k1),
 		},
+		// square size updates keep the codec
+		{
+			withCodec(makeParams(1, 2, 10, 3, 0, valEd25519), tmproto.ErasureCodec(100)),
+			&abci.ConsensusParams{
+				DataAvailability: &abci.DataAvailabilityParams{
+					MaxSquareSize: 32,
+					MinSquareSize: 2,
+				},
+			},
+			withSquareSizes(withCodec(makeParams(1, 2, 10, 3, 0, valEd25519), tmproto.ErasureCodec(100)), 32, 2),
+		},
+		// partial square size updates keep the other size
+		{
+			withSquareSizes(makeParams(1, 2, 10, 3, 0, valEd25519), 64, 2),
+			&abci.ConsensusParams{
+				DataAvailability: &abci.DataAvailabilityParams{
+					MaxSquareSize: 32,
+				},
+			},
+			withSquareSizes(makeParams(1, 2, 10, 3, 0, valEd25519), 32, 2),
+		},
+		{
+			withSquareSizes(makeParams(1, 2, 10, 3, 0, valEd25519), 64, 2),
+			&abci.ConsensusParams{
+				DataAvailability: &abci.DataAvailabilityParams{
+					MinSquareSize: 4,
+				},
+			},
+			withSquareSizes(makeParams(1, 2, 10, 3, 0, valEd25519), 64, 4),
+		},
 	}
 	for _, tc := range testCases {
 		assert.Equal(t, tc.updatedParams, UpdateConsensusParams(tc.params, tc.updates))
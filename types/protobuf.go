@@ -105,6 +105,10 @@ func (tm2pb) ConsensusParams(params *tmproto.ConsensusParams) *abci.ConsensusPar
 		},
 		Evidence:  &params.Evidence,
 		Validator: &params.Validator,
+		DataAvailability: &abci.DataAvailabilityParams{
+			MaxSquareSize: params.DataAvailability.MaxSquareSize,
+			MinSquareSize: params.DataAvailability.MinSquareSize,
+		},
 	}
 }
 
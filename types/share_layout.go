@@ -0,0 +1,284 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/lazyledger/nmt/namespace"
+
+	tmbytes "github.com/lazyledger/lazyledger-core/libs/bytes"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+)
+
+// This file implements the layout of the block data in the original data
+// square as described in the spec:
+// https://github.com/lazyledger/lazyledger-specs/blob/master/specs/block_proposer.md#laying-out-transactions-and-messages
+//
+// The reserved data (transactions, intermediate state roots and evidence) is
+// laid out contiguously at the beginning of the square, followed by the
+// messages, which are placed according to the non-interactive default rules:
+//  1. messages begin at an index aligned with the largest power of 2 that is
+//     not larger than the number of shares of the message or the square size
+//  2. messages which do not fit into the remainder of a row begin at the
+//     start of the next row
+// The shares skipped by the rules are filled with padding shares, which are
+// ignored when parsing the square.
+
+// LegacyLayoutBlockVersion is the version of the block protocol preceding the
+// non-interactive default rules. Its blocks lay out the shares of the messages
+// directly after the reserved shares, in the order of the messages, and only
+// pad the end of the square. Chains started with this version keep laying out
+// their blocks this way, see Data.ComputeSharesForBlockVersion.
+const LegacyLayoutBlockVersion uint64 = 11
+
+// SquareSize returns the width of the smallest original data square the data
+// fits into when laid out according to the non-interactive default rules.
+func (data *Data) SquareSize() uint32 {
+	reservedLen := len(data.reservedShares())
+	msgLens := data.Messages.sharesLens()
+	return squareSize(consts.MinSquareSize, reservedLen, msgLens)
+}
+
+// FitIntoSquare prepares the data for being proposed in a block. It sorts the
+// messages by namespace and drops the invalid messages, see
+// Message.ValidateBasic, along with the transactions and messages that do not
+// fit into an original data square of the given width. Transactions are
+// dropped from the end as the order of their execution must be kept, whereas a
+// message that does not fit does not prevent the following smaller ones from
+// being included. Intermediate state roots given per transaction are dropped
+// along with their transactions.
+//
+// It returns the number of dropped transactions and messages.
+func (data *Data) FitIntoSquare(maxSquareSize uint32) (droppedTxs, droppedMsgs int) {
+	maxShares := int(maxSquareSize * maxSquareSize)
+
+	// keep as many transactions as possible along with their ISRs and the
+	// evidence
+	roots := data.IntermediateStateRoots.RawRootsList
+	rootsPerTx := len(roots) == len(data.Txs)
+	otherReservedLen := len(data.Evidence.splitIntoShares())
+	if !rootsPerTx {
+		otherReservedLen += len(data.IntermediateStateRoots.splitIntoShares())
+	}
+	txsLen, rawTxsLen, rawRootsLen := 0, 0, 0
+	for i, tx := range data.Txs {
+		rawLen, rawRootLen := delimitedTxLen(tx), 0
+		if rootsPerTx {
+			rawRootLen = delimitedRootLen(roots[i])
+		}
+		if contiguousSharesLen(rawTxsLen+rawLen)+contiguousSharesLen(rawRootsLen+rawRootLen)+
+			otherReservedLen > maxShares {
+			break
+		}
+		txsLen++
+		rawTxsLen += rawLen
+		rawRootsLen += rawRootLen
+	}
+	droppedTxs = len(data.Txs) - txsLen
+	data.Txs = data.Txs[:txsLen]
+	if rootsPerTx && len(roots) > 0 {
+		data.IntermediateStateRoots.RawRootsList = roots[:txsLen]
+	}
+
+	msgs := make([]Message, 0, len(data.Messages.MessagesList))
+	for _, msg := range data.Messages.MessagesList {
+		if msg.ValidateBasic() == nil {
+			msgs = append(msgs, msg)
+		}
+	}
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return bytes.Compare(msgs[i].NamespaceID, msgs[j].NamespaceID) < 0
+	})
+
+	cursor := contiguousSharesLen(rawTxsLen) + contiguousSharesLen(rawRootsLen) + otherReservedLen
+	fitting := msgs[:0]
+	for _, msg := range msgs {
+		msgLen := msgSharesLen(msg)
+		start := msgStartIndex(cursor, msgLen, maxSquareSize)
+		if start+msgLen > maxShares {
+			continue
+		}
+		fitting = append(fitting, msg)
+		cursor = start + msgLen
+	}
+	droppedMsgs = len(data.Messages.MessagesList) - len(fitting)
+	if len(fitting) == 0 {
+		fitting = nil
+	}
+	data.Messages = Messages{MessagesList: fitting}
+
+	return droppedTxs, droppedMsgs
+}
+
+// reservedShares returns the shares of the transactions, intermediate state
+// roots and evidence in the order they are laid out in the square.
+func (data *Data) reservedShares() NamespacedShares {
+	txShares := data.Txs.splitIntoShares()
+	intermRootsShares := data.IntermediateStateRoots.splitIntoShares()
+	evidenceShares := data.Evidence.splitIntoShares()
+
+	shares := make(NamespacedShares, 0, len(txShares)+len(intermRootsShares)+len(evidenceShares))
+	return append(append(append(
+		shares,
+		txShares...),
+		intermRootsShares...),
+		evidenceShares...)
+}
+
+// layoutShares lays out the reserved shares and the shares of each message in
+// an original data square of the given width. It returns the shares of the
+// square along with the number of shares up to the end of the last message.
+func layoutShares(reserved NamespacedShares, msgShares []NamespacedShares, squareSize uint32) (NamespacedShares, int) {
+	shares := make(NamespacedShares, 0, squareSize*squareSize)
+	shares = append(shares, reserved...)
+
+	paddingNID := consts.ReservedPaddingNamespaceID
+	for _, msg := range msgShares {
+		start := msgStartIndex(len(shares), len(msg), squareSize)
+		shares = append(shares, paddingShares(paddingNID, start-len(shares))...)
+		shares = append(shares, msg...)
+		// padding following a message keeps the namespaces of the square
+		// ordered
+		paddingNID = msg[0].ID
+	}
+	dataLen := len(shares)
+
+	return append(shares, TailPaddingShares(int(squareSize*squareSize)-dataLen)...), dataLen
+}
+
+// legacyLayoutShares lays out the reserved shares directly followed by the
+// shares of the messages in the smallest square of at least minSquareSize they
+// fit into, see LegacyLayoutBlockVersion. It returns the shares of the square
+// along with the number of shares up to the end of the last message.
+func legacyLayoutShares(reserved, msgShares NamespacedShares, minSquareSize uint32) (NamespacedShares, int) {
+	dataLen := len(reserved) + len(msgShares)
+	squareLen := paddedLen(dataLen)
+	if minLen := int(minSquareSize * minSquareSize); squareLen < minLen {
+		squareLen = minLen
+	}
+	if squareLen < consts.MinSharecount {
+		squareLen = consts.MinSharecount
+	}
+
+	shares := make(NamespacedShares, 0, squareLen)
+	shares = append(append(shares, reserved...), msgShares...)
+	return append(shares, TailPaddingShares(squareLen-dataLen)...), dataLen
+}
+
+// squareSize returns the width of the smallest original data square of at
+// least minSquareSize that fits the given number of reserved shares followed by
+// messages of the given numbers of shares.
+func squareSize(minSquareSize uint32, reservedLen int, msgLens []int) uint32 {
+	total := reservedLen
+	for _, l := range msgLens {
+		total += l
+	}
+
+	size := minSquareSize
+	if size < consts.MinSquareSize {
+		size = consts.MinSquareSize
+	}
+	for size*size < uint32(paddedLen(total)) {
+		size *= 2
+	}
+	for ; ; size *= 2 {
+		cursor := reservedLen
+		for _, l := range msgLens {
+			cursor = msgStartIndex(cursor, l, size) + l
+		}
+		if cursor <= int(size*size) {
+			return size
+		}
+	}
+}
+
+// msgStartIndex returns the index of the share a message of msgLen shares
+// begins at when the preceding data ends at cursor.
+func msgStartIndex(cursor, msgLen int, squareSize uint32) int {
+	width := int(squareSize)
+
+	align := width
+	if msgLen < width {
+		align = int(nextHighestPowerOf2(uint32(msgLen)))
+		if align > msgLen {
+			align /= 2
+		}
+	}
+	start := roundUpTo(cursor, align)
+
+	// messages spanning multiple rows begin at the start of a row
+	if start%width+msgLen > width {
+		start = roundUpTo(start, width)
+	}
+	return start
+}
+
+// sharesLens returns the number of shares of each message.
+func (msgs Messages) sharesLens() []int {
+	lens := make([]int, len(msgs.MessagesList))
+	for i, msg := range msgs.MessagesList {
+		lens[i] = msgSharesLen(msg)
+	}
+	return lens
+}
+
+// splitIntoShareGroups splits each message into its own shares.
+func (msgs Messages) splitIntoShareGroups() []NamespacedShares {
+	groups := make([]NamespacedShares, len(msgs.MessagesList))
+	for i, msg := range msgs.MessagesList {
+		groups[i] = Messages{MessagesList: []Message{msg}}.splitIntoShares()
+	}
+	return groups
+}
+
+// msgSharesLen returns the number of shares the message is split into.
+func msgSharesLen(msg Message) int {
+	rawData, err := msg.MarshalDelimited()
+	if err != nil {
+		panic(fmt.Sprintf("app accepted a Message that can not be encoded %#v", msg))
+	}
+	return (len(rawData) + consts.MsgShareSize - 1) / consts.MsgShareSize
+}
+
+// delimitedTxLen returns the number of bytes the transaction occupies in the
+// contiguous shares.
+func delimitedTxLen(tx Tx) int {
+	rawData, err := tx.MarshalDelimited()
+	if err != nil {
+		panic(fmt.Sprintf("included Tx in mem-pool that can not be encoded %v", tx))
+	}
+	return len(rawData)
+}
+
+// delimitedRootLen returns the number of bytes the intermediate state root
+// occupies in the contiguous shares.
+func delimitedRootLen(root tmbytes.HexBytes) int {
+	rawData, err := root.MarshalDelimited()
+	if err != nil {
+		panic(fmt.Sprintf("app returned intermediate state root that can not be encoded %#v", root))
+	}
+	return len(rawData)
+}
+
+// contiguousSharesLen returns the number of shares contiguously split data of
+// rawLen bytes occupies.
+func contiguousSharesLen(rawLen int) int {
+	return (rawLen + consts.TxShareSize - 1) / consts.TxShareSize
+}
+
+// paddingShares returns n shares of the given namespace without any data.
+func paddingShares(nid namespace.ID, n int) NamespacedShares {
+	shares := make(NamespacedShares, n)
+	for i := 0; i < n; i++ {
+		shares[i] = NamespacedShare{
+			Share: zeroPadIfNecessary(append(make([]byte, 0, consts.ShareSize), nid...), consts.ShareSize),
+			ID:    nid,
+		}
+	}
+	return shares
+}
+
+func roundUpTo(v, multiple int) int {
+	return (v + multiple - 1) / multiple * multiple
+}
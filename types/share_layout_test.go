@@ -0,0 +1,247 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lazyledger/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tmbytes "github.com/lazyledger/lazyledger-core/libs/bytes"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+)
+
+func TestMsgStartIndex(t *testing.T) {
+	tests := []struct {
+		name       string
+		cursor     int
+		msgLen     int
+		squareSize uint32
+		expected   int
+	}{
+		{"single share msg", 3, 1, 4, 3},
+		{"aligned to power of 2", 3, 2, 4, 4},
+		{"aligned to largest power of 2 not larger than msg", 1, 3, 8, 2},
+		{"msg not fitting into the remainder of a row", 2, 3, 4, 4},
+		{"msg as long as a row", 1, 4, 4, 4},
+		{"msg spanning multiple rows", 5, 9, 4, 8},
+		{"already aligned", 8, 4, 8, 8},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, msgStartIndex(tt.cursor, tt.msgLen, tt.squareSize))
+		})
+	}
+}
+
+func TestSquareSize(t *testing.T) {
+	tests := []struct {
+		name          string
+		minSquareSize uint32
+		reservedLen   int
+		msgLens       []int
+		expected      uint32
+	}{
+		{"empty", 1, 0, nil, 1},
+		{"single share", 1, 1, nil, 1},
+		{"reserved only", 1, 5, nil, 4},
+		{"full square", 1, 0, []int{1, 1, 1, 1}, 2},
+		// the msg of 2 shares is aligned at index 2, leaving no room for the
+		// last msg in a square of width 2
+		{"padding requires larger square", 1, 1, []int{2, 1}, 4},
+		// the msg begins at the start of the second row and ends after the
+		// 16 shares of a square of width 4
+		{"msg spanning rows", 1, 1, []int{13}, 8},
+		{"empty with min square size", 4, 0, nil, 4},
+		{"larger than min square size", 2, 5, nil, 4},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, squareSize(tt.minSquareSize, tt.reservedLen, tt.msgLens))
+		})
+	}
+}
+
+func TestComputeSharesLayout(t *testing.T) {
+	data := Data{
+		Txs: Txs{[]byte{0x1}},
+		Messages: Messages{MessagesList: []Message{
+			{NamespaceID: []byte{1, 1, 1, 1, 1, 1, 1, 1}, Data: bytes.Repeat([]byte{0x1}, consts.MsgShareSize)},
+			{NamespaceID: []byte{2, 2, 2, 2, 2, 2, 2, 2}, Data: []byte{0x2}},
+		}},
+	}
+
+	shares, dataLen := data.ComputeShares()
+	require.Len(t, shares, 4*4)
+	assert.EqualValues(t, 4, data.SquareSize())
+	// tx share, reserved padding, msg of 2 shares aligned at 2, 1 share msg
+	assert.Equal(t, 5, dataLen)
+
+	expectedIDs := [][]byte{
+		consts.TxNamespaceID,
+		consts.ReservedPaddingNamespaceID,
+		{1, 1, 1, 1, 1, 1, 1, 1},
+		{1, 1, 1, 1, 1, 1, 1, 1},
+		{2, 2, 2, 2, 2, 2, 2, 2},
+	}
+	for i, id := range expectedIDs {
+		assert.EqualValues(t, id, shares[i].ID, "share %d", i)
+	}
+	for i := dataLen; i < len(shares); i++ {
+		assert.EqualValues(t, consts.TailPaddingNamespaceID, shares[i].ID, "share %d", i)
+	}
+
+	// the padding shares are ignored when parsing the square
+	eds, err := rsmt2d.ComputeExtendedDataSquare(shares.RawShares(), rsmt2d.NewRSGF8Codec(), rsmt2d.NewDefaultTree)
+	require.NoError(t, err)
+	res, err := DataFromSquare(eds)
+	require.NoError(t, err)
+	assert.Equal(t, data.Txs, res.Txs)
+	assert.Equal(t, data.Messages, res.Messages)
+}
+
+func TestComputeSharesForLegacyBlockVersion(t *testing.T) {
+	data := Data{
+		Txs: Txs{[]byte{0x1}},
+		Messages: Messages{MessagesList: []Message{
+			{NamespaceID: []byte{1, 1, 1, 1, 1, 1, 1, 1}, Data: bytes.Repeat([]byte{0x1}, consts.MsgShareSize)},
+			{NamespaceID: []byte{2, 2, 2, 2, 2, 2, 2, 2}, Data: []byte{0x2}},
+		}},
+	}
+
+	shares, dataLen := data.ComputeSharesForBlockVersion(LegacyLayoutBlockVersion, consts.MinSquareSize)
+	// the msgs directly follow the tx share without being aligned
+	require.Len(t, shares, 2*2)
+	assert.Equal(t, 4, dataLen)
+
+	expectedIDs := [][]byte{
+		consts.TxNamespaceID,
+		{1, 1, 1, 1, 1, 1, 1, 1},
+		{1, 1, 1, 1, 1, 1, 1, 1},
+		{2, 2, 2, 2, 2, 2, 2, 2},
+	}
+	for i, id := range expectedIDs {
+		assert.EqualValues(t, id, shares[i].ID, "share %d", i)
+	}
+
+	eds, err := rsmt2d.ComputeExtendedDataSquare(shares.RawShares(), rsmt2d.NewRSGF8Codec(), rsmt2d.NewDefaultTree)
+	require.NoError(t, err)
+	res, err := DataFromSquare(eds)
+	require.NoError(t, err)
+	assert.Equal(t, data.Txs, res.Txs)
+	assert.Equal(t, data.Messages, res.Messages)
+
+	// the min square size is kept
+	shares, dataLen = data.ComputeSharesForBlockVersion(LegacyLayoutBlockVersion, 4)
+	require.Len(t, shares, 4*4)
+	assert.Equal(t, 4, dataLen)
+	for i := dataLen; i < len(shares); i++ {
+		assert.EqualValues(t, consts.TailPaddingNamespaceID, shares[i].ID, "share %d", i)
+	}
+}
+
+func TestComputeSharesPaddingBetweenMessages(t *testing.T) {
+	msgs := []Message{
+		{NamespaceID: []byte{1, 1, 1, 1, 1, 1, 1, 1}, Data: []byte{0x1}},
+		{NamespaceID: []byte{2, 2, 2, 2, 2, 2, 2, 2}, Data: bytes.Repeat([]byte{0x2}, 3*consts.MsgShareSize)},
+	}
+	data := Data{Messages: Messages{MessagesList: msgs}}
+
+	shares, _ := data.ComputeShares()
+	// the second msg of 4 shares is aligned at index 4, the padding in between
+	// uses the namespace of the preceding msg to keep the square ordered
+	for i := 1; i < 4; i++ {
+		assert.EqualValues(t, msgs[0].NamespaceID, shares[i].ID, "share %d", i)
+	}
+	for i := 1; i < len(shares); i++ {
+		assert.True(t, bytes.Compare(shares[i-1].ID, shares[i].ID) <= 0, "share %d out of order", i)
+	}
+
+	parsed, err := parseMsgShares(shares[:8].RawShares())
+	require.NoError(t, err)
+	assert.Equal(t, msgs, parsed)
+}
+
+func TestFitIntoSquare(t *testing.T) {
+	nid := func(b byte) []byte { return bytes.Repeat([]byte{b}, consts.NamespaceSize) }
+
+	t.Run("sorts msgs and drops invalid ones", func(t *testing.T) {
+		data := Data{Messages: Messages{MessagesList: []Message{
+			{NamespaceID: nid(3), Data: []byte{0x1}},
+			{NamespaceID: nid(1), Data: []byte{0x2}},
+			{NamespaceID: nid(2)},
+			{NamespaceID: consts.EvidenceNamespaceID, Data: []byte{0x4}},
+			{NamespaceID: nid(1), Data: []byte{0x3}},
+		}}}
+
+		droppedTxs, droppedMsgs := data.FitIntoSquare(4)
+		assert.Zero(t, droppedTxs)
+		assert.Equal(t, 2, droppedMsgs)
+		assert.NoError(t, data.Messages.ValidateBasic())
+		assert.Equal(t, []Message{
+			{NamespaceID: nid(1), Data: []byte{0x2}},
+			{NamespaceID: nid(1), Data: []byte{0x3}},
+			{NamespaceID: nid(3), Data: []byte{0x1}},
+		}, data.Messages.MessagesList)
+	})
+
+	t.Run("drops msgs not fitting", func(t *testing.T) {
+		data := Data{
+			Txs: Txs{[]byte{0x1}},
+			Messages: Messages{MessagesList: []Message{
+				{NamespaceID: nid(1), Data: bytes.Repeat([]byte{0x1}, 3*consts.MsgShareSize)},
+				{NamespaceID: nid(2), Data: bytes.Repeat([]byte{0x2}, 10*consts.MsgShareSize)},
+				{NamespaceID: nid(3), Data: []byte{0x3}},
+			}},
+		}
+
+		// the first msg of 4 shares fills the second row, the second one of
+		// 11 shares does not fit into the remaining two rows
+		_, droppedMsgs := data.FitIntoSquare(4)
+		assert.Equal(t, 1, droppedMsgs)
+		require.Len(t, data.Messages.MessagesList, 2)
+		assert.EqualValues(t, nid(1), data.Messages.MessagesList[0].NamespaceID)
+		assert.EqualValues(t, nid(3), data.Messages.MessagesList[1].NamespaceID)
+	})
+
+	t.Run("drops txs not fitting", func(t *testing.T) {
+		txs := make(Txs, 5)
+		for i := range txs {
+			txs[i] = bytes.Repeat([]byte{byte(i)}, consts.TxShareSize)
+		}
+		data := Data{Txs: txs}
+
+		droppedTxs, _ := data.FitIntoSquare(2)
+		assert.Equal(t, 2, droppedTxs)
+		assert.Equal(t, txs[:3], data.Txs)
+		assert.EqualValues(t, 2, data.SquareSize())
+	})
+
+	t.Run("drops ISRs along with their txs", func(t *testing.T) {
+		txs := make(Txs, 5)
+		roots := make([]tmbytes.HexBytes, len(txs))
+		for i := range txs {
+			// fills a share along with the 2 bytes of the length delimiter
+			txs[i] = bytes.Repeat([]byte{byte(i)}, consts.TxShareSize-2)
+			roots[i] = bytes.Repeat([]byte{byte(i)}, consts.TxShareSize-2)
+		}
+		data := Data{Txs: txs, IntermediateStateRoots: IntermediateStateRoots{RawRootsList: roots}}
+
+		droppedTxs, _ := data.FitIntoSquare(2)
+		assert.Equal(t, 3, droppedTxs)
+		assert.Equal(t, txs[:2], data.Txs)
+		assert.Equal(t, roots[:2], data.IntermediateStateRoots.RawRootsList)
+		assert.EqualValues(t, 2, data.SquareSize())
+	})
+
+	t.Run("fitted data is laid out in the max square", func(t *testing.T) {
+		data := generateRandomBlockData(t, 50, 0, 0, 100, 1000)
+		const maxSquareSize = 16
+
+		data.FitIntoSquare(maxSquareSize)
+		assert.LessOrEqual(t, data.SquareSize(), uint32(maxSquareSize))
+	})
+}
@@ -16,62 +16,79 @@ import (
 func DataFromSquare(eds *rsmt2d.ExtendedDataSquare) (Data, error) {
 	originalWidth := eds.Width() / 2
 
-	// sort block data shares by namespace
-	var (
-		sortedTxShares  [][]byte
-		sortedISRShares [][]byte
-		sortedEvdShares [][]byte
-		sortedMsgShares [][]byte
-	)
-
+	var parser RowParser
 	// iterate over each row index
 	for x := uint(0); x < originalWidth; x++ {
+		row := make([][]byte, originalWidth)
 		// iterate over each col index
 		for y := uint(0); y < originalWidth; y++ {
-			// sort the data of that share types via namespace
-			share := eds.Cell(x, y)
-			nid := share[:consts.NamespaceSize]
-			switch {
-			case bytes.Equal(consts.TxNamespaceID, nid):
-				sortedTxShares = append(sortedTxShares, share)
-
-			case bytes.Equal(consts.IntermediateStateRootsNamespaceID, nid):
-				sortedISRShares = append(sortedISRShares, share)
-
-			case bytes.Equal(consts.EvidenceNamespaceID, nid):
-				sortedEvdShares = append(sortedEvdShares, share)
-
-			case bytes.Equal(consts.TailPaddingNamespaceID, nid):
-				continue
-
-			// ignore unused but reserved namespaces
-			case bytes.Compare(nid, consts.MaxReservedNamespace) < 1:
-				continue
-
-			// every other namespaceID should be a message
-			default:
-				sortedMsgShares = append(sortedMsgShares, share)
-			}
+			row[y] = eds.Cell(x, y)
 		}
+		parser.AddRow(row)
 	}
+	return parser.Data()
+}
+
+// RowParser extracts block data from the rows of the original data square,
+// which are added one by one and in order. This allows parsing block data
+// while the remaining rows are still being retrieved.
+type RowParser struct {
+	// block data shares sorted by namespace
+	txShares  [][]byte
+	isrShares [][]byte
+	evdShares [][]byte
+	msgShares [][]byte
+}
+
+// AddRow adds the shares of the next row of the original data square.
+func (p *RowParser) AddRow(shares [][]byte) {
+	for _, share := range shares {
+		// sort the data of that share types via namespace
+		nid := share[:consts.NamespaceSize]
+		switch {
+		case bytes.Equal(consts.TxNamespaceID, nid):
+			p.txShares = append(p.txShares, share)
+
+		case bytes.Equal(consts.IntermediateStateRootsNamespaceID, nid):
+			p.isrShares = append(p.isrShares, share)
+
+		case bytes.Equal(consts.EvidenceNamespaceID, nid):
+			p.evdShares = append(p.evdShares, share)
+
+		case bytes.Equal(consts.TailPaddingNamespaceID, nid):
+			continue
 
+		// ignore unused but reserved namespaces
+		case bytes.Compare(nid, consts.MaxReservedNamespace) < 1:
+			continue
+
+		// every other namespaceID should be a message
+		default:
+			p.msgShares = append(p.msgShares, share)
+		}
+	}
+}
+
+// Data parses the block data from the rows added so far. All rows of the
+// original data square must have been added.
+func (p *RowParser) Data() (Data, error) {
 	// pass the raw share data to their respective parsers
-	txs, err := parseTxs(sortedTxShares)
+	txs, err := parseTxs(p.txShares)
 	if err != nil {
 		return Data{}, err
 	}
 
-	isrs, err := parseISRs(sortedISRShares)
+	isrs, err := parseISRs(p.isrShares)
 	if err != nil {
 		return Data{}, err
 	}
 
-	evd, err := parseEvd(sortedEvdShares)
+	evd, err := parseEvd(p.evdShares)
 	if err != nil {
 		return Data{}, err
 	}
 
-	msgs, err := parseMsgs(sortedMsgShares)
+	msgs, err := parseMsgs(p.msgShares)
 	if err != nil {
 		return Data{}, err
 	}
@@ -146,6 +163,12 @@ func parseEvd(shares [][]byte) (EvidenceData, error) {
 	return EvidenceData{Evidence: evdList}, nil
 }
 
+// ParseMessages collects all messages from the provided message shares, e.g.
+// the shares of a single namespace retrieved from the extended data square.
+func ParseMessages(shares [][]byte) (Messages, error) {
+	return parseMsgs(shares)
+}
+
 // parseMsgs collects all messages from the shares provided
 func parseMsgs(shares [][]byte) (Messages, error) {
 	msgList, err := parseMsgShares(shares)
@@ -260,7 +283,8 @@ func parseMsgShares(shares [][]byte) ([]Message, error) {
 		if err != nil {
 			return nil, err
 		}
-		if msg.Data != nil {
+		// empty messages are padding shares, see layoutShares
+		if len(msg.Data) != 0 {
 			msgs = append(msgs, msg)
 		}
 	}
@@ -505,7 +505,11 @@ func generateIdenticalEvidence(t *testing.T, count int) EvidenceData {
 func generateRandomlySizedMessages(count, maxMsgSize int) Messages {
 	msgs := make([]Message, count)
 	for i := 0; i < count; i++ {
-		msgs[i] = generateRandomMessage(rand.Intn(maxMsgSize))
+		size := rand.Intn(maxMsgSize)
+		if size == 0 {
+			size = 1
+		}
+		msgs[i] = generateRandomMessage(size)
 	}
 
 	// this is just to let us use assert.Equal
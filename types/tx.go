@@ -2,13 +2,10 @@ package types
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 
 	"github.com/lazyledger/lazyledger-core/crypto/merkle"
 	"github.com/lazyledger/lazyledger-core/crypto/tmhash"
-	tmbytes "github.com/lazyledger/lazyledger-core/libs/bytes"
-	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
 	"github.com/lazyledger/lazyledger-core/types/consts"
 )
 
@@ -62,24 +59,6 @@ func (txs Txs) IndexByHash(hash []byte) int {
 	return -1
 }
 
-// Proof returns a simple merkle proof for this node.
-// Panics if i < 0 or i >= len(txs)
-// TODO: optimize this!
-func (txs Txs) Proof(i int) TxProof {
-	l := len(txs)
-	bzs := make([][]byte, l)
-	for i := 0; i < l; i++ {
-		bzs[i] = txs[i].Hash()
-	}
-	root, proofs := merkle.ProofsFromByteSlices(bzs)
-
-	return TxProof{
-		RootHash: root,
-		Data:     txs[i],
-		Proof:    *proofs[i],
-	}
-}
-
 func (txs Txs) splitIntoShares() NamespacedShares {
 	rawDatas := make([][]byte, len(txs))
 	for i, tx := range txs {
@@ -93,65 +72,6 @@ func (txs Txs) splitIntoShares() NamespacedShares {
 	return shares
 }
 
-// TxProof represents a Merkle proof of the presence of a transaction in the Merkle tree.
-type TxProof struct {
-	RootHash tmbytes.HexBytes `json:"root_hash"`
-	Data     Tx               `json:"data"`
-	Proof    merkle.Proof     `json:"proof"`
-}
-
-// Leaf returns the hash(tx), which is the leaf in the merkle tree which this proof refers to.
-func (tp TxProof) Leaf() []byte {
-	return tp.Data.Hash()
-}
-
-// Validate verifies the proof. It returns nil if the RootHash matches the dataHash argument,
-// and if the proof is internally consistent. Otherwise, it returns a sensible error.
-func (tp TxProof) Validate(dataHash []byte) error {
-	if !bytes.Equal(dataHash, tp.RootHash) {
-		return errors.New("proof matches different data hash")
-	}
-	if tp.Proof.Index < 0 {
-		return errors.New("proof index cannot be negative")
-	}
-	if tp.Proof.Total <= 0 {
-		return errors.New("proof total must be positive")
-	}
-	valid := tp.Proof.Verify(tp.RootHash, tp.Leaf())
-	if valid != nil {
-		return errors.New("proof is not internally consistent")
-	}
-	return nil
-}
-
-func (tp TxProof) ToProto() tmproto.TxProof {
-
-	pbProof := tp.Proof.ToProto()
-
-	pbtp := tmproto.TxProof{
-		RootHash: tp.RootHash,
-		Data:     tp.Data,
-		Proof:    pbProof,
-	}
-
-	return pbtp
-}
-func TxProofFromProto(pb tmproto.TxProof) (TxProof, error) {
-
-	pbProof, err := merkle.ProofFromProto(pb.Proof)
-	if err != nil {
-		return TxProof{}, err
-	}
-
-	pbtp := TxProof{
-		RootHash: pb.RootHash,
-		Data:     pb.Data,
-		Proof:    *pbProof,
-	}
-
-	return pbtp, nil
-}
-
 // ComputeProtoSizeForTxs wraps the transactions in tmproto.Data{} and calculates the size.
 // https://developers.google.com/protocol-buffers/docs/encoding
 func ComputeProtoSizeForTxs(txs []Tx) int64 {
@@ -0,0 +1,303 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/lazyledger/nmt/namespace"
+	"github.com/lazyledger/rsmt2d"
+
+	"github.com/lazyledger/lazyledger-core/crypto/merkle"
+	tmbytes "github.com/lazyledger/lazyledger-core/libs/bytes"
+	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+)
+
+// TxShareProof proves the presence of a transaction in the shares of a block.
+//
+// Transactions are split contiguously into shares, thus a share does not
+// necessarily begin with a transaction. The proof therefore covers the
+// consecutive transaction shares from the first share marking a transaction
+// boundary before the transaction up to its end. Each share is proven against
+// its row root, which in turn is proven against the DataHash of the header.
+type TxShareProof struct {
+	Data      Tx              `json:"data"`
+	RowProofs []RowShareProof `json:"row_proofs"`
+}
+
+// RowShareProof proves consecutive shares of a row of the original data
+// square.
+type RowShareProof struct {
+	RowIndex uint32 `json:"row_index"`
+	// RowRoot is the namespaced root of the row.
+	RowRoot tmbytes.HexBytes `json:"row_root"`
+	// RowRootProof proves RowRoot against the DataAvailabilityHeader hash.
+	RowRootProof merkle.Proof `json:"row_root_proof"`
+	// Shares are proven against RowRoot, their index is the column.
+	Shares []ShareProof `json:"shares"`
+}
+
+// TxShareProof returns a proof of the presence of the i-th transaction in the
+// shares of the block. The proof is built from the extended data square the
+// DataAvailabilityHeader commits to, which must be available, see
+// ExtendedDataSquare.
+func (b *Block) TxShareProof(i int) (TxShareProof, error) {
+	if i < 0 || i >= len(b.Data.Txs) {
+		return TxShareProof{}, fmt.Errorf("tx index %d is out of range of %d txs", i, len(b.Data.Txs))
+	}
+	eds := b.ExtendedDataSquare()
+	if eds == nil {
+		return TxShareProof{}, errors.New("extended data square of the block is not available")
+	}
+	return txShareProof(b.Data.Txs, i, eds)
+}
+
+// TxShareProof returns a proof of the presence of the i-th transaction in the
+// shares of the data, which are laid out in a square of at least minSquareSize
+// and erasure coded using the given codec, see ComputeExtendedDataSquare.
+func (data *Data) TxShareProof(i int, codec rsmt2d.Codec, minSquareSize uint32) (TxShareProof, error) {
+	if i < 0 || i >= len(data.Txs) {
+		return TxShareProof{}, fmt.Errorf("tx index %d is out of range of %d txs", i, len(data.Txs))
+	}
+
+	eds, _, err := data.ComputeExtendedDataSquare(codec, minSquareSize)
+	if err != nil {
+		return TxShareProof{}, err
+	}
+	return txShareProof(data.Txs, i, eds)
+}
+
+// txShareProof proves the i-th of the given transactions, which are laid out
+// in the first shares of the given extended data square.
+func txShareProof(txs Txs, i int, eds *rsmt2d.ExtendedDataSquare) (TxShareProof, error) {
+	// the offsets of the txs within the contiguous data of the tx shares
+	starts := make([]int, i+1)
+	offset := 0
+	for j, tx := range txs[:i+1] {
+		bz, err := tx.MarshalDelimited()
+		if err != nil {
+			return TxShareProof{}, err
+		}
+		starts[j] = offset
+		offset += len(bz)
+	}
+	txStart, txEnd := starts[i], offset
+
+	// the verifier can only find tx boundaries from the first share or from
+	// the boundary a share marks in its reserved byte, which is the first
+	// one within the share not starting the share
+	startShare := txStart / consts.TxShareSize
+	for ; startShare > 0; startShare-- {
+		shareStart := startShare * consts.TxShareSize
+		marked := false
+		for _, start := range starts {
+			if start > shareStart {
+				marked = start < shareStart+consts.TxShareSize && start <= txStart
+				break
+			}
+		}
+		if marked {
+			break
+		}
+	}
+	endShare := (txEnd - 1) / consts.TxShareSize
+	squareSize := int(eds.Width() / 2)
+
+	// the DataAvailabilityHeader hash is the root of the row and column roots
+	rowRoots := eds.RowRoots()
+	_, rootProofs := merkle.ProofsFromByteSlices(append(rowRoots, eds.ColumnRoots()...))
+
+	tp := TxShareProof{Data: txs[i]}
+	for row := startShare / squareSize; row <= endShare/squareSize; row++ {
+		rowShares := eds.Row(uint(row))
+		rowTree, err := extendedRowTree(rowShares)
+		if err != nil {
+			return TxShareProof{}, err
+		}
+
+		rp := RowShareProof{
+			RowIndex:     uint32(row),
+			RowRoot:      rowRoots[row],
+			RowRootProof: *rootProofs[row],
+		}
+		for idx := row * squareSize; idx < (row+1)*squareSize; idx++ {
+			if idx < startShare || idx > endShare {
+				continue
+			}
+			col := idx % squareSize
+			proof, err := rowTree.Prove(col)
+			if err != nil {
+				return TxShareProof{}, err
+			}
+			rp.Shares = append(rp.Shares, ShareProof{
+				Index: uint32(col),
+				Share: rowShares[col],
+				Proof: NewNMTProof(proof),
+			})
+		}
+		tp.RowProofs = append(tp.RowProofs, rp)
+	}
+
+	return tp, nil
+}
+
+// Validate verifies the proof. It returns nil if all shares are proven
+// against the given dataHash and the transaction is found in them. Otherwise,
+// it returns a sensible error.
+func (tp TxShareProof) Validate(dataHash []byte) error {
+	// the delimited empty tx is indistinguishable from the zero padding
+	// following the last tx
+	if len(tp.Data) == 0 {
+		return errors.New("proof of an empty tx")
+	}
+	if len(tp.RowProofs) == 0 {
+		return errors.New("proof contains no rows")
+	}
+
+	var (
+		// width of the original data square
+		squareSize int64
+		firstShare int64
+		nextShare  int64
+		payload    []byte
+	)
+	for i, rp := range tp.RowProofs {
+		if rp.RowRootProof.Total <= 0 || rp.RowRootProof.Total%4 != 0 {
+			return fmt.Errorf("invalid total of row root proof %d", i)
+		}
+		if i == 0 {
+			squareSize = rp.RowRootProof.Total / 4
+		} else if rp.RowRootProof.Total/4 != squareSize {
+			return fmt.Errorf("row root proof %d is for a different square size", i)
+		}
+		if int64(rp.RowIndex) >= squareSize || rp.RowRootProof.Index != int64(rp.RowIndex) {
+			return fmt.Errorf("row root proof %d is not for row %d of the original square", i, rp.RowIndex)
+		}
+		if err := rp.RowRootProof.Verify(dataHash, rp.RowRoot); err != nil {
+			return fmt.Errorf("invalid row root proof for row %d: %w", rp.RowIndex, err)
+		}
+		if len(rp.Shares) == 0 {
+			return fmt.Errorf("row %d contains no shares", rp.RowIndex)
+		}
+		rowRoot, err := namespace.IntervalDigestFromBytes(consts.NamespaceSize, rp.RowRoot)
+		if err != nil {
+			return fmt.Errorf("invalid root of row %d: %w", rp.RowIndex, err)
+		}
+
+		for _, sp := range rp.Shares {
+			idx := int64(rp.RowIndex)*squareSize + int64(sp.Index)
+			switch {
+			case int64(sp.Index) >= squareSize:
+				return fmt.Errorf("share index %d is out of range of the original square", sp.Index)
+			case payload == nil:
+				firstShare = idx
+			case idx != nextShare:
+				return errors.New("shares are not consecutive")
+			}
+			nextShare = idx + 1
+
+			if len(sp.Share) != consts.ShareSize {
+				return fmt.Errorf("share %d has invalid size %d", idx, len(sp.Share))
+			}
+			if !bytes.Equal(sp.Share[:consts.NamespaceSize], consts.TxNamespaceID) {
+				return fmt.Errorf("share %d is not a tx share", idx)
+			}
+			if len(sp.Proof.LeafHash) != 0 || sp.Proof.Start != int(sp.Index) || sp.Proof.End != sp.Proof.Start+1 {
+				return fmt.Errorf("proof of share %d is not an inclusion proof of leaf %d", idx, sp.Index)
+			}
+			if !sp.Proof.ToNMT().VerifyInclusion(consts.NewBaseHashFunc(), consts.TxNamespaceID, sp.Share, rowRoot) {
+				return fmt.Errorf("invalid inclusion proof for share %d", idx)
+			}
+
+			if payload == nil {
+				payload = make([]byte, 0, consts.TxShareSize)
+			}
+			payload = append(payload, sp.Share[consts.NamespaceSize+consts.ShareReservedBytes:]...)
+		}
+	}
+
+	// find the first tx boundary in the proven shares
+	offset := 0
+	if firstShare != 0 {
+		marker := int(tp.RowProofs[0].Shares[0].Share[consts.NamespaceSize])
+		if marker < consts.NamespaceSize+consts.ShareReservedBytes {
+			return errors.New("first share does not mark a tx boundary")
+		}
+		offset = marker - consts.NamespaceSize - consts.ShareReservedBytes
+	}
+
+	txBz, err := tp.Data.MarshalDelimited()
+	if err != nil {
+		return err
+	}
+	for offset < len(payload) {
+		if bytes.HasPrefix(payload[offset:], txBz) {
+			return nil
+		}
+		length, n := binary.Uvarint(payload[offset:])
+		if n <= 0 || length == 0 || length > uint64(len(payload)) {
+			break
+		}
+		offset += n + int(length)
+	}
+	return errors.New("tx is not contained in the proven shares")
+}
+
+// ToProto converts the TxShareProof into its protobuf representation.
+func (tp TxShareProof) ToProto() tmproto.TxShareProof {
+	rows := make([]*tmproto.RowShareProof, len(tp.RowProofs))
+	for i, rp := range tp.RowProofs {
+		shares := make([]*tmproto.ShareProof, len(rp.Shares))
+		for j, sp := range rp.Shares {
+			shares[j] = sp.ToProto()
+		}
+		rows[i] = &tmproto.RowShareProof{
+			RowIndex:     rp.RowIndex,
+			RowRoot:      rp.RowRoot,
+			RowRootProof: *rp.RowRootProof.ToProto(),
+			Shares:       shares,
+		}
+	}
+
+	return tmproto.TxShareProof{
+		Data:      tp.Data,
+		RowProofs: rows,
+	}
+}
+
+// TxShareProofFromProto converts a protobuf TxShareProof into a TxShareProof.
+func TxShareProofFromProto(pb tmproto.TxShareProof) (TxShareProof, error) {
+	rows := make([]RowShareProof, len(pb.RowProofs))
+	for i, prp := range pb.RowProofs {
+		if prp == nil {
+			return TxShareProof{}, errors.New("nil row share proof")
+		}
+
+		rootProof, err := merkle.ProofFromProto(&prp.RowRootProof)
+		if err != nil {
+			return TxShareProof{}, err
+		}
+
+		shares := make([]ShareProof, len(prp.Shares))
+		for j, psp := range prp.Shares {
+			shares[j], err = ShareProofFromProto(psp)
+			if err != nil {
+				return TxShareProof{}, err
+			}
+		}
+
+		rows[i] = RowShareProof{
+			RowIndex:     prp.RowIndex,
+			RowRoot:      prp.RowRoot,
+			RowRootProof: *rootProof,
+			Shares:       shares,
+		}
+	}
+
+	return TxShareProof{
+		Data:      pb.Data,
+		RowProofs: rows,
+	}, nil
+}
@@ -11,6 +11,8 @@ import (
 	tmrand "github.com/lazyledger/lazyledger-core/libs/rand"
 	ctest "github.com/lazyledger/lazyledger-core/libs/test"
 	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+	"github.com/lazyledger/lazyledger-core/types/consts"
+	"github.com/lazyledger/lazyledger-core/version"
 )
 
 func makeTxs(cnt, size int) Txs {
@@ -52,7 +54,7 @@ func TestTxIndexByHash(t *testing.T) {
 	}
 }
 
-func TestValidTxProof(t *testing.T) {
+func TestValidTxShareProof(t *testing.T) {
 	cases := []struct {
 		txs Txs
 	}{
@@ -62,28 +64,28 @@ func TestValidTxProof(t *testing.T) {
 		{makeTxs(20, 5)},
 		{makeTxs(7, 81)},
 		{makeTxs(61, 15)},
+		// txs spanning multiple shares and rows
+		{makeTxs(3, 600)},
+		{makeTxs(100, 60)},
+		{Txs{tmrand.Bytes(10), tmrand.Bytes(2000), tmrand.Bytes(10)}},
 	}
 
 	for h, tc := range cases {
-		txs := tc.txs
-		root := txs.Hash()
+		data := Data{Txs: tc.txs}
+		dah, _, err := data.ComputeDataAvailabilityHeader(DefaultCodec(), consts.MinSquareSize)
+		require.NoError(t, err)
+		root := dah.Hash()
+
 		// make sure valid proof for every tx
-		for i := range txs {
-			tx := []byte(txs[i])
-			proof := txs.Proof(i)
-			assert.EqualValues(t, i, proof.Proof.Index, "%d: %d", h, i)
-			assert.EqualValues(t, len(txs), proof.Proof.Total, "%d: %d", h, i)
-			assert.EqualValues(t, root, proof.RootHash, "%d: %d", h, i)
-			assert.EqualValues(t, tx, proof.Data, "%d: %d", h, i)
-			assert.EqualValues(t, txs[i].Hash(), proof.Leaf(), "%d: %d", h, i)
-			assert.Nil(t, proof.Validate(root), "%d: %d", h, i)
-			assert.NotNil(t, proof.Validate([]byte("foobar")), "%d: %d", h, i)
+		for i := range tc.txs {
+			proof, err := data.TxShareProof(i, DefaultCodec(), consts.MinSquareSize)
+			require.NoError(t, err, "%d: %d", h, i)
+			assert.EqualValues(t, tc.txs[i], proof.Data, "%d: %d", h, i)
+			assert.NoError(t, proof.Validate(root), "%d: %d", h, i)
+			assert.Error(t, proof.Validate([]byte("foobar")), "%d: %d", h, i)
 
 			// read-write must also work
-			var (
-				p2  TxProof
-				pb2 tmproto.TxProof
-			)
+			var pb2 tmproto.TxShareProof
 			pbProof := proof.ToProto()
 			bin, err := pbProof.Marshal()
 			require.NoError(t, err)
@@ -91,30 +93,80 @@ func TestValidTxProof(t *testing.T) {
 			err = pb2.Unmarshal(bin)
 			require.NoError(t, err)
 
-			p2, err = TxProofFromProto(pb2)
-			if assert.Nil(t, err, "%d: %d: %+v", h, i, err) {
-				assert.Nil(t, p2.Validate(root), "%d: %d", h, i)
+			p2, err := TxShareProofFromProto(pb2)
+			if assert.NoError(t, err, "%d: %d", h, i) {
+				assert.NoError(t, p2.Validate(root), "%d: %d", h, i)
 			}
+
+			// the proof must not be valid for any other tx
+			p2.Data = append(Tx{}, proof.Data...)
+			p2.Data[len(p2.Data)-1]++
+			assert.Error(t, p2.Validate(root), "%d: %d", h, i)
 		}
+
+		_, err = data.TxShareProof(len(tc.txs), DefaultCodec(), consts.MinSquareSize)
+		assert.Error(t, err, "%d", h)
+	}
+}
+
+func TestBlockTxShareProof(t *testing.T) {
+	txs := makeTxs(10, 300)
+	// the square is wider than the smallest one the txs fit into
+	block := MakeBlockWithCodec(1, txs, nil, nil, Messages{}, nil, version.BlockProtocol, DefaultCodec(), 16)
+
+	for i := range txs {
+		proof, err := block.TxShareProof(i)
+		require.NoError(t, err, "%d", i)
+		assert.NoError(t, proof.Validate(block.DataHash), "%d", i)
 	}
+
+	_, err := block.TxShareProof(len(txs))
+	assert.Error(t, err)
+
+	// the square is not available for blocks without it
+	_, err = (&Block{Data: block.Data}).TxShareProof(0)
+	assert.Error(t, err)
 }
 
-func TestTxProofUnchangable(t *testing.T) {
+func TestTxShareProofSkippedShares(t *testing.T) {
+	data := Data{Txs: makeTxs(10, 300)}
+	dah, _, err := data.ComputeDataAvailabilityHeader(DefaultCodec(), consts.MinSquareSize)
+	require.NoError(t, err)
+
+	proof, err := data.TxShareProof(5, DefaultCodec(), consts.MinSquareSize)
+	require.NoError(t, err)
+	require.NoError(t, proof.Validate(dah.Hash()))
+
+	// omitting a share breaks the proof
+	rp := &proof.RowProofs[len(proof.RowProofs)-1]
+	require.True(t, len(rp.Shares) > 1)
+	rp.Shares = append(rp.Shares[:1], rp.Shares[2:]...)
+	assert.Error(t, proof.Validate(dah.Hash()))
+
+	// empty txs are indistinguishable from padding
+	proof.Data = Tx{}
+	assert.Error(t, proof.Validate(dah.Hash()))
+}
+
+func TestTxShareProofUnchangable(t *testing.T) {
 	// run the other test a bunch...
 	for i := 0; i < 40; i++ {
-		testTxProofUnchangable(t)
+		testTxShareProofUnchangable(t)
 	}
 }
 
-func testTxProofUnchangable(t *testing.T) {
+func testTxShareProofUnchangable(t *testing.T) {
 	// make some proof
-	txs := makeTxs(randInt(2, 100), randInt(16, 128))
-	root := txs.Hash()
-	i := randInt(0, len(txs)-1)
-	proof := txs.Proof(i)
+	data := Data{Txs: makeTxs(randInt(2, 100), randInt(16, 128))}
+	dah, _, err := data.ComputeDataAvailabilityHeader(DefaultCodec(), consts.MinSquareSize)
+	require.NoError(t, err)
+	root := dah.Hash()
+	i := randInt(0, len(data.Txs)-1)
+	proof, err := data.TxShareProof(i, DefaultCodec(), consts.MinSquareSize)
+	require.NoError(t, err)
 
 	// make sure it is valid to start with
-	assert.Nil(t, proof.Validate(root))
+	assert.NoError(t, proof.Validate(root))
 	pbProof := proof.ToProto()
 	bin, err := pbProof.Marshal()
 	require.NoError(t, err)
@@ -123,29 +175,28 @@ func testTxProofUnchangable(t *testing.T) {
 	for j := 0; j < 500; j++ {
 		bad := ctest.MutateByteSlice(bin)
 		if !bytes.Equal(bad, bin) {
-			assertBadProof(t, root, bad, proof)
+			assertBadProof(t, root, bad, bin)
 		}
 	}
 }
 
 // This makes sure that the proof doesn't deserialize into something valid.
-func assertBadProof(t *testing.T, root []byte, bad []byte, good TxProof) {
-
-	var (
-		proof   TxProof
-		pbProof tmproto.TxProof
-	)
+func assertBadProof(t *testing.T, root []byte, bad []byte, good []byte) {
+	var pbProof tmproto.TxShareProof
 	err := pbProof.Unmarshal(bad)
 	if err == nil {
-		proof, err = TxProofFromProto(pbProof)
+		var proof TxShareProof
+		proof, err = TxShareProofFromProto(pbProof)
 		if err == nil {
 			err = proof.Validate(root)
 			if err == nil {
-				// XXX Fix simple merkle proofs so the following is *not* OK.
-				// This can happen if we have a slightly different total (where the
-				// path ends up the same). If it is something else, we have a real
-				// problem.
-				assert.NotEqual(t, proof.Proof.Total, good.Proof.Total, "bad: %#v\ngood: %#v", proof, good)
+				// A mutation might leave the proof semantically unchanged,
+				// e.g. by changing the tag of a field to an unknown one, but
+				// it must never result in a different valid proof.
+				pbProof = proof.ToProto()
+				bin, err := pbProof.Marshal()
+				require.NoError(t, err)
+				assert.Equal(t, good, bin, "bad proof was accepted")
 			}
 		}
 	}